@@ -29,6 +29,7 @@ func (in *ClusterComputeClass) DeepCopyInto(out *ClusterComputeClass) {
 		}
 	}
 	in.Memory.DeepCopyInto(&out.Memory)
+	in.CPU.DeepCopyInto(&out.CPU)
 	if in.SupportedRegions != nil {
 		in, out := &in.SupportedRegions, &out.SupportedRegions
 		*out = make([]string, len(*in))
@@ -172,6 +173,7 @@ func (in *ProjectComputeClass) DeepCopyInto(out *ProjectComputeClass) {
 		}
 	}
 	in.Memory.DeepCopyInto(&out.Memory)
+	in.CPU.DeepCopyInto(&out.CPU)
 	if in.SupportedRegions != nil {
 		in, out := &in.SupportedRegions, &out.SupportedRegions
 		*out = make([]string, len(*in))
@@ -43,10 +43,16 @@ func AddToSchemeWithGV(scheme *runtime.Scheme, schemeGroupVersion schema.GroupVe
 		&VolumeList{},
 		&VolumeClass{},
 		&VolumeClassList{},
+		&VolumeSnapshot{},
+		&VolumeSnapshotList{},
+		&VolumeBackup{},
+		&VolumeBackupList{},
 		&Credential{},
 		&CredentialList{},
 		&ContainerReplica{},
 		&ContainerReplicaList{},
+		&ContainerReplicaUsage{},
+		&ContainerReplicaUsageList{},
 		&ContainerReplicaExecOptions{},
 		&ContainerReplicaPortForwardOptions{},
 		&Secret{},
@@ -65,9 +71,13 @@ func AddToSchemeWithGV(scheme *runtime.Scheme, schemeGroupVersion schema.GroupVe
 		&ImageAllowRuleList{},
 		&Event{},
 		&EventList{},
+		&EventSubscription{},
+		&EventSubscriptionList{},
 		&DevSession{},
 		&DevSessionList{},
 		&IgnoreCleanup{},
+		&JobExecution{},
+		&JobExecutionList{},
 	)
 
 	// Add common types
@@ -118,6 +118,36 @@ func (in *ContainerReplica) GetRegion() string {
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+// ContainerReplicaUsage is the live CPU and memory usage of a container replica as reported by the metrics API,
+// along with the resources it requested. It has the same name as the ContainerReplica it describes.
+type ContainerReplicaUsage struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	AppName       string             `json:"appName,omitempty"`
+	JobName       string             `json:"jobName,omitempty"`
+	ContainerName string             `json:"containerName,omitempty"`
+	SidecarName   string             `json:"sidecarName,omitempty"`
+	ComputeClass  string             `json:"computeClass,omitempty"`
+	Timestamp     metav1.Time        `json:"timestamp,omitempty"`
+	Window        metav1.Duration    `json:"window,omitempty"`
+	CPU           resource.Quantity  `json:"cpu"`
+	Memory        resource.Quantity  `json:"memory"`
+	CPURequest    *resource.Quantity `json:"cpuRequest,omitempty"`
+	MemoryRequest *resource.Quantity `json:"memoryRequest,omitempty"`
+	MemoryLimit   *resource.Quantity `json:"memoryLimit,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ContainerReplicaUsageList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ContainerReplicaUsage `json:"items"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 type Image struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
@@ -148,11 +178,13 @@ type ImagePull struct {
 }
 
 type LogMessage struct {
-	Line          string      `json:"line,omitempty"`
-	AppName       string      `json:"appName,omitempty"`
-	ContainerName string      `json:"containerName,omitempty"`
-	Time          metav1.Time `json:"time,omitempty"`
-	Error         string      `json:"error,omitempty"`
+	Line          string `json:"line,omitempty"`
+	AppName       string `json:"appName,omitempty"`
+	ContainerName string `json:"containerName,omitempty"`
+	// ReplicaName is the name of the container replica that wrote the line, as accepted by `acorn logs`
+	ReplicaName string      `json:"replicaName,omitempty"`
+	Time        metav1.Time `json:"time,omitempty"`
+	Error       string      `json:"error,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -165,6 +197,8 @@ type LogOptions struct {
 	ContainerReplica string `json:"containerReplica,omitempty"`
 	Container        string `json:"container,omitempty"`
 	Since            string `json:"since,omitempty"`
+	Until            string `json:"until,omitempty"`
+	Grep             string `json:"grep,omitempty"`
 }
 
 type PortForwardOptions struct {
@@ -208,12 +242,14 @@ type ImageDetails struct {
 	DeployArgs   v1.GenericMap `json:"deployArgs,omitempty"`
 	Profiles     []string      `json:"profiles,omitempty"`
 	Auth         *RegistryAuth `json:"auth,omitempty"`
+	IncludeSBOM  bool          `json:"includeSBOM,omitempty"`
 
 	// Output Params
-	AppImage   v1.AppImage   `json:"appImage,omitempty"`
-	AppSpec    *v1.AppSpec   `json:"appSpec,omitempty"`
-	Params     *v1.ParamSpec `json:"params,omitempty"`
-	ParseError string        `json:"parseError,omitempty"`
+	AppImage   v1.AppImage    `json:"appImage,omitempty"`
+	AppSpec    *v1.AppSpec    `json:"appSpec,omitempty"`
+	Params     *v1.ParamSpec  `json:"params,omitempty"`
+	ParseError string         `json:"parseError,omitempty"`
+	SBOMs      []v1.ImageSBOM `json:"sboms,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -277,6 +313,89 @@ type VolumeColumns struct {
 	AccessModes string `json:"accessModes,omitempty"`
 }
 
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// VolumeSnapshot is a point-in-time copy of a volume, backed by a CSI VolumeSnapshot
+type VolumeSnapshot struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Spec   VolumeSnapshotSpec   `json:"spec,omitempty"`
+	Status VolumeSnapshotStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type VolumeSnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VolumeSnapshot `json:"items"`
+}
+
+type VolumeSnapshotSpec struct {
+	// Volume is the name of the volume to snapshot, or its <app>.<volume> alias
+	Volume string `json:"volume,omitempty"`
+	// Class is the name of the VolumeSnapshotClass to use, the cluster default is used if empty
+	Class string `json:"class,omitempty"`
+}
+
+type VolumeSnapshotStatus struct {
+	AppName    string             `json:"appName,omitempty"`
+	VolumeName string             `json:"volumeName,omitempty"`
+	Size       *resource.Quantity `json:"size,omitempty"`
+	ReadyToUse bool               `json:"readyToUse,omitempty"`
+	Error      string             `json:"error,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// VolumeBackup is a backup of a volume to, or a restore of a volume from, object storage. It is backed by a Job that
+// mounts the volume.
+type VolumeBackup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Spec   VolumeBackupSpec   `json:"spec,omitempty"`
+	Status VolumeBackupStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type VolumeBackupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VolumeBackup `json:"items"`
+}
+
+const (
+	VolumeBackupStatePending   = "pending"
+	VolumeBackupStateRunning   = "running"
+	VolumeBackupStateSucceeded = "succeeded"
+	VolumeBackupStateFailed    = "failed"
+)
+
+type VolumeBackupSpec struct {
+	// Volume is the name of the volume to back up or restore, or its <app>.<volume> alias
+	Volume string `json:"volume,omitempty"`
+	// To is the s3:// or file:// URL to store the backup at. A URL that ends with a slash is a prefix the backup is
+	// stored under with a generated name.
+	To string `json:"to,omitempty"`
+	// From is the s3:// or file:// URL of the backup to restore, replacing the contents of the volume
+	From string `json:"from,omitempty"`
+	// Secret is the name of the secret in the project with the credentials of the object store, as the environment
+	// variables AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY
+	Secret string `json:"secret,omitempty"`
+}
+
+type VolumeBackupStatus struct {
+	AppName    string `json:"appName,omitempty"`
+	VolumeName string `json:"volumeName,omitempty"`
+	// URL is the URL the backup was stored at or restored from
+	URL     string `json:"url,omitempty"`
+	State   string `json:"state,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
 // EnsureRegion checks or sets the region of a Volume.
 // If a Volume's region is unset, EnsureRegion sets it to the given region and returns true.
 // Otherwise, it returns true if and only if the Volume belongs to the given region.
@@ -420,6 +539,7 @@ type Config struct {
 	AcornDNSEndpoint               *string         `json:"acornDNSEndpoint" name:"acorn-dns-endpoint" usage:"The URL to access the Acorn DNS service"`
 	AutoUpgradeInterval            *string         `json:"autoUpgradeInterval" name:"auto-upgrade-interval" usage:"For apps configured with automatic upgrades enabled, the interval at which to check for new versions. Upgrade intervals configured at the application level cannot be smaller than this. (default '5m' - 5 minutes)"`
 	RecordBuilds                   *bool           `json:"recordBuilds" name:"record-builds" usage:"Keep a record of each acorn build that happens"`
+	BuildSBOM                      *bool           `json:"buildSBOM" name:"build-sbom" usage:"Generate an SPDX SBOM for each container image built and attach it to the Acorn image (default false)"`
 	PublishBuilders                *bool           `json:"publishBuilders" name:"publish-builders" usage:"Publish the builders through ingress to so build traffic does not traverse the api-server"`
 	BuilderPerProject              *bool           `json:"builderPerProject" name:"builder-per-project" usage:"Create a dedicated builder per project"`
 	InternalRegistryPrefix         *string         `json:"internalRegistryPrefix" name:"internal-registry-prefix" usage:"The image prefix to use when pushing internal images (example ghcr.io/my-org/)"`
@@ -441,6 +561,12 @@ type Config struct {
 	EventTTL                       *string         `json:"eventTTL" name:"event-ttl" usage:"Amount of time an Acorn event will be stored before being deleted (default '168h' - 7 days)"`
 	Features                       map[string]bool `json:"features" name:"features" boolmap:"true" usage:"Enable or disable features. (example foo=true,bar=false)"`
 	CertManagerIssuer              *string         `json:"certManagerIssuer" name:"cert-manager-issuer" usage:"The name of the cert-manager cluster issuer to use for TLS certificates on custom domains" default:""`
+	VaultAddress                   *string         `json:"vaultAddress" name:"vault-address" usage:"Address of the HashiCorp Vault server that secrets of type external read from (example https://vault.example.com:8200)" default:""`
+	ExternalSecretsDirectory       *string         `json:"externalSecretsDirectory" name:"external-secrets-directory" usage:"Directory in the controller that secrets of type external using the file provider read from" default:""`
+	VolumeBackupDirectory          *string         `json:"volumeBackupDirectory" name:"volume-backup-directory" usage:"Directory on the nodes that file:// volume backups are stored in, file:///path URLs are relative to the directory of the project in it. file:// backups are disabled if not set" default:""`
+	LogSinkDirectory               *string         `json:"logSinkDirectory" name:"log-sink-directory" usage:"Directory in the controller that file:// log sinks of projects write to, file:///path sinks are relative to it. File log sinks are disabled if not set" default:""`
+	PrometheusPodMonitors          *bool           `json:"prometheusPodMonitors" name:"prometheus-pod-monitors" usage:"Create Prometheus Operator PodMonitors for containers that declare metrics, if the PodMonitor CRD is installed (default false)"`
+	TracingEndpoint                *string         `json:"tracingEndpoint" name:"tracing-endpoint" usage:"Address (host:port) of an OpenTelemetry collector that the api-server and controller send traces to over OTLP gRPC, prefix with https:// to use TLS" default:""`
 }
 
 type EncryptionKey struct {
@@ -468,6 +594,35 @@ type Project struct {
 type ProjectSpec struct {
 	DefaultRegion    string   `json:"defaultRegion,omitempty"`
 	SupportedRegions []string `json:"supportedRegions,omitempty"`
+	// LogSinks are the external destinations the logs of all apps in the project are forwarded to
+	LogSinks []LogSink `json:"logSinks,omitempty"`
+}
+
+// LogSink is a destination for the logs of a project. Exactly one of Syslog, HTTP and File must be set.
+type LogSink struct {
+	Syslog *SyslogLogSink `json:"syslog,omitempty"`
+	HTTP   *HTTPLogSink   `json:"http,omitempty"`
+	File   *FileLogSink   `json:"file,omitempty"`
+}
+
+// SyslogLogSink sends every line as an RFC 5424 message
+type SyslogLogSink struct {
+	// Protocol is tcp or udp, the default is udp
+	Protocol string `json:"protocol,omitempty"`
+	// Address is the host:port of the syslog server
+	Address string `json:"address,omitempty"`
+}
+
+// HTTPLogSink posts batches of lines as a JSON array
+type HTTPLogSink struct {
+	URL string `json:"url,omitempty"`
+}
+
+// FileLogSink appends lines as JSON objects to a file per project in a directory of the acorn-controller, typically
+// on a volume mounted into it
+type FileLogSink struct {
+	// Path is the directory the file is written to, relative to the log sink directory configured by the admin
+	Path string `json:"path,omitempty"`
 }
 
 type ProjectStatus struct {
@@ -586,6 +741,18 @@ type EventList struct {
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+type EventSubscription v1.EventSubscriptionInstance
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type EventSubscriptionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EventSubscription `json:"items"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 type DevSession v1.DevSessionInstance
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -595,3 +762,53 @@ type DevSessionList struct {
 	metav1.ListMeta `json:"metadata,omitempty"`
 	Items           []DevSession `json:"items"`
 }
+
+const (
+	JobExecutionTriggerSchedule = "schedule"
+	JobExecutionTriggerManual   = "manual"
+	JobExecutionTriggerEvent    = "event"
+
+	JobExecutionStateRunning   = "running"
+	JobExecutionStateSucceeded = "succeeded"
+	JobExecutionStateFailed    = "failed"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// JobExecution is a single run of a job in an app. Creating a JobExecution runs a scheduled job on demand.
+type JobExecution struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Spec   JobExecutionSpec   `json:"spec,omitempty"`
+	Status JobExecutionStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type JobExecutionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []JobExecution `json:"items"`
+}
+
+type JobExecutionSpec struct {
+	AppName string `json:"appName,omitempty"`
+	JobName string `json:"jobName,omitempty"`
+}
+
+type JobExecutionStatus struct {
+	// Trigger is what started the execution: schedule, manual, or event
+	Trigger        string       `json:"trigger,omitempty"`
+	State          string       `json:"state,omitempty"`
+	StartTime      *metav1.Time `json:"startTime,omitempty"`
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// ExitCode is the exit code of the job container of the most recent attempt, once it has terminated
+	ExitCode *int32 `json:"exitCode,omitempty"`
+	Attempts int32  `json:"attempts,omitempty"`
+	Message  string `json:"message,omitempty"`
+	// ContainerReplicaNames are the container replicas of each attempt, used to retrieve the logs of the execution
+	ContainerReplicaNames []string `json:"containerReplicaNames,omitempty"`
+	JobResourceName       string   `json:"jobResourceName,omitempty"`
+	JobResourceNamespace  string   `json:"jobResourceNamespace,omitempty"`
+}
@@ -13,6 +13,14 @@ type AppImage struct {
 	VCS       VCS        `json:"vcs,omitempty"`
 }
 
+// ImageSBOM is a software bill of materials attested for one of the images of an app image
+type ImageSBOM struct {
+	// Image is the digest of the image the SBOM describes
+	Image         string     `json:"image,omitempty"`
+	PredicateType string     `json:"predicateType,omitempty"`
+	Document      GenericMap `json:"document,omitempty"`
+}
+
 type VCS struct {
 	Remotes  []string `json:"remotes,omitempty"`
 	Revision string   `json:"revision,omitempty"`
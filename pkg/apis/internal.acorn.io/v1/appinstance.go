@@ -26,6 +26,7 @@ var (
 	AppInstanceConditionVolumes        = "volumes"
 	AppInstanceConditionImageAllowed   = "image-allowed"
 	AppInstanceConditionQuotaAllocated = "quota-allocated"
+	AppInstanceConditionRollout        = "rollout"
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -103,6 +104,8 @@ type AppInstanceSpec struct {
 	AutoUpgradeInterval string           `json:"autoUpgradeInterval,omitempty"`
 	ComputeClasses      ComputeClassMap  `json:"computeClass,omitempty"`
 	Memory              MemoryMap        `json:"memory,omitempty"`
+	CPU                 CPUMap           `json:"cpu,omitempty"`
+	Rollout             *RolloutStrategy `json:"rollout,omitempty"`
 }
 
 func (in *AppInstance) GetStopped() bool {
@@ -157,6 +160,10 @@ type VolumeBinding struct {
 	Size        Quantity    `json:"size,omitempty"`
 	AccessModes AccessModes `json:"accessModes,omitempty"`
 	Class       string      `json:"class,omitempty"`
+	// Snapshot is the name of a volume snapshot to provision the volume from
+	Snapshot string `json:"snapshot,omitempty"`
+	// CloneOf is the name of an existing volume to provision the volume as a copy of
+	CloneOf string `json:"cloneOf,omitempty"`
 }
 
 type AppColumns struct {
@@ -188,6 +195,8 @@ type AppInstanceStatus struct {
 	Scheduling                   map[string]Scheduling   `json:"scheduling,omitempty"`
 	Conditions                   []Condition             `json:"conditions,omitempty"`
 	Defaults                     Defaults                `json:"defaults,omitempty"`
+	Rollout                      *RolloutStatus          `json:"rollout,omitempty"`
+	Revisions                    []AppRevision           `json:"revisions,omitempty"`
 }
 
 type Defaults struct {
@@ -203,10 +212,12 @@ type VolumeDefault struct {
 }
 
 type Scheduling struct {
-	Requirements      corev1.ResourceRequirements `json:"requirements,omitempty"`
-	Affinity          *corev1.Affinity            `json:"affinity,omitempty"`
-	Tolerations       []corev1.Toleration         `json:"tolerations,omitempty"`
-	PriorityClassName string                      `json:"priorityClassName,omitempty"`
+	Requirements              corev1.ResourceRequirements       `json:"requirements,omitempty"`
+	Affinity                  *corev1.Affinity                  `json:"affinity,omitempty"`
+	Tolerations               []corev1.Toleration               `json:"tolerations,omitempty"`
+	PriorityClassName         string                            `json:"priorityClassName,omitempty"`
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+	Availability              *Availability                     `json:"availability,omitempty"`
 }
 
 type Endpoint struct {
@@ -0,0 +1,73 @@
+package v1
+
+import (
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DefaultRevisionHistoryLimit is the number of revisions kept in the status of an app
+const DefaultRevisionHistoryLimit = 10
+
+// AppRevision is a deployed configuration of an app that can be restored with a rollback
+type AppRevision struct {
+	Revision int64 `json:"revision,omitempty"`
+	// Image is the image requested in the app spec
+	Image string `json:"image,omitempty"`
+	// ImageID and ImageDigest are what Image resolved to when the revision was deployed
+	ImageID     string           `json:"imageID,omitempty"`
+	ImageDigest string           `json:"imageDigest,omitempty"`
+	DeployArgs  GenericMap       `json:"deployArgs,omitempty"`
+	Profiles    []string         `json:"profiles,omitempty"`
+	PublishMode PublishMode      `json:"publishMode,omitempty"`
+	Volumes     []VolumeBinding  `json:"volumes,omitempty"`
+	Secrets     []SecretBinding  `json:"secrets,omitempty"`
+	Links       []ServiceBinding `json:"services,omitempty"`
+	Publish     []PortBinding    `json:"ports,omitempty"`
+	// UpdatedBy is the user that made the change that resulted in this revision
+	UpdatedBy string      `json:"updatedBy,omitempty"`
+	Created   metav1.Time `json:"created,omitempty"`
+}
+
+// NewAppRevision returns the revision of the currently deployed image and configuration of the app
+func NewAppRevision(app *AppInstance) AppRevision {
+	return AppRevision{
+		Image:       app.Spec.Image,
+		ImageID:     app.Status.AppImage.ID,
+		ImageDigest: app.Status.AppImage.Digest,
+		DeployArgs:  app.Spec.DeployArgs,
+		Profiles:    app.Spec.Profiles,
+		PublishMode: app.Spec.PublishMode,
+		Volumes:     app.Spec.Volumes,
+		Secrets:     app.Spec.Secrets,
+		Links:       app.Spec.Links,
+		Publish:     app.Spec.Publish,
+	}
+}
+
+// Equivalent returns true if both revisions deploy the same image with the same configuration
+func (in AppRevision) Equivalent(other AppRevision) bool {
+	in.Revision, other.Revision = 0, 0
+	in.Image, other.Image = "", ""
+	in.ImageID, other.ImageID = "", ""
+	in.UpdatedBy, other.UpdatedBy = "", ""
+	in.Created, other.Created = metav1.Time{}, metav1.Time{}
+	return equality.Semantic.DeepEqual(in, other)
+}
+
+// GetRevision returns the revision with the given number. A number of 0 or less is relative to the latest
+// revision, so 0 is the latest and -1 the one before it.
+func (in *AppInstanceStatus) GetRevision(revision int64) (AppRevision, bool) {
+	if revision <= 0 {
+		i := len(in.Revisions) - 1 + int(revision)
+		if i < 0 || i >= len(in.Revisions) {
+			return AppRevision{}, false
+		}
+		return in.Revisions[i], true
+	}
+	for _, rev := range in.Revisions {
+		if rev.Revision == revision {
+			return rev, true
+		}
+	}
+	return AppRevision{}, false
+}
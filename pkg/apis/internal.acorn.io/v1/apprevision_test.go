@@ -0,0 +1,42 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppRevisionEquivalent(t *testing.T) {
+	a := AppRevision{Revision: 1, Image: "app:v1", ImageID: "app:v1", ImageDigest: "sha256:a", UpdatedBy: "alice"}
+	b := AppRevision{Revision: 2, Image: "app:latest", ImageID: "app:latest", ImageDigest: "sha256:a", Profiles: []string{}}
+	assert.True(t, a.Equivalent(b))
+
+	b.DeployArgs = GenericMap{"replicas": 2}
+	assert.False(t, a.Equivalent(b))
+
+	b = a
+	b.ImageDigest = "sha256:b"
+	assert.False(t, a.Equivalent(b))
+}
+
+func TestGetRevision(t *testing.T) {
+	status := AppInstanceStatus{
+		Revisions: []AppRevision{{Revision: 3}, {Revision: 4}, {Revision: 5}},
+	}
+
+	for _, tt := range []struct {
+		revision int64
+		expected int64
+		found    bool
+	}{
+		{revision: 0, expected: 5, found: true},
+		{revision: -1, expected: 4, found: true},
+		{revision: -3},
+		{revision: 3, expected: 3, found: true},
+		{revision: 1},
+	} {
+		rev, ok := status.GetRevision(tt.revision)
+		assert.Equal(t, tt.found, ok, "revision %d", tt.revision)
+		assert.Equal(t, tt.expected, rev.Revision, "revision %d", tt.revision)
+	}
+}
@@ -364,6 +364,7 @@ type Container struct {
 	Permissions  *Permissions           `json:"permissions,omitempty"`
 	ComputeClass *string                `json:"class,omitempty"`
 	Memory       *int64                 `json:"memory,omitempty"`
+	CPU          *MilliCPU              `json:"cpu,omitempty"`
 
 	// Metrics is available on containers and jobs, but not sidecars
 	Metrics MetricsDef `json:"metrics,omitempty"`
@@ -371,12 +372,33 @@ type Container struct {
 	// Scale is only available on containers, not sidecars or jobs
 	Scale *int32 `json:"scale,omitempty"`
 
+	// Autoscale is only available on containers, not sidecars or jobs
+	Autoscale *Autoscale `json:"autoscale,omitempty"`
+
+	// Availability is only available on containers, not sidecars or jobs
+	Availability *Availability `json:"availability,omitempty"`
+
+	// Spread is only available on containers, not sidecars or jobs
+	Spread TopologySpreads `json:"spread,omitempty"`
+
 	// Schedule is only available on jobs
 	Schedule string `json:"schedule,omitempty"`
 
 	// Events is only available on jobs
 	Events []string `json:"events,omitempty"`
 
+	// Concurrency is only available on jobs with a schedule
+	Concurrency ConcurrencyPolicy `json:"concurrency,omitempty"`
+
+	// Deadline is only available on jobs
+	Deadline string `json:"deadline,omitempty"`
+
+	// Retries is only available on jobs
+	Retries *int32 `json:"retries,omitempty"`
+
+	// History is only available on jobs with a schedule
+	History *JobHistory `json:"history,omitempty"`
+
 	// Init is only available on sidecars
 	Init bool `json:"init,omitempty"`
 
@@ -435,6 +457,7 @@ type Acorn struct {
 	NotifyUpgrade       *bool           `json:"notifyUpgrade,omitempty"`
 	AutoUpgradeInterval string          `json:"autoUpgradeInterval,omitempty"`
 	Memory              MemoryMap       `json:"memory,omitempty"`
+	CPU                 CPUMap          `json:"cpu,omitempty"`
 	ComputeClasses      ComputeClassMap `json:"computeClasses,omitempty"`
 }
 
@@ -446,6 +469,7 @@ type Secret struct {
 	Type        string            `json:"type,omitempty"`
 	Params      GenericMap        `json:"params,omitempty"`
 	Data        map[string]string `json:"data,omitempty"`
+	Rotate      *SecretRotation   `json:"rotate,omitempty"`
 }
 
 type AccessModes []AccessMode
@@ -456,6 +480,19 @@ type VolumeRequest struct {
 	Class       string            `json:"class,omitempty"`
 	Size        Quantity          `json:"size,omitempty"`
 	AccessModes AccessModes       `json:"accessModes,omitempty"`
+	Backup      *VolumeBackup     `json:"backup,omitempty"`
+	// From is the name of an existing volume that the volume is provisioned as a copy of
+	From string `json:"from,omitempty"`
+}
+
+// VolumeBackup is a policy to periodically back up a volume to object storage
+type VolumeBackup struct {
+	// Schedule is a cron expression, or one of hourly, daily, weekly and monthly
+	Schedule string `json:"schedule,omitempty"`
+	// To is the s3:// or file:// URL prefix the backups are stored under
+	To string `json:"to,omitempty"`
+	// Secret is the secret of the app with the credentials of the object store
+	Secret string `json:"secret,omitempty"`
 }
 
 // Workload to its memory
@@ -494,6 +531,7 @@ type Service struct {
 	NotifyUpgrade       *bool             `json:"notifyUpgrade,omitempty"`
 	AutoUpgradeInterval string            `json:"autoUpgradeInterval,omitempty"`
 	Memory              MemoryMap         `json:"memory,omitempty"`
+	CPU                 CPUMap            `json:"cpu,omitempty"`
 }
 
 func (s Service) GetJob() string {
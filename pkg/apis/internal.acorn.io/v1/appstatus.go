@@ -1,6 +1,10 @@
 package v1
 
-import "fmt"
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
 
 type AppStatus struct {
 	Containers map[string]ContainerStatus `json:"containers,omitempty"`
@@ -88,12 +92,15 @@ func (in ServiceStatus) GetCommonStatus() CommonStatus {
 
 type SecretStatus struct {
 	CommonStatus        `json:",inline"`
-	SecretName          string   `json:"secretName,omitempty"`
-	JobName             string   `json:"jobName,omitempty"`
-	JobReady            bool     `json:"jobReady,omitempty"`
-	LookupErrors        []string `json:"lookupErrors,omitempty"`
-	LookupTransitioning []string `json:"lookupTransitioning,omitempty"`
-	DataKeys            []string `json:"dataKeys,omitempty"`
+	SecretName          string       `json:"secretName,omitempty"`
+	JobName             string       `json:"jobName,omitempty"`
+	JobReady            bool         `json:"jobReady,omitempty"`
+	LookupErrors        []string     `json:"lookupErrors,omitempty"`
+	LookupTransitioning []string     `json:"lookupTransitioning,omitempty"`
+	DataKeys            []string     `json:"dataKeys,omitempty"`
+	SourceVersion       string       `json:"sourceVersion,omitempty"`
+	LastSyncTime        *metav1.Time `json:"lastSyncTime,omitempty"`
+	LastRotationTime    *metav1.Time `json:"lastRotationTime,omitempty"`
 }
 
 func (in SecretStatus) GetCommonStatus() CommonStatus {
@@ -107,6 +114,7 @@ type ContainerStatus struct {
 	RunningReplicaCount    int32                       `json:"runningReplicaCount,omitempty"`
 	UpToDateReplicaCount   int32                       `json:"upToDateCount,omitempty"`
 	MaxReplicaRestartCount int32                       `json:"maxReplicaRestartCount,omitempty"`
+	Autoscale              *AutoscaleStatus            `json:"autoscale,omitempty"`
 	Dependencies           map[string]DependencyStatus `json:"dependencies,omitempty"`
 	ExpressionErrors       []ExpressionError           `json:"expressionErrors,omitempty"`
 }
@@ -123,6 +131,18 @@ type JobStatus struct {
 	Dependencies         map[string]DependencyStatus `json:"dependencies,omitempty"`
 	Skipped              bool                        `json:"skipped,omitempty"`
 	ExpressionErrors     []ExpressionError           `json:"expressionErrors,omitempty"`
+	// Event is the lifecycle event the job was run for in the EventGeneration of the app
+	Event           string `json:"event,omitempty"`
+	EventGeneration int64  `json:"eventGeneration,omitempty"`
+	// Runs records the most recent lifecycle events the job ran for, oldest first
+	Runs []JobRun `json:"runs,omitempty"`
+}
+
+type JobRun struct {
+	Event          string `json:"event,omitempty"`
+	Generation     int64  `json:"generation,omitempty"`
+	Succeeded      bool   `json:"succeeded,omitempty"`
+	FailedAttempts int    `json:"failedAttempts,omitempty"`
 }
 
 type DependencyStatus struct {
@@ -149,6 +169,12 @@ type VolumeStatus struct {
 	VolumeName        string `json:"volumeName,omitempty"`
 	StorageClassFound bool   `json:"storageClassFound,omitempty"`
 	Bound             bool   `json:"bound,omitempty"`
+	// Size is the capacity of the volume
+	Size Quantity `json:"size,omitempty"`
+	// RequestedSize is the size that was requested for the volume, the capacity can be larger than that
+	RequestedSize Quantity `json:"requestedSize,omitempty"`
+	// Resizing is true while the volume is expanded to a new size
+	Resizing bool `json:"resizing,omitempty"`
 }
 
 func (in VolumeStatus) GetCommonStatus() CommonStatus {
@@ -0,0 +1,80 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+var (
+	ErrInvalidAutoscale = errors.New("invalid autoscale")
+)
+
+type Autoscale struct {
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+	MaxReplicas int32  `json:"maxReplicas,omitempty"`
+	// CPU is the target average CPU utilization as a percentage of the requested CPU
+	CPU *int32 `json:"cpu,omitempty"`
+	// Memory is the target average memory utilization as a percentage of the requested memory
+	Memory *int32 `json:"memory,omitempty"`
+	// Metrics are per replica targets for metrics exposed by the container, typically on the
+	// endpoint declared by the container's MetricsDef
+	Metrics AutoscaleMetrics `json:"metrics,omitempty"`
+}
+
+type AutoscaleMetrics []AutoscaleMetric
+
+type AutoscaleMetric struct {
+	Name   string `json:"name,omitempty"`
+	Target string `json:"target,omitempty"`
+}
+
+type AutoscaleStatus struct {
+	MinReplicas     int32 `json:"minReplicas,omitempty"`
+	MaxReplicas     int32 `json:"maxReplicas,omitempty"`
+	CurrentReplicas int32 `json:"currentReplicas,omitempty"`
+	DesiredReplicas int32 `json:"desiredReplicas,omitempty"`
+}
+
+// GetMinReplicas returns the lower bound of replicas, falling back to the container scale and then 1.
+func (in *Autoscale) GetMinReplicas(scale *int32) int32 {
+	if in.MinReplicas != nil {
+		return *in.MinReplicas
+	}
+	if scale != nil && *scale > 0 {
+		return *scale
+	}
+	return 1
+}
+
+func (in *Autoscale) Validate(scale *int32) error {
+	if in == nil {
+		return nil
+	}
+	minReplicas := in.GetMinReplicas(scale)
+	if minReplicas < 1 {
+		return fmt.Errorf("%w: min replicas %d must be at least 1", ErrInvalidAutoscale, minReplicas)
+	}
+	if in.MaxReplicas < minReplicas {
+		return fmt.Errorf("%w: max replicas %d must be greater than or equal to min replicas %d", ErrInvalidAutoscale, in.MaxReplicas, minReplicas)
+	}
+	if in.CPU == nil && in.Memory == nil && len(in.Metrics) == 0 {
+		return fmt.Errorf("%w: at least one of cpu, memory, or metrics must be set", ErrInvalidAutoscale)
+	}
+	if in.CPU != nil && *in.CPU <= 0 {
+		return fmt.Errorf("%w: cpu target %d must be greater than 0", ErrInvalidAutoscale, *in.CPU)
+	}
+	if in.Memory != nil && *in.Memory <= 0 {
+		return fmt.Errorf("%w: memory target %d must be greater than 0", ErrInvalidAutoscale, *in.Memory)
+	}
+	for _, metric := range in.Metrics {
+		if metric.Name == "" {
+			return fmt.Errorf("%w: metric name is required", ErrInvalidAutoscale)
+		}
+		if _, err := resource.ParseQuantity(metric.Target); err != nil {
+			return fmt.Errorf("%w: metric [%s] target [%s]: %v", ErrInvalidAutoscale, metric.Name, metric.Target, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package v1
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAutoscaleValidate(t *testing.T) {
+	cpu := int32(50)
+	tests := []struct {
+		name      string
+		autoscale *Autoscale
+		scale     *int32
+		valid     bool
+	}{
+		{
+			name:  "nil",
+			valid: true,
+		},
+		{
+			name:      "max below min",
+			autoscale: &Autoscale{MinReplicas: &[]int32{4}[0], MaxReplicas: 2, CPU: &cpu},
+		},
+		{
+			name:      "max below scale",
+			autoscale: &Autoscale{MaxReplicas: 2, CPU: &cpu},
+			scale:     &[]int32{3}[0],
+		},
+		{
+			name:      "no targets",
+			autoscale: &Autoscale{MaxReplicas: 2},
+		},
+		{
+			name:      "bad metric target",
+			autoscale: &Autoscale{MaxReplicas: 2, Metrics: AutoscaleMetrics{{Name: "rps", Target: "lots"}}},
+		},
+		{
+			name:      "valid",
+			autoscale: &Autoscale{MaxReplicas: 2, CPU: &cpu},
+			valid:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.autoscale.Validate(tt.scale)
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.True(t, errors.Is(err, ErrInvalidAutoscale), "expected ErrInvalidAutoscale, got %v", err)
+			}
+		})
+	}
+}
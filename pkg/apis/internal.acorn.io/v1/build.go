@@ -101,6 +101,7 @@ type AcornImageBuildInstanceSpec struct {
 	Platforms       []Platform `json:"platforms,omitempty"`
 	Args            GenericMap `json:"args,omitempty"`
 	VCS             VCS        `json:"vcs,omitempty"`
+	SBOM            bool       `json:"sbom,omitempty"`
 }
 
 type AcornImageBuildInstanceStatus struct {
@@ -0,0 +1,111 @@
+package v1
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+var (
+	ErrInvalidCPU      = errors.New("invalid cpu")
+	ErrInvalidAcornCPU = errors.New("invalid cpu from Acornfile")
+	ErrInvalidSetCPU   = errors.New("invalid cpu set by user")
+)
+
+// MilliCPU is an amount of CPU in thousandths of a core. It is written as a number of cores (ex: 0.5 or 2) or as a
+// quantity (ex: "500m") and is serialized as a quantity.
+type MilliCPU int64
+
+// Workload to its CPU
+type CPUMap map[string]*MilliCPU
+
+// ParseMilliCPU parses a number of cores or a CPU quantity
+func ParseMilliCPU(s string) (MilliCPU, error) {
+	q, err := resource.ParseQuantity(s)
+	if err != nil {
+		return 0, fmt.Errorf("%w %q: %v", ErrInvalidCPU, s, err)
+	}
+	if q.Sign() < 0 {
+		return 0, fmt.Errorf("%w %q: must not be negative", ErrInvalidCPU, s)
+	}
+	return MilliCPU(q.MilliValue()), nil
+}
+
+func ParseCPU(s []string) (CPUMap, error) {
+	result := CPUMap{}
+	for _, s := range s {
+		workload, cpu, specific := strings.Cut(s, "=")
+
+		// If setting all, swap workload and cpu
+		if !specific {
+			cpu = workload
+			workload = ""
+		}
+
+		milliCPU, err := ParseMilliCPU(cpu)
+		if err != nil {
+			return CPUMap{}, err
+		}
+
+		result[workload] = &milliCPU
+	}
+	return result, nil
+}
+
+// GetCPU returns the CPU of the workload, which is set by the user for the specific workload, by the user for all
+// workloads, or in the Acornfile, in that order. The error returned is the one to wrap if the CPU is rejected.
+// The CPU is nil if it is not set.
+func GetCPU(cpuSpec CPUMap, containerName string, container Container) (*MilliCPU, error) {
+	if c := cpuSpec[containerName]; c != nil {
+		return c, ErrInvalidSetCPU
+	} else if c := cpuSpec[""]; c != nil {
+		return c, ErrInvalidSetCPU
+	}
+	return container.CPU, ErrInvalidAcornCPU
+}
+
+func (in MilliCPU) Quantity() resource.Quantity {
+	return *resource.NewMilliQuantity(int64(in), resource.DecimalSI)
+}
+
+func (in MilliCPU) String() string {
+	q := in.Quantity()
+	return q.String()
+}
+
+func (in MilliCPU) MarshalJSON() ([]byte, error) {
+	return json.Marshal(in.String())
+}
+
+func (in *MilliCPU) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if isString(data) {
+		var err error
+		if s, err = parseString(data); err != nil {
+			return err
+		}
+	}
+	cpu, err := ParseMilliCPU(s)
+	if err != nil {
+		return err
+	}
+	*in = cpu
+	return nil
+}
+
+func (in *CPUMap) UnmarshalJSON(data []byte) error {
+	if isObject(data) {
+		return json.Unmarshal(data, (*map[string]*MilliCPU)(in))
+	}
+	var cpu MilliCPU
+	if err := json.Unmarshal(data, &cpu); err != nil {
+		return err
+	}
+	*in = CPUMap{
+		"": &cpu,
+	}
+	return nil
+}
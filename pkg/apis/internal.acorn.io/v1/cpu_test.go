@@ -0,0 +1,92 @@
+package v1
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCPUUnmarshal(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		want MilliCPU
+	}{
+		{name: "cores", json: `{"cpu": 2}`, want: 2000},
+		{name: "fractional cores", json: `{"cpu": 0.5}`, want: 500},
+		{name: "millicores", json: `{"cpu": "250m"}`, want: 250},
+		{name: "cores as string", json: `{"cpu": "1.5"}`, want: 1500},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var c Container
+			if err := json.Unmarshal([]byte(tt.json), &c); err != nil {
+				t.Fatal(err)
+			}
+			if assert.NotNil(t, c.CPU) {
+				assert.Equal(t, tt.want, *c.CPU)
+			}
+
+			// The CPU is serialized as a quantity so that it unmarshals to the same value
+			data, err := json.Marshal(c)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var roundTrip Container
+			if err := json.Unmarshal(data, &roundTrip); err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, c.CPU, roundTrip.CPU)
+		})
+	}
+
+	var c Container
+	assert.True(t, errors.Is(json.Unmarshal([]byte(`{"cpu": "-1"}`), &c), ErrInvalidCPU))
+}
+
+func TestCPUMapUnmarshal(t *testing.T) {
+	var spec AppInstanceSpec
+	if err := json.Unmarshal([]byte(`{"cpu": "500m"}`), &spec); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, CPUMap{"": &[]MilliCPU{500}[0]}, spec.CPU)
+
+	spec = AppInstanceSpec{}
+	if err := json.Unmarshal([]byte(`{"cpu": {"web": 1, "worker": "100m"}}`), &spec); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, CPUMap{"web": &[]MilliCPU{1000}[0], "worker": &[]MilliCPU{100}[0]}, spec.CPU)
+}
+
+func TestParseCPU(t *testing.T) {
+	cpu, err := ParseCPU([]string{"1", "web=250m"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, CPUMap{"": &[]MilliCPU{1000}[0], "web": &[]MilliCPU{250}[0]}, cpu)
+
+	_, err = ParseCPU([]string{"web=lots"})
+	assert.True(t, errors.Is(err, ErrInvalidCPU))
+}
+
+func TestGetCPU(t *testing.T) {
+	acornfile := Container{CPU: &[]MilliCPU{100}[0]}
+
+	cpu, errType := GetCPU(CPUMap{"web": &[]MilliCPU{300}[0], "": &[]MilliCPU{200}[0]}, "web", acornfile)
+	assert.Equal(t, MilliCPU(300), *cpu)
+	assert.Equal(t, ErrInvalidSetCPU, errType)
+
+	cpu, errType = GetCPU(CPUMap{"": &[]MilliCPU{200}[0]}, "web", acornfile)
+	assert.Equal(t, MilliCPU(200), *cpu)
+	assert.Equal(t, ErrInvalidSetCPU, errType)
+
+	cpu, errType = GetCPU(nil, "web", acornfile)
+	assert.Equal(t, MilliCPU(100), *cpu)
+	assert.Equal(t, ErrInvalidAcornCPU, errType)
+
+	cpu, _ = GetCPU(nil, "web", Container{})
+	assert.Nil(t, cpu)
+}
@@ -0,0 +1,112 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+var (
+	ErrInvalidAvailability = errors.New("invalid availability")
+	ErrInvalidSpread       = errors.New("invalid spread")
+)
+
+const (
+	TopologyZone = "zone"
+	TopologyHost = "host"
+)
+
+// Availability limits the number of replicas that may be down during voluntary disruptions such as node drains.
+// Values are either a number of replicas or a percentage of replicas (ex: 1 or "25%").
+type Availability struct {
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+	MinAvailable   *intstr.IntOrString `json:"minAvailable,omitempty"`
+}
+
+// Validate checks that the availability can be satisfied by the given number of replicas
+func (in *Availability) Validate(replicas int32) error {
+	if in == nil {
+		return nil
+	}
+	if in.MaxUnavailable != nil && in.MinAvailable != nil {
+		return fmt.Errorf("%w: only one of maxUnavailable or minAvailable can be set", ErrInvalidAvailability)
+	}
+	for field, value := range map[string]*intstr.IntOrString{
+		"maxUnavailable": in.MaxUnavailable,
+		"minAvailable":   in.MinAvailable,
+	} {
+		if value == nil {
+			continue
+		}
+		// count is compared to total, which is the number of replicas or 100 for a percentage
+		count, total := value.IntVal, replicas
+		if value.Type == intstr.String {
+			percent, err := intstr.GetScaledValueFromIntOrPercent(value, 100, true)
+			if err != nil {
+				return fmt.Errorf("%w: %s %s: %v", ErrInvalidAvailability, field, value.String(), err)
+			}
+			if percent < 0 || percent > 100 {
+				return fmt.Errorf("%w: %s %s must be between 0%% and 100%%", ErrInvalidAvailability, field, value.String())
+			}
+			count, total = int32(percent), 100
+		} else if count < 0 {
+			return fmt.Errorf("%w: %s %d must not be negative", ErrInvalidAvailability, field, count)
+		} else if replicas > 0 && count > replicas {
+			return fmt.Errorf("%w: %s %d is greater than the %d replicas of the container", ErrInvalidAvailability, field, count, replicas)
+		}
+
+		// A budget that allows no replica to be down blocks every eviction, so nodes with a replica could never be drained
+		if field == "maxUnavailable" && count == 0 || field == "minAvailable" && total > 0 && count >= total {
+			return fmt.Errorf("%w: %s %s does not allow any of the %d replicas of the container to be evicted", ErrInvalidAvailability, field, value.String(), replicas)
+		}
+	}
+	return nil
+}
+
+type TopologySpreads []TopologySpread
+
+// TopologySpread spreads the replicas of a container across zones, hosts, or the values of a node label
+type TopologySpread struct {
+	// Topology is zone, host, or the key of a node label (default: zone)
+	Topology string `json:"topology,omitempty"`
+	// MaxSkew is the maximum difference in the number of replicas between two topology domains (default: 1)
+	MaxSkew int32 `json:"maxSkew,omitempty"`
+	// Required prevents replicas from being scheduled if that would exceed MaxSkew. By default the spread is only
+	// preferred.
+	Required bool `json:"required,omitempty"`
+}
+
+// TopologyKey returns the node label key the replicas are spread across
+func (in TopologySpread) TopologyKey() string {
+	switch in.Topology {
+	case "", TopologyZone:
+		return corev1.LabelTopologyZone
+	case TopologyHost:
+		return corev1.LabelHostname
+	}
+	return in.Topology
+}
+
+func (in TopologySpread) GetMaxSkew() int32 {
+	if in.MaxSkew == 0 {
+		return 1
+	}
+	return in.MaxSkew
+}
+
+func (in TopologySpreads) Validate() error {
+	seen := map[string]bool{}
+	for _, spread := range in {
+		if spread.MaxSkew < 0 {
+			return fmt.Errorf("%w: maxSkew %d must not be negative", ErrInvalidSpread, spread.MaxSkew)
+		}
+		key := spread.TopologyKey()
+		if seen[key] {
+			return fmt.Errorf("%w: topology %s is listed more than once", ErrInvalidSpread, spread.Topology)
+		}
+		seen[key] = true
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package v1
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestAvailabilityValidate(t *testing.T) {
+	zero, one, three, negative := intstr.FromInt(0), intstr.FromInt(1), intstr.FromInt(3), intstr.FromInt(-1)
+	half, all, tooMuch, bad := intstr.FromString("50%"), intstr.FromString("100%"), intstr.FromString("150%"), intstr.FromString("half")
+	tests := []struct {
+		name         string
+		availability *Availability
+		replicas     int32
+		valid        bool
+	}{
+		{
+			name:  "nil",
+			valid: true,
+		},
+		{
+			name:         "both set",
+			availability: &Availability{MaxUnavailable: &one, MinAvailable: &one},
+			replicas:     3,
+		},
+		{
+			name:         "more than replicas",
+			availability: &Availability{MinAvailable: &three},
+			replicas:     2,
+		},
+		{
+			name:         "min available equals replicas",
+			availability: &Availability{MinAvailable: &three},
+			replicas:     3,
+		},
+		{
+			name:         "min available all replicas",
+			availability: &Availability{MinAvailable: &all},
+			replicas:     3,
+		},
+		{
+			name:         "max unavailable zero",
+			availability: &Availability{MaxUnavailable: &zero},
+			replicas:     3,
+		},
+		{
+			name:         "max unavailable",
+			availability: &Availability{MaxUnavailable: &one},
+			replicas:     3,
+			valid:        true,
+		},
+		{
+			name:         "negative",
+			availability: &Availability{MaxUnavailable: &negative},
+			replicas:     2,
+		},
+		{
+			name:         "percentage over 100",
+			availability: &Availability{MaxUnavailable: &tooMuch},
+			replicas:     2,
+		},
+		{
+			name:         "not a percentage",
+			availability: &Availability{MinAvailable: &bad},
+			replicas:     2,
+		},
+		{
+			name:         "percentage",
+			availability: &Availability{MinAvailable: &half},
+			replicas:     2,
+			valid:        true,
+		},
+		{
+			name:         "stopped",
+			availability: &Availability{MinAvailable: &three},
+			valid:        true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.availability.Validate(tt.replicas)
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.True(t, errors.Is(err, ErrInvalidAvailability), "expected ErrInvalidAvailability, got %v", err)
+			}
+		})
+	}
+}
+
+func TestTopologySpreadsValidate(t *testing.T) {
+	assert.NoError(t, TopologySpreads{{Topology: TopologyZone}, {Topology: TopologyHost}}.Validate())
+	assert.ErrorIs(t, TopologySpreads{{}, {Topology: TopologyZone}}.Validate(), ErrInvalidSpread)
+	assert.ErrorIs(t, TopologySpreads{{MaxSkew: -1}}.Validate(), ErrInvalidSpread)
+}
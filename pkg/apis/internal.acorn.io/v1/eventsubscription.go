@@ -0,0 +1,103 @@
+package v1
+
+import (
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/strings/slices"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type EventSubscriptionInstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EventSubscriptionInstance `json:"items"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EventSubscriptionInstance delivers the events of a project that match its filters to an HTTP endpoint.
+type EventSubscriptionInstance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EventSubscriptionSpec   `json:"spec,omitempty"`
+	Status EventSubscriptionStatus `json:"status,omitempty"`
+}
+
+type EventSubscriptionSpec struct {
+	// URL is the HTTP endpoint that matching events are POSTed to.
+	URL string `json:"url"`
+
+	// SigningSecretName is the name of a token secret in the project. Its token is used as the key of the HMAC-SHA256
+	// signature sent with every delivery.
+	// +optional
+	SigningSecretName string `json:"signingSecretName,omitempty"`
+
+	// Types, Severities and SourceKinds limit the events delivered to those with one of the given values.
+	// An empty list matches all events.
+	// +optional
+	Types []string `json:"types,omitempty"`
+	// +optional
+	Severities []EventSeverity `json:"severities,omitempty"`
+	// +optional
+	SourceKinds []string `json:"sourceKinds,omitempty"`
+
+	// SourceNamePrefix limits the events delivered to those whose source name starts with the prefix.
+	// +optional
+	SourceNamePrefix string `json:"sourceNamePrefix,omitempty"`
+}
+
+// Matches returns true if the event passes all filters of the subscription.
+func (in EventSubscriptionSpec) Matches(e *EventInstance) bool {
+	if len(in.Types) > 0 && !slices.Contains(in.Types, e.Type) {
+		return false
+	}
+	if len(in.Severities) > 0 {
+		severity := e.Severity
+		if severity == "" {
+			severity = EventSeverityInfo
+		}
+		found := false
+		for _, s := range in.Severities {
+			if s == severity {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(in.SourceKinds) > 0 && !slices.Contains(in.SourceKinds, e.Source.Kind) {
+		return false
+	}
+	return strings.HasPrefix(e.Source.Name, in.SourceNamePrefix)
+}
+
+type EventSubscriptionStatus struct {
+	// LastEvent and LastEventObserved identify the last event that was delivered or skipped because it did not
+	// match. Events are processed in the order they were observed, starting with the events observed after the
+	// subscription was created.
+	// +optional
+	LastEvent string `json:"lastEvent,omitempty"`
+	// +optional
+	LastEventObserved *MicroTime `json:"lastEventObserved,omitempty" wrangler:"type=string"`
+
+	// Delivered is the number of events delivered.
+	Delivered int64 `json:"delivered,omitempty"`
+
+	// ConsecutiveFailures is the number of failed attempts to deliver the next event. Failed deliveries are retried
+	// with exponential backoff.
+	// +optional
+	ConsecutiveFailures int `json:"consecutiveFailures,omitempty"`
+
+	// LastError is the error of the last failed delivery attempt.
+	// +optional
+	LastError string `json:"lastError,omitempty"`
+
+	// LastAttempt is the time of the last delivery attempt.
+	// +optional
+	LastAttempt *metav1.Time `json:"lastAttempt,omitempty"`
+}
@@ -35,6 +35,11 @@ type ImageAllowRuleSignatures struct {
 	Rules []SignatureRules `json:"rules,omitempty"`
 }
 
+type ImageAllowRuleSBOM struct {
+	// Required denies images that have no SBOM attestation
+	Required bool `json:"required,omitempty"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 type ImageAllowRuleInstance struct {
@@ -43,6 +48,7 @@ type ImageAllowRuleInstance struct {
 
 	Images     []string                 `json:"images,omitempty"` // list of patterns to match against image names
 	Signatures ImageAllowRuleSignatures `json:"signatures,omitempty"`
+	SBOM       ImageAllowRuleSBOM       `json:"sbom,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
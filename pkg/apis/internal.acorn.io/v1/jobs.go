@@ -0,0 +1,70 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var ErrInvalidJobSettings = errors.New("invalid job settings")
+
+type ConcurrencyPolicy string
+
+const (
+	// ConcurrencyAllow allows runs of a scheduled job to overlap
+	ConcurrencyAllow ConcurrencyPolicy = "allow"
+	// ConcurrencyForbid skips a scheduled run if the previous run has not finished
+	ConcurrencyForbid ConcurrencyPolicy = "forbid"
+	// ConcurrencyReplace cancels the previous run if it has not finished when the next run is scheduled
+	ConcurrencyReplace ConcurrencyPolicy = "replace"
+)
+
+// JobHistory is the number of finished runs of a scheduled job to keep
+type JobHistory struct {
+	Successful *int32 `json:"successful,omitempty"`
+	Failed     *int32 `json:"failed,omitempty"`
+}
+
+// ValidateJobSettings checks the settings that only apply to jobs
+func (in Container) ValidateJobSettings() error {
+	switch in.Concurrency {
+	case "", ConcurrencyAllow, ConcurrencyForbid, ConcurrencyReplace:
+	default:
+		return fmt.Errorf("%w: concurrency must be one of %s, %s, or %s, not %s", ErrInvalidJobSettings,
+			ConcurrencyAllow, ConcurrencyForbid, ConcurrencyReplace, in.Concurrency)
+	}
+	if in.Schedule == "" && (in.Concurrency != "" || in.History != nil) {
+		return fmt.Errorf("%w: concurrency and history can only be set on jobs with a schedule", ErrInvalidJobSettings)
+	}
+	if _, err := in.GetDeadline(); err != nil {
+		return err
+	}
+	if in.Retries != nil && *in.Retries < 0 {
+		return fmt.Errorf("%w: retries %d must not be negative", ErrInvalidJobSettings, *in.Retries)
+	}
+	if in.History != nil {
+		if in.History.Successful != nil && *in.History.Successful < 0 {
+			return fmt.Errorf("%w: successful history %d must not be negative", ErrInvalidJobSettings, *in.History.Successful)
+		}
+		if in.History.Failed != nil && *in.History.Failed < 0 {
+			return fmt.Errorf("%w: failed history %d must not be negative", ErrInvalidJobSettings, *in.History.Failed)
+		}
+	}
+	return nil
+}
+
+// GetDeadline returns the deadline of a job rounded up to the nearest second, or nil if the job has no deadline
+func (in Container) GetDeadline() (*int64, error) {
+	if in.Deadline == "" {
+		return nil, nil
+	}
+	d, err := time.ParseDuration(in.Deadline)
+	if err != nil {
+		return nil, fmt.Errorf("%w: deadline %s: %v", ErrInvalidJobSettings, in.Deadline, err)
+	}
+	if d <= 0 {
+		return nil, fmt.Errorf("%w: deadline %s must be positive", ErrInvalidJobSettings, in.Deadline)
+	}
+	seconds := int64((d + time.Second - 1) / time.Second)
+	return &seconds, nil
+}
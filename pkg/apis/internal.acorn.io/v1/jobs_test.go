@@ -0,0 +1,92 @@
+package v1
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJobSettingsUnmarshal(t *testing.T) {
+	var c Container
+	err := json.Unmarshal([]byte(`{"schedule": "daily", "concurrency": "forbid", "deadline": "1m30s", "retries": 3, "history": {"successful": 2, "failed": 5}}`), &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, ConcurrencyForbid, c.Concurrency)
+	assert.Equal(t, int32(3), *c.Retries)
+	if assert.NotNil(t, c.History) {
+		assert.Equal(t, int32(2), *c.History.Successful)
+		assert.Equal(t, int32(5), *c.History.Failed)
+	}
+	assert.NoError(t, c.ValidateJobSettings())
+
+	deadline, err := c.GetDeadline()
+	if assert.NoError(t, err) {
+		assert.Equal(t, int64(90), *deadline)
+	}
+}
+
+func TestJobSettingsValidate(t *testing.T) {
+	negative := int32(-1)
+	tests := []struct {
+		name      string
+		container Container
+		valid     bool
+	}{
+		{
+			name:  "empty",
+			valid: true,
+		},
+		{
+			name:      "deadline without schedule",
+			container: Container{Deadline: "10m"},
+			valid:     true,
+		},
+		{
+			name:      "deadline rounds up",
+			container: Container{Deadline: "1500ms"},
+			valid:     true,
+		},
+		{
+			name:      "invalid concurrency",
+			container: Container{Schedule: "daily", Concurrency: "sometimes"},
+		},
+		{
+			name:      "concurrency without schedule",
+			container: Container{Concurrency: ConcurrencyAllow},
+		},
+		{
+			name:      "history without schedule",
+			container: Container{History: &JobHistory{}},
+		},
+		{
+			name:      "invalid deadline",
+			container: Container{Deadline: "ten minutes"},
+		},
+		{
+			name:      "zero deadline",
+			container: Container{Deadline: "0s"},
+		},
+		{
+			name:      "negative retries",
+			container: Container{Retries: &negative},
+		},
+		{
+			name:      "negative history",
+			container: Container{Schedule: "daily", History: &JobHistory{Failed: &negative}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.container.ValidateJobSettings()
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.True(t, errors.Is(err, ErrInvalidJobSettings), "expected ErrInvalidJobSettings, got %v", err)
+			}
+		})
+	}
+}
@@ -98,6 +98,34 @@ func TestParseVolumesWithBinding(t *testing.T) {
 	}, vs[6])
 }
 
+func TestParseVolumesWithSnapshot(t *testing.T) {
+	vs, err := ParseVolumes([]string{"data,snapshot=before-upgrade,size=20G"}, true)
+	assert.NoError(t, err)
+	assert.Equal(t, []VolumeBinding{{
+		Target:   "data",
+		Size:     "20G",
+		Snapshot: "before-upgrade",
+	}}, vs)
+
+	_, err = ParseVolumes([]string{"mydata:data,snapshot=before-upgrade"}, true)
+	assert.Error(t, err)
+}
+
+func TestParseVolumesWithClone(t *testing.T) {
+	vs, err := ParseVolumes([]string{"data,clone-of=staging.db"}, true)
+	assert.NoError(t, err)
+	assert.Equal(t, []VolumeBinding{{
+		Target:  "data",
+		CloneOf: "staging.db",
+	}}, vs)
+
+	_, err = ParseVolumes([]string{"mydata:data,clone-of=staging.db"}, true)
+	assert.Error(t, err)
+
+	_, err = ParseVolumes([]string{"data,clone-of=staging.db,snapshot=before-upgrade"}, true)
+	assert.Error(t, err)
+}
+
 func TestParsePorts(t *testing.T) {
 	tests := []struct {
 		name       string
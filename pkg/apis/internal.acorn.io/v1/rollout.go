@@ -0,0 +1,169 @@
+package v1
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type RolloutStrategyType string
+
+const (
+	RolloutStrategyRolling   RolloutStrategyType = "rolling"
+	RolloutStrategyBlueGreen RolloutStrategyType = "blueGreen"
+	RolloutStrategyCanary    RolloutStrategyType = "canary"
+
+	DefaultRolloutProgressDeadline = 10 * time.Minute
+)
+
+var DefaultCanarySteps = []RolloutStep{
+	{Weight: 20},
+	{Weight: 50},
+}
+
+type RolloutStrategy struct {
+	Type RolloutStrategyType `json:"type,omitempty"`
+	// Steps are the traffic weights the new revision goes through before it is promoted. For blueGreen only the
+	// pause of the first step is used, as the time to keep the new revision in preview after it is ready.
+	Steps []RolloutStep `json:"steps,omitempty"`
+	// ProgressDeadline is how long a step may take to become ready before the rollout is rolled back (ex: 10m)
+	ProgressDeadline string `json:"progressDeadline,omitempty"`
+}
+
+type RolloutStep struct {
+	// Weight is the percentage of the published traffic that is sent to the new revision
+	Weight int32 `json:"weight,omitempty"`
+	// Pause is how long to wait after the step is ready before moving to the next step (ex: 5m)
+	Pause string `json:"pause,omitempty"`
+}
+
+func (in *RolloutStrategy) GetType() RolloutStrategyType {
+	if in == nil || in.Type == "" {
+		return RolloutStrategyRolling
+	}
+	return in.Type
+}
+
+func (in *RolloutStrategy) GetSteps() []RolloutStep {
+	if in == nil {
+		return nil
+	}
+	if in.Type == RolloutStrategyCanary && len(in.Steps) == 0 {
+		return DefaultCanarySteps
+	}
+	return in.Steps
+}
+
+func (in *RolloutStrategy) GetProgressDeadline() time.Duration {
+	if in == nil || in.ProgressDeadline == "" {
+		return DefaultRolloutProgressDeadline
+	}
+	d, err := time.ParseDuration(in.ProgressDeadline)
+	if err != nil {
+		return DefaultRolloutProgressDeadline
+	}
+	return d
+}
+
+func (in RolloutStep) GetPause() time.Duration {
+	d, _ := time.ParseDuration(in.Pause)
+	return d
+}
+
+func (in *RolloutStrategy) Validate() error {
+	if in == nil {
+		return nil
+	}
+	switch in.Type {
+	case "", RolloutStrategyRolling, RolloutStrategyBlueGreen, RolloutStrategyCanary:
+	default:
+		return fmt.Errorf("invalid rollout strategy [%s], must be one of %s, %s, or %s", in.Type,
+			RolloutStrategyRolling, RolloutStrategyBlueGreen, RolloutStrategyCanary)
+	}
+	if in.ProgressDeadline != "" {
+		if _, err := time.ParseDuration(in.ProgressDeadline); err != nil {
+			return fmt.Errorf("invalid rollout progress deadline [%s]: %w", in.ProgressDeadline, err)
+		}
+	}
+	var lastWeight int32
+	for _, step := range in.Steps {
+		if step.Weight <= lastWeight || step.Weight > 100 {
+			return fmt.Errorf("invalid rollout step weight [%d], weights must be increasing and between 1 and 100", step.Weight)
+		}
+		lastWeight = step.Weight
+		if step.Pause != "" {
+			if _, err := time.ParseDuration(step.Pause); err != nil {
+				return fmt.Errorf("invalid rollout step pause [%s]: %w", step.Pause, err)
+			}
+		}
+	}
+	return nil
+}
+
+// ParseRolloutStrategy parses the format TYPE[:WEIGHT[/PAUSE],...] (ex: blueGreen, canary:10/5m,50/5m)
+func ParseRolloutStrategy(s string) (*RolloutStrategy, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	strategyType, steps, _ := strings.Cut(s, ":")
+	result := &RolloutStrategy{
+		Type: RolloutStrategyType(strategyType),
+	}
+	if steps != "" {
+		for _, step := range strings.Split(steps, ",") {
+			weight, pause, _ := strings.Cut(step, "/")
+			i, err := strconv.ParseInt(strings.TrimSuffix(weight, "%"), 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("invalid rollout step [%s]: %w", step, err)
+			}
+			result.Steps = append(result.Steps, RolloutStep{
+				Weight: int32(i),
+				Pause:  pause,
+			})
+		}
+	}
+
+	return result, result.Validate()
+}
+
+type RolloutPhase string
+
+const (
+	RolloutPhaseProgressing RolloutPhase = "progressing"
+	RolloutPhasePromoting   RolloutPhase = "promoting"
+	RolloutPhaseCompleted   RolloutPhase = "completed"
+	RolloutPhaseRolledBack  RolloutPhase = "rolledBack"
+)
+
+type RolloutStatus struct {
+	Strategy RolloutStrategyType `json:"strategy,omitempty"`
+	Phase    RolloutPhase        `json:"phase,omitempty"`
+	// Revision is the digest of the app image being rolled out
+	Revision      string      `json:"revision,omitempty"`
+	Step          int32       `json:"step,omitempty"`
+	Weight        int32       `json:"weight,omitempty"`
+	StepStartTime metav1.Time `json:"stepStartTime,omitempty"`
+	StepReadyTime metav1.Time `json:"stepReadyTime,omitempty"`
+	Message       string      `json:"message,omitempty"`
+	// Previous is the revision that keeps serving traffic until the rollout is promoted
+	Previous *RolloutRevision `json:"previous,omitempty"`
+}
+
+type RolloutRevision struct {
+	AppImage AppImage `json:"appImage,omitempty"`
+	AppSpec  AppSpec  `json:"appSpec,omitempty"`
+}
+
+// IsActive returns true if workloads of the previous revision are still running
+func (in *RolloutStatus) IsActive() bool {
+	return in != nil && in.Previous != nil && in.Phase != RolloutPhaseCompleted
+}
+
+// IsProgressing returns true if workloads of both the previous and the new revision are running
+func (in *RolloutStatus) IsProgressing() bool {
+	return in.IsActive() && (in.Phase == RolloutPhaseProgressing || in.Phase == RolloutPhasePromoting)
+}
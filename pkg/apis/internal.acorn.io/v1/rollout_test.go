@@ -0,0 +1,75 @@
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRolloutStrategy(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected *RolloutStrategy
+		wantErr  bool
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:     "blue green",
+			input:    "blueGreen",
+			expected: &RolloutStrategy{Type: RolloutStrategyBlueGreen},
+		},
+		{
+			name:  "canary with steps",
+			input: "canary:10/5m,50%/1h,100",
+			expected: &RolloutStrategy{
+				Type: RolloutStrategyCanary,
+				Steps: []RolloutStep{
+					{Weight: 10, Pause: "5m"},
+					{Weight: 50, Pause: "1h"},
+					{Weight: 100},
+				},
+			},
+		},
+		{
+			name:    "unknown type",
+			input:   "linear",
+			wantErr: true,
+		},
+		{
+			name:    "decreasing weights",
+			input:   "canary:50,10",
+			wantErr: true,
+		},
+		{
+			name:    "bad pause",
+			input:   "canary:10/soon",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			strategy, err := ParseRolloutStrategy(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, strategy)
+		})
+	}
+}
+
+func TestRolloutStrategyDefaults(t *testing.T) {
+	var strategy *RolloutStrategy
+	assert.Equal(t, RolloutStrategyRolling, strategy.GetType())
+	assert.Equal(t, DefaultRolloutProgressDeadline, strategy.GetProgressDeadline())
+
+	strategy = &RolloutStrategy{Type: RolloutStrategyCanary, ProgressDeadline: "2m"}
+	assert.Equal(t, DefaultCanarySteps, strategy.GetSteps())
+	assert.Equal(t, 2*time.Minute, strategy.GetProgressDeadline())
+}
@@ -9,6 +9,8 @@ const (
 	SecretTypeTemplate  corev1.SecretType = "secrets.acorn.io/template"
 	SecretTypeBasic     corev1.SecretType = "secrets.acorn.io/basic"
 	SecretTypeToken     corev1.SecretType = "secrets.acorn.io/token"
+	SecretTypeExternal  corev1.SecretType = "secrets.acorn.io/external"
+	SecretTypeTLS       corev1.SecretType = "secrets.acorn.io/tls"
 )
 
 var (
@@ -18,5 +20,14 @@ var (
 		SecretTypeTemplate:  true,
 		SecretTypeBasic:     true,
 		SecretTypeToken:     true,
+		SecretTypeTLS:       true,
 	}
 )
+
+// SecretRotation regenerates the generated values of a secret on an interval or a cron schedule
+type SecretRotation struct {
+	Interval string `json:"interval,omitempty"`
+	Schedule string `json:"schedule,omitempty"`
+	// GracePeriod is how long the previous values are kept under keys prefixed with "previous-" after a rotation
+	GracePeriod string `json:"gracePeriod,omitempty"`
+}
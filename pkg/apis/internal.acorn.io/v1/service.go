@@ -59,6 +59,15 @@ type ServiceInstanceSpec struct {
 	Routes       []Route       `json:"routes,omitempty"`
 	PublishMode  PublishMode   `json:"publishMode,omitempty"`
 	Publish      []PortPublish `json:"publish,omitempty"`
+	// Canary routes a share of the published HTTP traffic to another container while a new revision is rolled out
+	Canary *ServiceCanary `json:"canary,omitempty"`
+}
+
+type ServiceCanary struct {
+	// Container is the name of the container whose pods receive the canary traffic
+	Container string `json:"container,omitempty"`
+	// Weight is the percentage of the traffic that is sent to the canary
+	Weight int32 `json:"weight,omitempty"`
 }
 
 type PortPublish struct {
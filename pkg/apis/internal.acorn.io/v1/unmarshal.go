@@ -690,6 +690,133 @@ func (in *Container) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+type autoscaleAliases struct {
+	Min *int32 `json:"min,omitempty"`
+	Max int32  `json:"max,omitempty"`
+}
+
+type autoscaleTargets struct {
+	CPU    json.RawMessage `json:"cpu,omitempty"`
+	Memory json.RawMessage `json:"memory,omitempty"`
+}
+
+func (in *Autoscale) UnmarshalJSON(data []byte) error {
+	var (
+		a       Autoscale
+		alias   autoscaleAliases
+		targets autoscaleTargets
+		err     error
+	)
+	var base struct {
+		MinReplicas *int32           `json:"minReplicas,omitempty"`
+		MaxReplicas int32            `json:"maxReplicas,omitempty"`
+		Metrics     AutoscaleMetrics `json:"metrics,omitempty"`
+	}
+	if err := json.Unmarshal(data, &base); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, &alias); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, &targets); err != nil {
+		return err
+	}
+
+	a.MinReplicas, a.MaxReplicas, a.Metrics = base.MinReplicas, base.MaxReplicas, base.Metrics
+	if alias.Min != nil {
+		a.MinReplicas = alias.Min
+	}
+	if alias.Max != 0 {
+		a.MaxReplicas = alias.Max
+	}
+	if a.CPU, err = parsePercent(targets.CPU); err != nil {
+		return fmt.Errorf("invalid autoscale cpu target: %w", err)
+	}
+	if a.Memory, err = parsePercent(targets.Memory); err != nil {
+		return fmt.Errorf("invalid autoscale memory target: %w", err)
+	}
+
+	*in = a
+	return nil
+}
+
+func (in *AutoscaleMetric) UnmarshalJSON(data []byte) error {
+	var m struct {
+		Name   string          `json:"name,omitempty"`
+		Target json.RawMessage `json:"target,omitempty"`
+	}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return err
+	}
+	in.Name = m.Name
+	in.Target = ""
+	if len(m.Target) == 0 {
+		return nil
+	}
+	if isString(m.Target) {
+		s, err := parseString(m.Target)
+		if err != nil {
+			return err
+		}
+		in.Target = s
+		return nil
+	}
+	var n json.Number
+	if err := json.Unmarshal(m.Target, &n); err != nil {
+		return err
+	}
+	in.Target = n.String()
+	return nil
+}
+
+// parsePercent accepts either a number (70) or a percentage string ("70%")
+func parsePercent(data json.RawMessage) (*int32, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+	s := string(data)
+	if isString(data) {
+		var err error
+		s, err = parseString(data)
+		if err != nil {
+			return nil, err
+		}
+	}
+	i, err := strconv.ParseInt(strings.TrimSpace(strings.TrimSuffix(s, "%")), 10, 32)
+	if err != nil {
+		return nil, err
+	}
+	result := int32(i)
+	return &result, nil
+}
+
+func (in *TopologySpread) UnmarshalJSON(data []byte) error {
+	if isString(data) {
+		s, err := parseString(data)
+		if err != nil {
+			return err
+		}
+		*in = TopologySpread{Topology: s}
+		return nil
+	}
+	type topologySpread TopologySpread
+	return json.Unmarshal(data, (*topologySpread)(in))
+}
+
+func (in *TopologySpreads) UnmarshalJSON(data []byte) error {
+	if isArray(data) {
+		return json.Unmarshal(data, (*[]TopologySpread)(in))
+	}
+
+	var spread TopologySpread
+	if err := json.Unmarshal(data, &spread); err != nil {
+		return err
+	}
+
+	*in = append(*in, spread)
+	return nil
+}
+
 type policyRuleAliases struct {
 	Verb         string   `json:"verb,omitempty"`
 	APIGroup     string   `json:"apiGroup,omitempty"`
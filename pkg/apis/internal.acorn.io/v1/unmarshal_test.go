@@ -1,10 +1,13 @@
 package v1
 
 import (
+	"encoding/json"
 	"os"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 func TestParseHostnameBinding(t *testing.T) {
@@ -43,3 +46,64 @@ func TestParseEnv(t *testing.T) {
 		Value: "y111",
 	}, f[1])
 }
+
+func TestAutoscaleUnmarshal(t *testing.T) {
+	var c Container
+	err := json.Unmarshal([]byte(`{"scale": 2, "autoscale": {"max": 10, "cpu": "70%", "memory": 80, "metrics": [{"name": "rps", "target": 100}]}}`), &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if assert.NotNil(t, c.Autoscale) {
+		assert.Nil(t, c.Autoscale.MinReplicas)
+		assert.Equal(t, int32(2), c.Autoscale.GetMinReplicas(c.Scale))
+		assert.Equal(t, int32(10), c.Autoscale.MaxReplicas)
+		assert.Equal(t, int32(70), *c.Autoscale.CPU)
+		assert.Equal(t, int32(80), *c.Autoscale.Memory)
+		assert.Equal(t, AutoscaleMetrics{{Name: "rps", Target: "100"}}, c.Autoscale.Metrics)
+		assert.NoError(t, c.Autoscale.Validate(c.Scale))
+	}
+}
+
+func TestAutoscaleUnmarshalLongForm(t *testing.T) {
+	var a Autoscale
+	err := json.Unmarshal([]byte(`{"minReplicas": 1, "maxReplicas": 5, "cpu": 60}`), &a)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, int32(1), *a.MinReplicas)
+	assert.Equal(t, int32(5), a.MaxReplicas)
+	assert.Equal(t, int32(60), *a.CPU)
+	assert.Nil(t, a.Memory)
+
+	err = json.Unmarshal([]byte(`{"max": 5, "cpu": "lots"}`), &a)
+	assert.Error(t, err)
+}
+
+func TestDisruptionUnmarshal(t *testing.T) {
+	var c Container
+	err := json.Unmarshal([]byte(`{"availability": {"minAvailable": "50%"}, "spread": ["zone", {"topology": "host", "maxSkew": 2, "required": true}]}`), &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if assert.NotNil(t, c.Availability) {
+		assert.Nil(t, c.Availability.MaxUnavailable)
+		assert.Equal(t, intstr.FromString("50%"), *c.Availability.MinAvailable)
+	}
+	if assert.Len(t, c.Spread, 2) {
+		assert.Equal(t, corev1.LabelTopologyZone, c.Spread[0].TopologyKey())
+		assert.Equal(t, int32(1), c.Spread[0].GetMaxSkew())
+		assert.False(t, c.Spread[0].Required)
+		assert.Equal(t, corev1.LabelHostname, c.Spread[1].TopologyKey())
+		assert.Equal(t, int32(2), c.Spread[1].GetMaxSkew())
+		assert.True(t, c.Spread[1].Required)
+	}
+
+	c = Container{}
+	if err := json.Unmarshal([]byte(`{"spread": "host"}`), &c); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, TopologySpreads{{Topology: TopologyHost}}, c.Spread)
+}
@@ -35,6 +35,14 @@ func ParseVolumes(args []string, fromCLI bool) (result []VolumeBinding, _ error)
 				return nil, fmt.Errorf("parsing [%s]: %w", arg, err)
 			}
 			volumeBinding.Size = q
+			volumeBinding.Snapshot = strings.TrimSpace(kvOpts["snapshot"])
+			if volumeBinding.Snapshot != "" && volumeBinding.Volume != "" {
+				return nil, fmt.Errorf("invalid volume binding [%s], can not bind an existing volume and a snapshot", arg)
+			}
+			volumeBinding.CloneOf = strings.TrimSpace(kvOpts["clone-of"])
+			if volumeBinding.CloneOf != "" && (volumeBinding.Volume != "" || volumeBinding.Snapshot != "") {
+				return nil, fmt.Errorf("invalid volume binding [%s], can not clone a volume and bind an existing volume or a snapshot", arg)
+			}
 		} else if len(kvOpts) > 0 {
 			return nil, fmt.Errorf("options [%s] are not supported in acorn volume binding definition", opts)
 		}
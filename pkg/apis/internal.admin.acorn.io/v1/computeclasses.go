@@ -50,6 +50,7 @@ type ProjectComputeClassInstance struct {
 	Affinity          *corev1.Affinity    `json:"affinity,omitempty"`
 	Tolerations       []corev1.Toleration `json:"tolerations,omitempty"`
 	Memory            ComputeClassMemory  `json:"memory,omitempty"`
+	CPU               ComputeClassCPU     `json:"cpu,omitempty"`
 	SupportedRegions  []string            `json:"supportedRegions,omitempty"`
 	PriorityClassName string              `json:"priorityClassName,omitempty"`
 }
@@ -68,3 +69,12 @@ type ComputeClassMemory struct {
 	Default string   `json:"default,omitempty"`
 	Values  []string `json:"values,omitempty"`
 }
+
+// ComputeClassCPU limits the CPU that workloads of the class can request. Values are a number of cores or a
+// quantity (ex: "2" or "500m").
+type ComputeClassCPU struct {
+	Min     string   `json:"min,omitempty"`
+	Max     string   `json:"max,omitempty"`
+	Default string   `json:"default,omitempty"`
+	Values  []string `json:"values,omitempty"`
+}
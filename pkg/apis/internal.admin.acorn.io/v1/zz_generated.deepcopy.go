@@ -29,6 +29,7 @@ func (in *ClusterComputeClassInstance) DeepCopyInto(out *ClusterComputeClassInst
 		}
 	}
 	in.Memory.DeepCopyInto(&out.Memory)
+	in.CPU.DeepCopyInto(&out.CPU)
 	if in.SupportedRegions != nil {
 		in, out := &in.SupportedRegions, &out.SupportedRegions
 		*out = make([]string, len(*in))
@@ -154,6 +155,26 @@ func (in *ClusterVolumeClassInstanceList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComputeClassCPU) DeepCopyInto(out *ComputeClassCPU) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComputeClassCPU.
+func (in *ComputeClassCPU) DeepCopy() *ComputeClassCPU {
+	if in == nil {
+		return nil
+	}
+	out := new(ComputeClassCPU)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ComputeClassMemory) DeepCopyInto(out *ComputeClassMemory) {
 	*out = *in
@@ -192,6 +213,7 @@ func (in *ProjectComputeClassInstance) DeepCopyInto(out *ProjectComputeClassInst
 		}
 	}
 	in.Memory.DeepCopyInto(&out.Memory)
+	in.CPU.DeepCopyInto(&out.CPU)
 	if in.SupportedRegions != nil {
 		in, out := &in.SupportedRegions, &out.SupportedRegions
 		*out = make([]string, len(*in))
@@ -14,6 +14,7 @@ import (
 	"github.com/acorn-io/aml/pkg/cue"
 	"github.com/acorn-io/baaah/pkg/typed"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/appdefinition/schema"
 	"sigs.k8s.io/yaml"
 )
 
@@ -130,7 +131,9 @@ func (a *AppDefinition) JSON() (string, error) {
 	return string(app), err
 }
 
+// newDecoder returns the decoder of the Acornfile, which validates it against the schema of the runtime
 func (a *AppDefinition) newDecoder() *aml.Decoder {
+	schema.Install()
 	return aml.NewDecoder(bytes.NewReader(a.data), aml.Options{
 		Args:      a.args,
 		Profiles:  a.profiles,
@@ -12,6 +12,7 @@ import (
 	"github.com/acorn-io/baaah/pkg/typed"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 func TestParseRouters(t *testing.T) {
@@ -1437,6 +1438,230 @@ jobs: job2: image: "job2-image"
 	assert.Equal(t, "job2-image", appSpec.Jobs["job2"].Image)
 }
 
+func TestJobSettings(t *testing.T) {
+	acornCue := `
+jobs: backup: {
+	image: "my-app"
+	schedule: "@daily"
+	concurrency: "forbid"
+	deadline: "2h"
+	retries: 2
+	history: {
+		successful: 5
+		failed: 5
+	}
+}
+`
+	def, err := NewAppDefinition([]byte(acornCue))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	appSpec, err := def.AppSpec()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	job := appSpec.Jobs["backup"]
+	assert.Equal(t, v1.ConcurrencyForbid, job.Concurrency)
+	assert.Equal(t, "2h", job.Deadline)
+	assert.Equal(t, int32(2), *job.Retries)
+	assert.Equal(t, &v1.JobHistory{Successful: &[]int32{5}[0], Failed: &[]int32{5}[0]}, job.History)
+
+	_, err = NewAppDefinition([]byte(`jobs: backup: concurrency: "sometimes"`))
+	assert.Error(t, err)
+}
+
+func TestJobEvents(t *testing.T) {
+	acornCue := `
+jobs: migrate: {
+	image: "my-app"
+	events: ["create", "pre-update"]
+}
+jobs: warmup: {
+	image: "my-app"
+	events: ["post-update", "start"]
+}
+`
+	def, err := NewAppDefinition([]byte(acornCue))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	appSpec, err := def.AppSpec()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, []string{"create", "pre-update"}, appSpec.Jobs["migrate"].Events)
+	assert.Equal(t, []string{"post-update", "start"}, appSpec.Jobs["warmup"].Events)
+
+	_, err = NewAppDefinition([]byte(`jobs: migrate: events: ["restart"]`))
+	assert.Error(t, err)
+}
+
+func TestExternalSecret(t *testing.T) {
+	acornCue := `
+secrets: "db-creds": {
+	type: "external"
+	params: {
+		provider: "vault"
+		path: "secret/my-app/db"
+		version: "3"
+		refresh: "1m"
+	}
+}
+`
+	def, err := NewAppDefinition([]byte(acornCue))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	appSpec, err := def.AppSpec()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	secret := appSpec.Secrets["db-creds"]
+	assert.Equal(t, "external", secret.Type)
+	assert.Equal(t, "vault", secret.Params["provider"])
+	assert.Equal(t, "secret/my-app/db", secret.Params["path"])
+	assert.Equal(t, "3", secret.Params["version"])
+	assert.Equal(t, "1m", secret.Params["refresh"])
+
+	_, err = NewAppDefinition([]byte(`secrets: "db-creds": {type: "external", params: {provider: "s3", path: "db"}}`))
+	assert.Error(t, err)
+}
+
+func TestSecretRotation(t *testing.T) {
+	acornCue := `
+secrets: "db-password": {
+	type: "token"
+	rotate: {
+		interval: "720h"
+		gracePeriod: "1h"
+	}
+}
+secrets: "api-creds": {
+	type: "basic"
+	rotate: schedule: "0 3 * * 0"
+}
+`
+	def, err := NewAppDefinition([]byte(acornCue))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	appSpec, err := def.AppSpec()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, &v1.SecretRotation{
+		Interval:    "720h",
+		GracePeriod: "1h",
+	}, appSpec.Secrets["db-password"].Rotate)
+	assert.Equal(t, &v1.SecretRotation{
+		Schedule: "0 3 * * 0",
+	}, appSpec.Secrets["api-creds"].Rotate)
+
+	_, err = NewAppDefinition([]byte(`secrets: "db-password": {type: "token", rotate: {interval: "1h", schedule: "0 3 * * 0"}}`))
+	assert.Error(t, err)
+
+	_, err = NewAppDefinition([]byte(`secrets: "config": {type: "opaque", rotate: interval: "1h"}`))
+	assert.Error(t, err)
+}
+
+func TestTLSSecret(t *testing.T) {
+	acornCue := `
+secrets: "internal-ca": type: "tls"
+secrets: "web-cert": {
+	type: "tls"
+	params: {
+		ca: "internal-ca"
+		service: "web"
+	}
+}
+secrets: "worker-cert": {
+	type: "tls"
+	params: {
+		ca: "internal-ca"
+		sans: ["worker", "worker.example.com"]
+		duration: "720h"
+		renewBefore: "168h"
+	}
+}
+`
+	def, err := NewAppDefinition([]byte(acornCue))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	appSpec, err := def.AppSpec()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "tls", appSpec.Secrets["internal-ca"].Type)
+	assert.Equal(t, "internal-ca", appSpec.Secrets["web-cert"].Params["ca"])
+	assert.Equal(t, "web", appSpec.Secrets["web-cert"].Params["service"])
+	assert.Equal(t, []any{"worker", "worker.example.com"}, appSpec.Secrets["worker-cert"].Params["sans"])
+	assert.Equal(t, "720h", appSpec.Secrets["worker-cert"].Params["duration"])
+	assert.Equal(t, "168h", appSpec.Secrets["worker-cert"].Params["renewBefore"])
+
+	_, err = NewAppDefinition([]byte(`secrets: "web-cert": {type: "tls", params: sans: "worker"}`))
+	assert.Error(t, err)
+}
+
+func TestVolumeBackup(t *testing.T) {
+	acornCue := `
+secrets: "minio-creds": external: "minio-creds"
+volumes: data: {
+	size: "10G"
+	backup: {
+		schedule: "daily"
+		to: "s3://backups/db/?endpoint=http://minio.minio:9000"
+		secret: "minio-creds"
+	}
+}
+`
+	def, err := NewAppDefinition([]byte(acornCue))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	appSpec, err := def.AppSpec()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, &v1.VolumeBackup{
+		Schedule: "daily",
+		To:       "s3://backups/db/?endpoint=http://minio.minio:9000",
+		Secret:   "minio-creds",
+	}, appSpec.Volumes["data"].Backup)
+
+	_, err = NewAppDefinition([]byte(`volumes: data: backup: {schedule: "daily", to: "https://backups/db/"}`))
+	assert.Error(t, err)
+}
+
+func TestVolumeFrom(t *testing.T) {
+	def, err := NewAppDefinition([]byte(`volumes: data: from: "staging.data"`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	appSpec, err := def.AppSpec()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "staging.data", appSpec.Volumes["data"].From)
+
+	_, err = NewAppDefinition([]byte(`volumes: data: from: ["staging.data"]`))
+	assert.Error(t, err)
+}
+
 func TestNonUnique(t *testing.T) {
 	acornCue := `
 containers: foo: image: "test"
@@ -1520,6 +1745,135 @@ containers: zero: scale: 0
 	assert.Equal(t, int32(0), *appSpec.Containers["zero"].Scale)
 }
 
+func TestAutoscale(t *testing.T) {
+	acornCue := `
+containers: web: {
+	image: "nginx"
+	autoscale: {
+		min: 2
+		max: 10
+		cpu: "70%"
+		metrics: [{name: "http_requests_per_second", target: 100}]
+	}
+}
+containers: api: {
+	image: "nginx"
+	autoscale: {
+		maxReplicas: 4
+		memory: 80
+	}
+}
+`
+	def, err := NewAppDefinition([]byte(acornCue))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	appSpec, err := def.AppSpec()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	web := appSpec.Containers["web"].Autoscale
+	assert.Equal(t, int32(2), *web.MinReplicas)
+	assert.Equal(t, int32(10), web.MaxReplicas)
+	assert.Equal(t, int32(70), *web.CPU)
+	assert.Equal(t, v1.AutoscaleMetrics{{Name: "http_requests_per_second", Target: "100"}}, web.Metrics)
+
+	api := appSpec.Containers["api"].Autoscale
+	assert.Nil(t, api.MinReplicas)
+	assert.Equal(t, int32(4), api.MaxReplicas)
+	assert.Equal(t, int32(80), *api.Memory)
+
+	_, err = NewAppDefinition([]byte(`containers: web: autoscale: cpu: "lots"`))
+	assert.Error(t, err)
+
+	_, err = NewAppDefinition([]byte(`containers: web: autoscale: {maximum: 4, max: 4}`))
+	assert.Error(t, err)
+}
+
+func TestAvailabilityAndSpread(t *testing.T) {
+	acornCue := `
+containers: web: {
+	image: "nginx"
+	scale: 4
+	availability: maxUnavailable: "25%"
+	spread: ["zone", {topology: "host", maxSkew: 2, required: true}]
+}
+containers: api: {
+	image: "nginx"
+	availability: minAvailable: 1
+	spread: "host"
+}
+`
+	def, err := NewAppDefinition([]byte(acornCue))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	appSpec, err := def.AppSpec()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	web := appSpec.Containers["web"]
+	assert.Equal(t, intstr.FromString("25%"), *web.Availability.MaxUnavailable)
+	assert.Equal(t, v1.TopologySpreads{
+		{Topology: v1.TopologyZone},
+		{Topology: v1.TopologyHost, MaxSkew: 2, Required: true},
+	}, web.Spread)
+
+	api := appSpec.Containers["api"]
+	assert.Equal(t, intstr.FromInt(1), *api.Availability.MinAvailable)
+	assert.Equal(t, v1.TopologySpreads{{Topology: v1.TopologyHost}}, api.Spread)
+
+	_, err = NewAppDefinition([]byte(`containers: web: availability: maxUnavailable: "most"`))
+	assert.Error(t, err)
+}
+
+func TestCPU(t *testing.T) {
+	acornCue := `
+containers: web: {
+	image: "nginx"
+	cpu: 0.5
+	sidecars: log: {
+		image: "busybox"
+		cpu: "250m"
+	}
+}
+jobs: migrate: {
+	image: "nginx"
+	cpu: 2
+}
+acorns: nested: {
+	image: "nested"
+	cpu: web: "100m"
+}
+services: db: {
+	image: "db"
+	cpu: 1
+}
+`
+	def, err := NewAppDefinition([]byte(acornCue))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	appSpec, err := def.AppSpec()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, v1.MilliCPU(500), *appSpec.Containers["web"].CPU)
+	assert.Equal(t, v1.MilliCPU(250), *appSpec.Containers["web"].Sidecars["log"].CPU)
+	assert.Equal(t, v1.MilliCPU(2000), *appSpec.Jobs["migrate"].CPU)
+	assert.Equal(t, v1.MilliCPU(100), *appSpec.Acorns["nested"].CPU["web"])
+	assert.Equal(t, v1.MilliCPU(1000), *appSpec.Services["db"].CPU[""])
+
+	_, err = NewAppDefinition([]byte(`containers: web: cpu: "lots"`))
+	assert.Error(t, err)
+}
+
 func TestBuildProfileParameters(t *testing.T) {
 	acornCue := `
 args: {
@@ -0,0 +1,25 @@
+// Package schema holds the Acornfile schema used to validate Acornfiles. It is a copy of the schema embedded in
+// acorn-io/aml at the version in go.mod, with the fields added that the runtime supports and aml doesn't have yet.
+// Fields added here should also be added to aml, and the copy taken from aml again when it is bumped.
+package schema
+
+import (
+	"embed"
+	"sync"
+
+	amlschema "github.com/acorn-io/aml/schema"
+)
+
+//go:embed v1
+var Files embed.FS
+
+var install sync.Once
+
+// Install makes acorn-io/aml validate Acornfiles against this schema. aml only reads the schema from its package
+// variable, so Install replaces it, and must be called before an Acornfile is decoded. It only replaces the schema
+// the first time it is called.
+func Install() {
+	install.Do(func() {
+		amlschema.Files = Files
+	})
+}
@@ -6,6 +6,7 @@ import (
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/config"
+	"github.com/acorn-io/runtime/pkg/event"
 	"github.com/acorn-io/runtime/pkg/imageallowrules"
 	"github.com/acorn-io/runtime/pkg/images"
 	tags2 "github.com/acorn-io/runtime/pkg/tags"
@@ -23,10 +24,12 @@ type daemonClient interface {
 	imageDigest(context.Context, string, string, ...remote.Option) (string, error)
 	resolveLocalTag(context.Context, string, string) (string, bool, error)
 	checkImageAllowed(context.Context, string, string) error
+	recordEvent(context.Context, *apiv1.Event) error
 }
 
 type client struct {
-	client kclient.Client
+	client   kclient.Client
+	recorder event.Recorder
 }
 
 func (c *client) getConfig(ctx context.Context) (*apiv1.Config, error) {
@@ -62,3 +65,7 @@ func (c *client) resolveLocalTag(ctx context.Context, namespace, name string) (s
 func (c *client) checkImageAllowed(ctx context.Context, namespace, name string) error {
 	return imageallowrules.CheckImageAllowed(ctx, c.client, namespace, name, "")
 }
+
+func (c *client) recordEvent(ctx context.Context, e *apiv1.Event) error {
+	return c.recorder.Record(ctx, e)
+}
@@ -8,7 +8,9 @@ import (
 	"github.com/acorn-io/baaah/pkg/router"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/config"
+	"github.com/acorn-io/runtime/pkg/event"
 	"github.com/acorn-io/runtime/pkg/imageallowrules"
+	"github.com/acorn-io/runtime/pkg/metrics"
 	imagename "github.com/google/go-containerregistry/pkg/name"
 	"github.com/sirupsen/logrus"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -34,19 +36,21 @@ func Sync() {
 type daemon struct {
 	client           daemonClient
 	appKeysPrevCheck map[kclient.ObjectKey]time.Time
+	// notAllowed is the last image found for an app that was not allowed, so it is only recorded once
+	notAllowed map[kclient.ObjectKey]string
 }
 
-func newDaemon(c kclient.Client) *daemon {
+func newDaemon(c kclient.Client, recorder event.Recorder) *daemon {
 	return &daemon{
-		client:           &client{client: c},
+		client:           &client{client: c, recorder: recorder},
 		appKeysPrevCheck: make(map[kclient.ObjectKey]time.Time),
 	}
 }
 
 // StartSync starts the daemon. It watches for new sync events coming and ensures a sync is triggered
-// periodically.
-func StartSync(ctx context.Context, client kclient.Client) {
-	d := newDaemon(client)
+// periodically. Upgrades found are recorded as events with the recorder.
+func StartSync(ctx context.Context, client kclient.Client, recorder event.Recorder) {
+	d := newDaemon(client, recorder)
 
 	// Trigger one sync upon startup of the daemon
 	nextWait, err := d.sync(ctx, time.Now())
@@ -198,6 +202,7 @@ func (d *daemon) refreshImages(ctx context.Context, apps map[kclient.ObjectKey]v
 				nextAppImage, updated, err = findLatestTagForImageWithPattern(ctx, d.client, current.Identifier(), imageKey.namespace, imageKey.image, tagPattern)
 				if err != nil {
 					logrus.Errorf("Problem finding latest tag for app %v: %v", appKey, err)
+					recordCheck("error")
 					continue
 				}
 			}
@@ -236,10 +241,13 @@ func (d *daemon) refreshImages(ctx context.Context, apps map[kclient.ObjectKey]v
 					if err := d.client.checkImageAllowed(ctx, app.Namespace, nextAppImage); err != nil {
 						if _, ok := err.(*imageallowrules.ErrImageNotAllowed); ok {
 							logrus.Debugf("Updated image %s for %s/%s is not allowed: %v", nextAppImage, app.Namespace, app.Name, err)
+							d.recordNotAllowed(ctx, appKey, &app, nextAppImage, digest)
 							d.appKeysPrevCheck[appKey] = updateTime
+							recordCheck("not_allowed")
 							continue
 						}
 						logrus.Errorf("error checking if updated image %s for %s/%s  is allowed: %v", app.Namespace, app.Name, nextAppImage, err)
+						recordCheck("error")
 						continue
 					}
 				}
@@ -249,6 +257,7 @@ func (d *daemon) refreshImages(ctx context.Context, apps map[kclient.ObjectKey]v
 				case "enabled":
 					if app.Status.AvailableAppImage == nextAppImage {
 						d.appKeysPrevCheck[appKey] = updateTime
+						recordCheck("no_change")
 						continue
 					}
 					app.Status.AvailableAppImage = nextAppImage
@@ -258,6 +267,7 @@ func (d *daemon) refreshImages(ctx context.Context, apps map[kclient.ObjectKey]v
 				case "notify":
 					if app.Status.ConfirmUpgradeAppImage == nextAppImage {
 						d.appKeysPrevCheck[appKey] = updateTime
+						recordCheck("no_change")
 						continue
 					}
 					app.Status.ConfirmUpgradeAppImage = nextAppImage
@@ -266,6 +276,7 @@ func (d *daemon) refreshImages(ctx context.Context, apps map[kclient.ObjectKey]v
 					app.Status.AvailableAppImageRemote = false
 				default:
 					logrus.Warnf("Unrecognized auto-upgrade mode %v for %v", mode, app.Name)
+					recordCheck("error")
 					continue
 				}
 				if updated {
@@ -277,8 +288,13 @@ func (d *daemon) refreshImages(ctx context.Context, apps map[kclient.ObjectKey]v
 				}
 				if err := d.client.updateAppStatus(ctx, &app); err != nil {
 					logrus.Errorf("Problem updating %v: %v", appKey, err)
+					recordCheck("error")
 					continue
 				}
+				d.recordUpgrade(ctx, &app, mode, nextAppImage, digest)
+				recordCheck("upgrade")
+			} else {
+				recordCheck("no_change")
 			}
 
 			// This app was checked on this run, so update the prevCheckTime time for this app
@@ -287,6 +303,11 @@ func (d *daemon) refreshImages(ctx context.Context, apps map[kclient.ObjectKey]v
 	}
 }
 
+// recordCheck counts the result of checking a single app for a newer image
+func recordCheck(result string) {
+	metrics.AutoUpgradeChecks.WithLabelValues(result).Inc()
+}
+
 func calcNextCheck(defaultInterval time.Duration, lastUpdate time.Time, app v1.AppInstance) (time.Time, error) {
 	if app.CreationTimestamp.After(lastUpdate) {
 		// If the app was created after the last update time, then the app was deleted and recreated between sync runs.
@@ -28,6 +28,7 @@ type mockDaemonClient struct {
 	remoteImageDigest, resolvedLocalTag string
 	localTagFound                       bool
 	imageDenyList                       map[string]struct{}
+	events                              []apiv1.Event
 }
 
 func (m *mockDaemonClient) getConfig(_ context.Context) (*apiv1.Config, error) {
@@ -76,6 +77,11 @@ func (m *mockDaemonClient) checkImageAllowed(_ context.Context, _ string, img st
 	return nil
 }
 
+func (m *mockDaemonClient) recordEvent(_ context.Context, e *apiv1.Event) error {
+	m.events = append(m.events, *e)
+	return nil
+}
+
 func TestDetermineAppsToRefresh(t *testing.T) {
 	defaultNextCheckInterval := time.Minute
 	now := time.Now()
@@ -446,6 +452,61 @@ func TestRefreshImages(t *testing.T) {
 		})
 	}
 }
+func TestRefreshImagesRecordsEvents(t *testing.T) {
+	now := time.Now()
+	ptrTrue := &[]bool{true}[0]
+	newApp := func(name, image string) v1.AppInstance {
+		return v1.AppInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "acorn"},
+			Spec:       v1.AppInstanceSpec{Image: image},
+			Status: v1.AppInstanceStatus{AppImage: v1.AppImage{
+				Name:   image,
+				Digest: "sha256:old",
+			}},
+		}
+	}
+	enabled, notify := newApp("enabled-app", "docker.io/acorn/enabled:latest"), newApp("notify-app", "docker.io/acorn/notify:latest")
+	enabled.Spec.AutoUpgrade = ptrTrue
+	notify.Spec.NotifyUpgrade = ptrTrue
+	apps := map[kclient.ObjectKey]v1.AppInstance{
+		router.Key("acorn", "enabled-app"): enabled,
+		router.Key("acorn", "notify-app"):  notify,
+	}
+	imagesToRefresh := map[imageAndNamespaceKey][]kclient.ObjectKey{
+		{image: "docker.io/acorn/enabled:latest", namespace: "acorn"}: {router.Key("acorn", "enabled-app")},
+		{image: "docker.io/acorn/notify:latest", namespace: "acorn"}:  {router.Key("acorn", "notify-app")},
+	}
+
+	c := &mockDaemonClient{remoteImageDigest: "sha256:new"}
+	d := &daemon{client: c, appKeysPrevCheck: map[kclient.ObjectKey]time.Time{}}
+	d.refreshImages(context.Background(), apps, imagesToRefresh, now)
+
+	types := map[string]string{}
+	for _, e := range c.events {
+		types[e.Source.Name] = e.Type
+		assert.Equal(t, "acorn", e.Namespace)
+		assert.Equal(t, "sha256:new", e.Details["targetDigest"])
+	}
+	assert.Equal(t, map[string]string{
+		"enabled-app": AppUpgradeAppliedEventType,
+		"notify-app":  AppUpgradeAvailableEventType,
+	}, types)
+
+	// An image that is not allowed is only recorded once
+	c = &mockDaemonClient{remoteImageDigest: "sha256:new", imageDenyList: map[string]struct{}{"docker.io/acorn/enabled:latest": {}}}
+	d = &daemon{client: c, appKeysPrevCheck: map[kclient.ObjectKey]time.Time{}}
+	for i := 0; i < 2; i++ {
+		d.refreshImages(context.Background(), apps, map[imageAndNamespaceKey][]kclient.ObjectKey{
+			{image: "docker.io/acorn/enabled:latest", namespace: "acorn"}: {router.Key("acorn", "enabled-app")},
+		}, now)
+	}
+	if assert.Len(t, c.events, 1) {
+		assert.Equal(t, imageallowrules.ImageNotAllowedEventType, c.events[0].Type)
+		assert.Equal(t, v1.EventSeverityWarn, c.events[0].Severity)
+	}
+	assert.Empty(t, c.appUpdates)
+}
+
 func TestDaemonSync(t *testing.T) {
 	start := time.Now()
 	tenMinutesAgo := time.Now().Add(-10 * time.Minute)
@@ -0,0 +1,94 @@
+package autoupgrade
+
+import (
+	"context"
+	"fmt"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/event"
+	"github.com/acorn-io/runtime/pkg/imageallowrules"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// AppUpgradeAvailableEventType is recorded when a newer image is found for an app with notify-upgrade and the
+	// upgrade waits to be confirmed.
+	AppUpgradeAvailableEventType = "AppUpgradeAvailable"
+	// AppUpgradeAppliedEventType is recorded when a newer image is found for an app with auto-upgrade and the app
+	// is upgraded to it.
+	AppUpgradeAppliedEventType = "AppUpgradeApplied"
+)
+
+// AppUpgradeEventDetails captures additional info about an upgrade found for an app.
+type AppUpgradeEventDetails struct {
+	// Mode is the auto-upgrade mode of the app, either enabled or notify.
+	Mode string `json:"mode"`
+
+	// Previous is the image the app is running.
+	Previous string `json:"previous,omitempty"`
+
+	// PreviousDigest is the digest of the image the app is running.
+	// +optional
+	PreviousDigest string `json:"previousDigest,omitempty"`
+
+	// Target is the image the app is upgraded to.
+	Target string `json:"target"`
+
+	// TargetDigest is the digest of the target image if the upgrade was found because new content was pushed to
+	// the tag of the app.
+	// +optional
+	TargetDigest string `json:"targetDigest,omitempty"`
+}
+
+func (d *daemon) recordUpgrade(ctx context.Context, app *v1.AppInstance, mode, target, digest string) {
+	e := apiv1.Event{
+		Type:        AppUpgradeAppliedEventType,
+		Actor:       "acorn-system",
+		Severity:    v1.EventSeverityInfo,
+		Description: fmt.Sprintf("Upgrading to %s", target),
+		Source:      event.ObjectSource(app),
+		Observed:    v1.MicroTime(metav1.NowMicro()),
+	}
+	e.SetNamespace(app.GetNamespace())
+
+	if mode == "notify" {
+		e.Type = AppUpgradeAvailableEventType
+		e.Description = fmt.Sprintf("Upgrade to %s is available", target)
+	}
+
+	var err error
+	if e.Details, err = v1.Mapify(AppUpgradeEventDetails{
+		Mode:           mode,
+		Previous:       app.Status.AppImage.Name,
+		PreviousDigest: app.Status.AppImage.Digest,
+		Target:         target,
+		TargetDigest:   digest,
+	}); err != nil {
+		logrus.Warnf("Failed to mapify event details: %s", err.Error())
+	}
+
+	if err := d.client.recordEvent(ctx, &e); err != nil {
+		logrus.Warnf("Failed to record event: %s", err.Error())
+	}
+}
+
+// recordNotAllowed records that the image found for an app is not allowed, unless it was already recorded for the
+// same image
+func (d *daemon) recordNotAllowed(ctx context.Context, appKey kclient.ObjectKey, app *v1.AppInstance, image, digest string) {
+	if d.notAllowed == nil {
+		d.notAllowed = map[kclient.ObjectKey]string{}
+	}
+	if d.notAllowed[appKey] == image+digest {
+		return
+	}
+	d.notAllowed[appKey] = image + digest
+
+	imageallowrules.RecordNotAllowedEvent(ctx, event.RecorderFunc(d.client.recordEvent), app, imageallowrules.ImageNotAllowedEventDetails{
+		Image:       image,
+		Digest:      digest,
+		AutoUpgrade: true,
+	})
+}
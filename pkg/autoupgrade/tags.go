@@ -76,7 +76,7 @@ func findLatestTagForImageWithPattern(ctx context.Context, c daemonClient, curre
 
 // FindLatestTagForImageWithPattern will return the latest tag for image corresponding to the pattern.
 func FindLatestTagForImageWithPattern(ctx context.Context, c kclient.Client, current, namespace, image, pattern string) (string, bool, error) {
-	return findLatestTagForImageWithPattern(ctx, &client{c}, current, namespace, image, pattern)
+	return findLatestTagForImageWithPattern(ctx, &client{client: c}, current, namespace, image, pattern)
 }
 
 // FindLatest returns the tag from the tags slice that sorts as the "latest" according to the supplied pattern.
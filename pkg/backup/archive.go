@@ -0,0 +1,197 @@
+package backup
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Archive writes the contents of the directory to w as a gzip compressed tarball. Ownership, permissions and
+// modification times are kept so that the restored volume can be used by the same containers.
+func Archive(dir string, w io.Writer) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil || rel == "." {
+			return err
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		var link string
+		if info.Mode()&fs.ModeSymlink != 0 {
+			if link, err = os.Readlink(path); err != nil {
+				return err
+			}
+		} else if !info.Mode().IsRegular() && !info.IsDir() {
+			// sockets, devices and pipes can't be restored into a volume in a meaningful way
+			return nil
+		}
+
+		header, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(rel)
+		if info.IsDir() {
+			header.Name += "/"
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(tw, f)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// Extract replaces the contents of the directory with the gzip compressed tarball read from r
+func Extract(r io.Reader, dir string) error {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return fmt.Errorf("backup is not a gzip compressed tarball: %w", err)
+	}
+	defer gz.Close()
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if err := os.RemoveAll(filepath.Join(dir, entry.Name())); err != nil {
+			return err
+		}
+	}
+
+	var dirs []*tar.Header
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		path := filepath.Join(dir, filepath.FromSlash(header.Name))
+		if !strings.HasPrefix(path, filepath.Clean(dir)+string(filepath.Separator)) {
+			return fmt.Errorf("invalid path %q in backup", header.Name)
+		}
+		// an entry must not be written through a symlink of an earlier entry, it could point anywhere on the node
+		if err := checkInside(filepath.Clean(dir), filepath.Dir(path)); err != nil {
+			return err
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := checkInside(filepath.Clean(dir), path); err != nil {
+				return err
+			}
+			if err := os.MkdirAll(path, 0700); err != nil {
+				return err
+			}
+			// permissions and times of directories are set after their contents are written
+			dirs = append(dirs, header)
+			continue
+		case tar.TypeReg:
+			if err := writeFile(path, header, tr); err != nil {
+				return err
+			}
+		case tar.TypeSymlink:
+			if err := os.Symlink(header.Linkname, path); err != nil {
+				return err
+			}
+		default:
+			continue
+		}
+
+		if err := setAttributes(path, header); err != nil {
+			return err
+		}
+	}
+
+	for i := len(dirs) - 1; i >= 0; i-- {
+		if err := setAttributes(filepath.Join(dir, filepath.FromSlash(dirs[i].Name)), dirs[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkInside returns an error if target or one of its parents up to dir is a symlink
+func checkInside(dir, target string) error {
+	for p := target; ; p = filepath.Dir(p) {
+		if p == dir || len(p) < len(dir) {
+			return nil
+		}
+		if info, err := os.Lstat(p); err == nil && info.Mode()&fs.ModeSymlink != 0 {
+			return fmt.Errorf("can not restore into %s, it is a symlink", p)
+		}
+	}
+}
+
+func writeFile(path string, header *tar.Header, r io.Reader) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	// a symlink in the way is replaced rather than followed
+	if info, err := os.Lstat(path); err == nil && info.Mode()&fs.ModeSymlink != 0 {
+		if err := os.Remove(path); err != nil {
+			return err
+		}
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func setAttributes(path string, header *tar.Header) error {
+	// Ownership can only be restored when running as root, which the backup job does
+	if err := os.Lchown(path, header.Uid, header.Gid); err != nil && !errors.Is(err, fs.ErrPermission) {
+		return err
+	}
+	if header.Typeflag == tar.TypeSymlink {
+		return nil
+	}
+	if err := os.Chmod(path, header.FileInfo().Mode().Perm()); err != nil {
+		return err
+	}
+	return os.Chtimes(path, header.ModTime, header.ModTime)
+}
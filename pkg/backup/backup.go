@@ -0,0 +1,144 @@
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	SchemeS3   = "s3"
+	SchemeFile = "file"
+
+	// Extension is the extension of the compressed tarballs that backups are stored as
+	Extension = ".tar.gz"
+
+	timestampFormat = "20060102T150405Z"
+)
+
+// ErrFileBackupsDisabled is returned for file:// URLs if no directory on the nodes is configured to store them in
+var ErrFileBackupsDisabled = errors.New("file:// backups are disabled, the volume backup directory is not configured")
+
+// Store reads and writes backups at the location of a backup URL
+type Store interface {
+	// Put stores everything read from r as the backup
+	Put(ctx context.Context, r io.Reader) error
+	// Get returns a reader of the backup, the caller must close it
+	Get(ctx context.Context) (io.ReadCloser, error)
+}
+
+// Validate returns an error if target is not a backup URL that acorn can store backups at
+func Validate(target string) error {
+	u, err := url.Parse(target)
+	if err != nil {
+		return fmt.Errorf("invalid backup URL %q: %w", target, err)
+	}
+	switch u.Scheme {
+	case SchemeS3:
+		if u.Host == "" {
+			return fmt.Errorf("invalid backup URL %q: a bucket is required, for example s3://bucket/path", target)
+		}
+		if endpoint := u.Query().Get("endpoint"); endpoint != "" {
+			if e, err := url.Parse(endpoint); err != nil || e.Host == "" || (e.Scheme != "http" && e.Scheme != "https") {
+				return fmt.Errorf("invalid backup URL %q: endpoint must be an http or https URL", target)
+			}
+		}
+	case SchemeFile:
+		if u.Host != "" || !strings.HasPrefix(u.Path, "/") || u.Path == "/" {
+			return fmt.Errorf("invalid backup URL %q: a path in the volume backup directory is required, for example file:///my-app/", target)
+		}
+		for _, elem := range strings.Split(u.Path, "/") {
+			if elem == ".." {
+				return fmt.Errorf("invalid backup URL %q: the path can not contain ..", target)
+			}
+		}
+	default:
+		return fmt.Errorf("invalid backup URL %q: only s3:// and file:// URLs are supported", target)
+	}
+	return nil
+}
+
+// ResolveURL returns the URL of the backup of the volume that is taken at the given time. A target that ends with a
+// slash is a prefix that every backup of the volume is stored under, the name of the backup is appended to it.
+func ResolveURL(target, volume string, now time.Time) (string, error) {
+	u, err := url.Parse(target)
+	if err != nil {
+		return "", err
+	}
+	if u.Path == "" {
+		u.Path = "/"
+	}
+	if strings.HasSuffix(u.Path, "/") {
+		u.Path += fmt.Sprintf("%s-%s%s", volume, now.UTC().Format(timestampFormat), Extension)
+	}
+	return u.String(), nil
+}
+
+// NewStore returns the store of the backup at the URL. If credentialsRequired is set, the store fails if no credentials
+// for it are found rather than accessing it anonymously.
+func NewStore(ctx context.Context, target string, credentialsRequired bool) (Store, error) {
+	if err := Validate(target); err != nil {
+		return nil, err
+	}
+	u, err := url.Parse(target)
+	if err != nil {
+		return nil, err
+	}
+	if strings.HasSuffix(u.Path, "/") {
+		return nil, fmt.Errorf("backup URL %q is a prefix, the URL of a single backup is required", target)
+	}
+
+	switch u.Scheme {
+	case SchemeS3:
+		return newS3Store(ctx, u, credentialsRequired)
+	default:
+		return &fileStore{path: u.Path}, nil
+	}
+}
+
+// Backup archives the directory and stores it at the URL
+func Backup(ctx context.Context, dir, target string, credentialsRequired bool) error {
+	store, err := NewStore(ctx, target, credentialsRequired)
+	if err != nil {
+		return err
+	}
+
+	r, w := io.Pipe()
+	go func() {
+		w.CloseWithError(Archive(dir, w))
+	}()
+	defer r.Close()
+
+	return store.Put(ctx, r)
+}
+
+// Restore replaces the contents of the directory with the backup at the URL
+func Restore(ctx context.Context, dir, source string, credentialsRequired bool) error {
+	store, err := NewStore(ctx, source, credentialsRequired)
+	if err != nil {
+		return err
+	}
+
+	r, err := store.Get(ctx)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	return Extract(r, dir)
+}
+
+// Copy replaces the contents of the directory dst with the contents of the directory src
+func Copy(src, dst string) error {
+	r, w := io.Pipe()
+	go func() {
+		w.CloseWithError(Archive(src, w))
+	}()
+	defer r.Close()
+
+	return Extract(r, dst)
+}
@@ -0,0 +1,338 @@
+package backup
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeS3 is a MinIO-style stand-in that implements the subset of the S3 API the s3 store uses
+type fakeS3 struct {
+	lock    sync.Mutex
+	objects map[string][]byte
+	uploads map[string]map[int][]byte
+	parts   int
+}
+
+func newFakeS3() *fakeS3 {
+	return &fakeS3{
+		objects: map[string][]byte{},
+		uploads: map[string]map[int][]byte{},
+	}
+}
+
+func (f *fakeS3) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if !strings.HasPrefix(req.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=minio/") {
+		rw.WriteHeader(http.StatusForbidden)
+		_, _ = rw.Write([]byte("<Error><Code>AccessDenied</Code><Message>Access Denied</Message></Error>"))
+		return
+	}
+
+	query := req.URL.Query()
+	body, _ := io.ReadAll(req.Body)
+	switch {
+	case req.Method == http.MethodPost && query.Has("uploads"):
+		id := fmt.Sprint(len(f.uploads))
+		f.uploads[id] = map[int][]byte{}
+		_, _ = fmt.Fprintf(rw, "<InitiateMultipartUploadResult><UploadId>%s</UploadId></InitiateMultipartUploadResult>", id)
+	case req.Method == http.MethodPut && query.Has("uploadId"):
+		var partNumber int
+		_, _ = fmt.Sscan(query.Get("partNumber"), &partNumber)
+		f.uploads[query.Get("uploadId")][partNumber] = body
+		f.parts++
+		rw.Header().Set("ETag", fmt.Sprintf(`"%d"`, partNumber))
+	case req.Method == http.MethodPost && query.Has("uploadId"):
+		var complete completeMultipartUpload
+		if err := xml.Unmarshal(body, &complete); err != nil {
+			rw.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		var data []byte
+		for _, part := range complete.Parts {
+			data = append(data, f.uploads[query.Get("uploadId")][part.PartNumber]...)
+		}
+		f.objects[req.URL.Path] = data
+		delete(f.uploads, query.Get("uploadId"))
+	case req.Method == http.MethodPut:
+		f.objects[req.URL.Path] = body
+	case req.Method == http.MethodGet:
+		data, ok := f.objects[req.URL.Path]
+		if !ok {
+			rw.WriteHeader(http.StatusNotFound)
+			_, _ = rw.Write([]byte("<Error><Code>NoSuchKey</Code><Message>The specified key does not exist.</Message></Error>"))
+			return
+		}
+		_, _ = rw.Write(data)
+	default:
+		rw.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func setCredentials(t *testing.T) {
+	t.Setenv("AWS_CONFIG_FILE", filepath.Join(t.TempDir(), "config"))
+	t.Setenv("AWS_SHARED_CREDENTIALS_FILE", filepath.Join(t.TempDir(), "credentials"))
+	t.Setenv("AWS_ACCESS_KEY_ID", "minio")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "minio123")
+}
+
+func writeTree(t *testing.T, dir string, files map[string]string) {
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0640))
+	}
+}
+
+func readTree(t *testing.T, dir string) map[string]string {
+	result := map[string]string{}
+	require.NoError(t, filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, _ := filepath.Rel(dir, path)
+		if info.Mode()&os.ModeSymlink != 0 {
+			link, err := os.Readlink(path)
+			result[rel] = "-> " + link
+			return err
+		}
+		data, err := os.ReadFile(path)
+		result[rel] = string(data)
+		return err
+	}))
+	return result
+}
+
+func TestArchiveExtract(t *testing.T) {
+	src, dst := t.TempDir(), t.TempDir()
+	writeTree(t, src, map[string]string{
+		"a.txt":         "a",
+		"dir/b.txt":     "b",
+		"dir/sub/c.txt": "c",
+	})
+	require.NoError(t, os.Symlink("dir/b.txt", filepath.Join(src, "link")))
+	require.NoError(t, os.Chmod(filepath.Join(src, "a.txt"), 0600))
+	writeTree(t, dst, map[string]string{
+		"stale.txt": "removed by the restore",
+	})
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, Archive(src, buf))
+	require.NoError(t, Extract(buf, dst))
+
+	assert.Equal(t, readTree(t, src), readTree(t, dst))
+
+	info, err := os.Stat(filepath.Join(dst, "a.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+}
+
+func TestCopy(t *testing.T) {
+	src, dst := t.TempDir(), t.TempDir()
+	writeTree(t, src, map[string]string{
+		"a.txt":     "a",
+		"dir/b.txt": "b",
+	})
+	writeTree(t, dst, map[string]string{
+		"stale.txt": "removed by the copy",
+	})
+
+	require.NoError(t, Copy(src, dst))
+	assert.Equal(t, readTree(t, src), readTree(t, dst))
+}
+
+func TestExtractRejectsPathTraversal(t *testing.T) {
+	buf := &bytes.Buffer{}
+	gz := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gz)
+	require.NoError(t, tw.WriteHeader(&tar.Header{
+		Name:     "../evil",
+		Typeflag: tar.TypeReg,
+		Mode:     0644,
+		Size:     4,
+	}))
+	_, err := tw.Write([]byte("evil"))
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+
+	assert.EqualError(t, Extract(buf, t.TempDir()), `invalid path "../evil" in backup`)
+}
+
+func TestExtractRejectsSymlinkTraversal(t *testing.T) {
+	outside := t.TempDir()
+
+	buf := &bytes.Buffer{}
+	gz := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gz)
+	require.NoError(t, tw.WriteHeader(&tar.Header{
+		Name:     "a",
+		Typeflag: tar.TypeSymlink,
+		Linkname: outside,
+	}))
+	require.NoError(t, tw.WriteHeader(&tar.Header{
+		Name:     "a/x",
+		Typeflag: tar.TypeReg,
+		Mode:     0644,
+		Size:     4,
+	}))
+	_, err := tw.Write([]byte("evil"))
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+
+	dir := t.TempDir()
+	assert.EqualError(t, Extract(buf, dir), fmt.Sprintf("can not restore into %s, it is a symlink", filepath.Join(dir, "a")))
+	assert.NoFileExists(t, filepath.Join(outside, "x"))
+}
+
+func TestResolveURL(t *testing.T) {
+	now := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	for target, expected := range map[string]string{
+		"s3://bucket/backups/": "s3://bucket/backups/data-20230601T120000Z.tar.gz",
+		"s3://bucket":          "s3://bucket/data-20230601T120000Z.tar.gz",
+		"s3://bucket/backups/?endpoint=http://m:9": "s3://bucket/backups/data-20230601T120000Z.tar.gz?endpoint=http://m:9",
+		"s3://bucket/backups/data.tar.gz":          "s3://bucket/backups/data.tar.gz",
+		"file:///var/backups/":                     "file:///var/backups/data-20230601T120000Z.tar.gz",
+	} {
+		result, err := ResolveURL(target, "data", now)
+		if assert.NoError(t, err, target) {
+			assert.Equal(t, expected, result, target)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	for target, valid := range map[string]bool{
+		"s3://bucket/backups/":                             true,
+		"s3://bucket/key?endpoint=http://minio.minio:9000": true,
+		"s3:///key": false,
+		"s3://bucket/key?endpoint=minio.minio:9000":         false,
+		"file:///var/backups/":                              true,
+		"file://host/var/backups/":                          false,
+		"file:///":                                          false,
+		"file:///backups/../../etc/":                        false,
+		"https://bucket.s3.us-east-1.amazonaws.com/backups": false,
+	} {
+		if valid {
+			assert.NoError(t, Validate(target), target)
+		} else {
+			assert.Error(t, Validate(target), target)
+		}
+	}
+}
+
+func TestPodSpecFile(t *testing.T) {
+	_, err := PodSpec(JobOptions{Type: TypeBackup, URL: "file:///etc/", ClaimName: "data"})
+	assert.ErrorIs(t, err, ErrFileBackupsDisabled)
+
+	podSpec, err := PodSpec(JobOptions{Type: TypeBackup, URL: "file:///etc/data.tar.gz", ClaimName: "data", HostDirectory: "/var/lib/acorn-backups", Project: "acorn"})
+	if assert.NoError(t, err) {
+		// the path of the URL is only ever resolved inside of the directory of the project in the configured directory
+		assert.Equal(t, "/var/lib/acorn-backups/acorn", podSpec.Volumes[1].HostPath.Path)
+		assert.Contains(t, podSpec.Containers[0].Args, "file:///backup-target/etc/data.tar.gz")
+	}
+
+	for _, project := range []string{"", ".", "..", "other/acorn"} {
+		_, err = PodSpec(JobOptions{Type: TypeBackup, URL: "file:///etc/", ClaimName: "data", HostDirectory: "/var/lib/acorn-backups", Project: project})
+		assert.ErrorContains(t, err, "invalid project", project)
+	}
+}
+
+func TestPodSpecFileOtherProject(t *testing.T) {
+	// The backups of the project other are stored in /var/lib/acorn-backups/other, which is never mounted into the jobs
+	// of the project acorn. A path naming the other project only reaches a directory of the same name in its own.
+	podSpec, err := PodSpec(JobOptions{Type: TypeRestore, URL: "file:///other/db/data.tar.gz", ClaimName: "data", HostDirectory: "/var/lib/acorn-backups", Project: "acorn"})
+	require.NoError(t, err)
+	assert.Equal(t, "/var/lib/acorn-backups/acorn", podSpec.Volumes[1].HostPath.Path)
+	assert.Contains(t, podSpec.Containers[0].Args, "file:///backup-target/other/db/data.tar.gz")
+
+	_, err = PodSpec(JobOptions{Type: TypeRestore, URL: "file:///../other/db/data.tar.gz", ClaimName: "data", HostDirectory: "/var/lib/acorn-backups", Project: "acorn"})
+	assert.ErrorContains(t, err, "can not contain ..")
+}
+
+func TestBackupRestoreFile(t *testing.T) {
+	src, dst := t.TempDir(), t.TempDir()
+	writeTree(t, src, map[string]string{"data/db": "records"})
+
+	target := "file://" + filepath.Join(t.TempDir(), "backups", "data.tar.gz")
+	require.NoError(t, Backup(context.Background(), src, target, false))
+	require.NoError(t, Restore(context.Background(), dst, target, false))
+
+	assert.Equal(t, readTree(t, src), readTree(t, dst))
+}
+
+func TestBackupRestoreS3(t *testing.T) {
+	setCredentials(t)
+	s3 := newFakeS3()
+	server := httptest.NewServer(s3)
+	defer server.Close()
+
+	src, dst := t.TempDir(), t.TempDir()
+	writeTree(t, src, map[string]string{"small": "small"})
+	// incompressible content that is big enough to be uploaded in more than one part
+	big := make([]byte, partSize+partSize/2)
+	rand.New(rand.NewSource(0)).Read(big)
+	require.NoError(t, os.WriteFile(filepath.Join(src, "big"), big, 0644))
+
+	target := "s3://bucket/backups/data.tar.gz?endpoint=" + server.URL
+	require.NoError(t, Backup(context.Background(), src, target, false))
+	assert.Equal(t, 2, s3.parts)
+	assert.Contains(t, s3.objects, "/bucket/backups/data.tar.gz")
+
+	require.NoError(t, Restore(context.Background(), dst, target, false))
+	assert.Equal(t, readTree(t, src), readTree(t, dst))
+
+	err := Restore(context.Background(), dst, "s3://bucket/backups/dne.tar.gz?endpoint="+server.URL, false)
+	assert.EqualError(t, err, "GET "+server.URL+"/bucket/backups/dne.tar.gz: NoSuchKey: The specified key does not exist.")
+}
+
+func TestBackupS3CredentialsRequired(t *testing.T) {
+	setCredentials(t)
+	t.Setenv("AWS_ACCESS_KEY_ID", "")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "")
+	t.Setenv("AWS_EC2_METADATA_DISABLED", "true")
+	s3 := newFakeS3()
+	server := httptest.NewServer(s3)
+	defer server.Close()
+
+	src := t.TempDir()
+	writeTree(t, src, map[string]string{"small": "small"})
+
+	err := Backup(context.Background(), src, "s3://bucket/data.tar.gz?endpoint="+server.URL, true)
+	assert.ErrorContains(t, err, "retrieving credentials for "+server.URL+"/bucket/data.tar.gz")
+	assert.Empty(t, s3.objects)
+}
+
+func TestBackupS3SinglePart(t *testing.T) {
+	setCredentials(t)
+	s3 := newFakeS3()
+	server := httptest.NewServer(s3)
+	defer server.Close()
+
+	src := t.TempDir()
+	writeTree(t, src, map[string]string{"small": "small"})
+
+	require.NoError(t, Backup(context.Background(), src, "s3://bucket/data.tar.gz?endpoint="+server.URL, false))
+	assert.Equal(t, 0, s3.parts)
+	assert.Contains(t, s3.objects, "/bucket/data.tar.gz")
+}
@@ -0,0 +1,40 @@
+package backup
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// fileStore stores a backup as a file. In the backup job the directory of the file is a hostPath volume, so backups
+// to file:// URLs are stored on the node the job runs on.
+type fileStore struct {
+	path string
+}
+
+func (f *fileStore) Put(_ context.Context, r io.Reader) error {
+	if err := os.MkdirAll(filepath.Dir(f.path), 0700); err != nil {
+		return err
+	}
+
+	// write to a temporary file first so that a failed backup doesn't leave a partial file behind
+	tmp, err := os.CreateTemp(filepath.Dir(f.path), "."+filepath.Base(f.path)+"-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, r); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), f.path)
+}
+
+func (f *fileStore) Get(_ context.Context) (io.ReadCloser, error) {
+	return os.Open(f.path)
+}
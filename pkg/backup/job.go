@@ -0,0 +1,167 @@
+package backup
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/acorn-io/runtime/pkg/system"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// HelperCommand is the hidden acorn command that the backup job runs
+	HelperCommand = "vol-backup"
+
+	TypeBackup  = "backup"
+	TypeRestore = "restore"
+	TypeCopy    = "copy"
+
+	volumeMountPath = "/volume"
+	sourceMountPath = "/source"
+	targetMountPath = "/backup-target"
+)
+
+// JobOptions describe the pod of a job that backs up or restores a volume
+type JobOptions struct {
+	// Type is TypeBackup, TypeRestore or TypeCopy
+	Type string
+	// URL the backup is stored at, or restored from. It is not used to copy a volume.
+	URL string
+	// Name is the name the backup is stored as if URL is a prefix
+	Name string
+	// ClaimName is the PersistentVolumeClaim of the volume
+	ClaimName string
+	// SourceClaimName is the PersistentVolumeClaim that is copied into the volume if Type is TypeCopy
+	SourceClaimName string
+	// Secret is the secret in the namespace of the job that the credentials of the object store are read from
+	Secret string
+	// NodeName pins the pod to a node, this is required when the volume is already attached to a node
+	NodeName string
+	// Affinity of the pod, used to schedule the pod next to the pods that use the volume when the node isn't known
+	Affinity *corev1.Affinity
+	// HostDirectory is the admin configured directory on the nodes that file:// backups are stored in
+	HostDirectory string
+	// Project is the namespace of the project of the volume. The paths of file:// URLs are relative to the directory of
+	// the project in HostDirectory, so that projects can't read or overwrite the backups of other projects.
+	Project string
+}
+
+// PodSpec returns the pod of a job that runs the backup helper for the options. For file:// URLs the directory of the
+// project in the configured host directory is mounted into the pod, never a path from the URL, and the claim that is
+// copied from is mounted read only.
+func PodSpec(opts JobOptions) (corev1.PodSpec, error) {
+	target, err := url.Parse(opts.URL)
+	if err != nil {
+		return corev1.PodSpec{}, err
+	}
+	if target.Scheme == SchemeFile {
+		if err := Validate(opts.URL); err != nil {
+			return corev1.PodSpec{}, err
+		}
+		if opts.HostDirectory == "" || !path.IsAbs(opts.HostDirectory) {
+			return corev1.PodSpec{}, ErrFileBackupsDisabled
+		}
+		if opts.Project == "" || strings.Contains(opts.Project, "/") || opts.Project == "." || opts.Project == ".." {
+			return corev1.PodSpec{}, fmt.Errorf("invalid project %q for file backups", opts.Project)
+		}
+	}
+
+	volumes := []corev1.Volume{
+		{
+			Name: "volume",
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: opts.ClaimName,
+				},
+			},
+		},
+	}
+	mounts := []corev1.VolumeMount{
+		{
+			Name:      "volume",
+			MountPath: volumeMountPath,
+		},
+	}
+
+	if opts.Type == TypeCopy {
+		volumes = append(volumes, corev1.Volume{
+			Name: "source",
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: opts.SourceClaimName,
+					ReadOnly:  true,
+				},
+			},
+		})
+		mounts = append(mounts, corev1.VolumeMount{
+			Name:      "source",
+			MountPath: sourceMountPath,
+			ReadOnly:  true,
+		})
+		target.Path = sourceMountPath
+	} else if target.Scheme == SchemeFile {
+		hostPathType := corev1.HostPathDirectoryOrCreate
+		volumes = append(volumes, corev1.Volume{
+			Name: "backup-target",
+			VolumeSource: corev1.VolumeSource{
+				HostPath: &corev1.HostPathVolumeSource{
+					Path: path.Join(opts.HostDirectory, opts.Project),
+					Type: &hostPathType,
+				},
+			},
+		})
+		mounts = append(mounts, corev1.VolumeMount{
+			Name:      "backup-target",
+			MountPath: targetMountPath,
+		})
+		target.Path = targetMountPath + target.Path
+	}
+
+	args := []string{HelperCommand, "--termination-log", corev1.TerminationMessagePathDefault}
+	switch opts.Type {
+	case TypeRestore:
+		args = append(args, "--restore")
+	case TypeCopy:
+		args = append(args, "--copy")
+	}
+	if opts.Name != "" {
+		args = append(args, "--name", opts.Name)
+	}
+	if opts.Secret != "" {
+		args = append(args, "--credentials-required")
+	}
+	args = append(args, target.String(), volumeMountPath)
+
+	container := corev1.Container{
+		Name:                     "backup",
+		Image:                    system.DefaultImage(),
+		Command:                  []string{"acorn"},
+		Args:                     args,
+		ImagePullPolicy:          corev1.PullIfNotPresent,
+		VolumeMounts:             mounts,
+		TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+	}
+	if opts.Secret != "" {
+		container.EnvFrom = []corev1.EnvFromSource{
+			{
+				SecretRef: &corev1.SecretEnvSource{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: opts.Secret,
+					},
+				},
+			},
+		}
+	}
+
+	return corev1.PodSpec{
+		RestartPolicy:                corev1.RestartPolicyNever,
+		AutomountServiceAccountToken: new(bool),
+		EnableServiceLinks:           new(bool),
+		NodeName:                     opts.NodeName,
+		Affinity:                     opts.Affinity,
+		Containers:                   []corev1.Container{container},
+		Volumes:                      volumes,
+	}, nil
+}
@@ -0,0 +1,266 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+)
+
+const (
+	defaultRegion = "us-east-1"
+	// partSize is the size of the parts of a multipart upload, S3 requires at least 5MiB for all but the last part
+	partSize = 16 << 20
+	// maxParts is the maximum number of parts of a multipart upload that S3 allows
+	maxParts = 10000
+	// requestTimeout bounds the requests that send or receive a part of a backup, a restore streams the whole backup
+	// in one response and is only bounded by the time the object store takes to respond
+	requestTimeout = 10 * time.Minute
+)
+
+var httpClient = newHTTPClient()
+
+func newHTTPClient() *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.ResponseHeaderTimeout = time.Minute
+	return &http.Client{
+		Transport: transport,
+	}
+}
+
+// s3Store stores a backup as an object in an S3 compatible object store. Requests are made path-style so that any S3
+// compatible store, like MinIO, can be used through the endpoint parameter of the URL.
+type s3Store struct {
+	client      *http.Client
+	signer      *v4.Signer
+	credentials aws.CredentialsProvider
+	// credentialsRequired fails requests if no credentials are found instead of sending them anonymously
+	credentialsRequired bool
+	region              string
+	objectURL           *url.URL
+}
+
+func newS3Store(ctx context.Context, u *url.URL, credentialsRequired bool) (*s3Store, error) {
+	cfg, err := awsconfig.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	region := u.Query().Get("region")
+	if region == "" {
+		region = cfg.Region
+	}
+	if region == "" {
+		region = defaultRegion
+	}
+
+	endpoint := u.Query().Get("endpoint")
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://s3.%s.amazonaws.com", region)
+	}
+	objectURL, err := url.Parse(strings.TrimSuffix(endpoint, "/"))
+	if err != nil {
+		return nil, err
+	}
+	objectURL = objectURL.JoinPath(u.Host, strings.TrimPrefix(u.Path, "/"))
+
+	return &s3Store{
+		client:              httpClient,
+		signer:              v4.NewSigner(),
+		credentials:         cfg.Credentials,
+		credentialsRequired: credentialsRequired,
+		region:              region,
+		objectURL:           objectURL,
+	}, nil
+}
+
+type initiateMultipartUploadResult struct {
+	UploadID string `xml:"UploadId"`
+}
+
+type completeMultipartUpload struct {
+	XMLName xml.Name        `xml:"CompleteMultipartUpload"`
+	Parts   []completedPart `xml:"Part"`
+}
+
+type completedPart struct {
+	ETag       string `xml:"ETag"`
+	PartNumber int    `xml:"PartNumber"`
+}
+
+type s3Error struct {
+	Code    string `xml:"Code"`
+	Message string `xml:"Message"`
+}
+
+func (s *s3Store) Put(ctx context.Context, r io.Reader) error {
+	buf := make([]byte, partSize)
+	n, err := io.ReadFull(r, buf)
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		// the whole backup fits into a single part
+		_, err := s.do(ctx, http.MethodPut, nil, buf[:n])
+		return err
+	} else if err != nil {
+		return err
+	}
+
+	resp, err := s.do(ctx, http.MethodPost, url.Values{"uploads": []string{""}}, nil)
+	if err != nil {
+		return err
+	}
+	var upload initiateMultipartUploadResult
+	if err := xml.Unmarshal(resp, &upload); err != nil {
+		return fmt.Errorf("invalid response to starting a multipart upload: %w", err)
+	}
+
+	if err := s.putParts(ctx, upload.UploadID, r, buf); err != nil {
+		// abort the upload so that the parts that were uploaded aren't kept around
+		abortCtx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		_, _ = s.do(abortCtx, http.MethodDelete, url.Values{"uploadId": []string{upload.UploadID}}, nil)
+		return err
+	}
+	return nil
+}
+
+func (s *s3Store) putParts(ctx context.Context, uploadID string, r io.Reader, part []byte) error {
+	var complete completeMultipartUpload
+	for partNumber := 1; len(part) > 0; partNumber++ {
+		if partNumber > maxParts {
+			return fmt.Errorf("backup is larger than the %d bytes that can be uploaded", int64(partSize)*maxParts)
+		}
+
+		etag, err := s.putPart(ctx, uploadID, partNumber, part)
+		if err != nil {
+			return err
+		}
+		complete.Parts = append(complete.Parts, completedPart{
+			ETag:       etag,
+			PartNumber: partNumber,
+		})
+
+		part = part[:cap(part)]
+		n, err := io.ReadFull(r, part)
+		if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+			return err
+		}
+		part = part[:n]
+	}
+
+	body, err := xml.Marshal(complete)
+	if err != nil {
+		return err
+	}
+	_, err = s.do(ctx, http.MethodPost, url.Values{"uploadId": []string{uploadID}}, body)
+	return err
+}
+
+func (s *s3Store) putPart(ctx context.Context, uploadID string, partNumber int, part []byte) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	req, err := s.newRequest(ctx, http.MethodPut, url.Values{
+		"partNumber": []string{strconv.Itoa(partNumber)},
+		"uploadId":   []string{uploadID},
+	}, part)
+	if err != nil {
+		return "", err
+	}
+	resp, err := s.send(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	return resp.Header.Get("ETag"), nil
+}
+
+func (s *s3Store) Get(ctx context.Context) (io.ReadCloser, error) {
+	req, err := s.newRequest(ctx, http.MethodGet, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.send(req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// do sends a request to the object and returns the body of the response
+func (s *s3Store) do(ctx context.Context, method string, query url.Values, body []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	req, err := s.newRequest(ctx, method, query, body)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.send(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	return io.ReadAll(resp.Body)
+}
+
+func (s *s3Store) newRequest(ctx context.Context, method string, query url.Values, body []byte) (*http.Request, error) {
+	u := *s.objectURL
+	// S3 expects query parameters without a value, like "?uploads", to be sent without an equal sign
+	u.RawQuery = strings.ReplaceAll(query.Encode(), "uploads=", "uploads")
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.ContentLength = int64(len(body))
+
+	if s.credentials == nil {
+		if s.credentialsRequired {
+			return nil, fmt.Errorf("no credentials found for %s", s.objectURL.Redacted())
+		}
+		return req, nil
+	}
+	credentials, err := s.credentials.Retrieve(ctx)
+	if err != nil && s.credentialsRequired {
+		return nil, fmt.Errorf("retrieving credentials for %s: %w", s.objectURL.Redacted(), err)
+	} else if err != nil {
+		// no credentials are configured, the bucket may allow anonymous access
+		return req, nil
+	}
+
+	hash := sha256.Sum256(body)
+	payloadHash := hex.EncodeToString(hash[:])
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	return req, s.signer.SignHTTP(ctx, credentials, req, payloadHash, "s3", s.region, time.Now())
+}
+
+func (s *s3Store) send(req *http.Request) (*http.Response, error) {
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return resp, nil
+	}
+	defer resp.Body.Close()
+
+	data, _ := io.ReadAll(io.LimitReader(resp.Body, 1<<16))
+	var s3Err s3Error
+	if err := xml.Unmarshal(data, &s3Err); err == nil && s3Err.Code != "" {
+		return nil, fmt.Errorf("%s %s: %s: %s", req.Method, s.objectURL.Redacted(), s3Err.Code, s3Err.Message)
+	}
+	return nil, fmt.Errorf("%s %s: %s", req.Method, s.objectURL.Redacted(), resp.Status)
+}
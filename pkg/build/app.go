@@ -7,7 +7,9 @@ import (
 
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/appdefinition"
+	"github.com/acorn-io/runtime/pkg/sbom"
 	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
 	"github.com/google/go-containerregistry/pkg/v1/remote"
 )
 
@@ -53,7 +55,22 @@ func fromAppImage(ctx *buildContext, appImage *v1.AppImage) (string, error) {
 		return "", err
 	}
 
-	return createAppManifest(tag, appImage.ImageData, ctx.remoteOpts)
+	id, err := createAppManifest(tag, appImage.ImageData, ctx.remoteOpts)
+	if err != nil {
+		return "", err
+	}
+
+	if ctx.opts.SBOM {
+		d, err := name.NewDigest(tag)
+		if err != nil {
+			return "", err
+		}
+		if _, err := sbom.Attest(d.Context().Digest("sha256:"+id), ctx.remoteOpts...); err != nil {
+			return "", err
+		}
+	}
+
+	return id, nil
 }
 
 func getContextFromAppImage(appImage *v1.AppImage) (_ string, err error) {
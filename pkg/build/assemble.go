@@ -259,6 +259,22 @@ func createManifest(tags []string, platforms []v1.Platform, opts []remote.Option
 			return "", err
 		}
 
+		descriptor, err := remote.Head(d, opts...)
+		if err != nil {
+			return "", err
+		}
+
+		if descriptor.MediaType.IsIndex() {
+			// images with attestations, like SBOMs, are pushed by buildkit as an index of the image and the
+			// attestation manifest, which are merged into the new index as is
+			adds, err := indexManifests(d, opts)
+			if err != nil {
+				return "", err
+			}
+			currentIndex = mutate.AppendManifests(currentIndex, adds...)
+			continue
+		}
+
 		img, err := remote.Image(d, opts...)
 		if err != nil {
 			return "", err
@@ -288,3 +304,28 @@ func createManifest(tags []string, platforms []v1.Platform, opts []remote.Option
 	err = remote.WriteIndex(d, currentIndex, opts...)
 	return d.Name(), err
 }
+
+func indexManifests(d name.Digest, opts []remote.Option) (result []mutate.IndexAddendum, _ error) {
+	index, err := remote.Index(d, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest, err := index.IndexManifest()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, desc := range manifest.Manifests {
+		img, err := index.Image(desc.Digest)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, mutate.IndexAddendum{
+			Add:        img,
+			Descriptor: desc,
+		})
+	}
+
+	return result, nil
+}
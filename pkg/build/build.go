@@ -521,7 +521,8 @@ func fromBuild(ctx *buildContext, buildCache *buildCache, build v1.Build) (id st
 }
 
 func buildImageNoManifest(ctx *buildContext, cwd string, build v1.Build) (string, error) {
-	_, ids, err := buildkit.Build(ctx.ctx, ctx.pushRepo, true, cwd, nil, build, ctx.messages, ctx.keychain)
+	// this is only used for the app metadata image, which has no packages to describe in an SBOM
+	_, ids, err := buildkit.Build(ctx.ctx, ctx.pushRepo, true, cwd, nil, build, false, ctx.messages, ctx.keychain)
 	if err != nil {
 		return "", err
 	}
@@ -529,7 +530,7 @@ func buildImageNoManifest(ctx *buildContext, cwd string, build v1.Build) (string
 }
 
 func buildImageAndManifest(ctx *buildContext, build v1.Build) (string, error) {
-	platforms, ids, err := buildkit.Build(ctx.ctx, ctx.pushRepo, false, ctx.cwd, ctx.opts.Platforms, build, ctx.messages, ctx.keychain)
+	platforms, ids, err := buildkit.Build(ctx.ctx, ctx.pushRepo, false, ctx.cwd, ctx.opts.Platforms, build, ctx.opts.SBOM, ctx.messages, ctx.keychain)
 	if err != nil {
 		return "", err
 	}
@@ -30,7 +30,9 @@ func getCacheKey(ctx context.Context) string {
 	return v
 }
 
-func Build(ctx context.Context, pushRepo string, local bool, cwd string, platforms []v1.Platform, build v1.Build, messages buildclient.Messages, keychain authn.Keychain) ([]v1.Platform, []string, error) {
+// Build builds and pushes an image for each platform. If sbom is set, buildkit attaches an SPDX SBOM attestation to
+// each image, which makes the pushed image an index of the image and the attestation manifest.
+func Build(ctx context.Context, pushRepo string, local bool, cwd string, platforms []v1.Platform, build v1.Build, sbom bool, messages buildclient.Messages, keychain authn.Keychain) ([]v1.Platform, []string, error) {
 	bkc, err := buildkit.New(ctx, "")
 	if err != nil {
 		return nil, nil, err
@@ -65,7 +67,7 @@ func Build(ctx context.Context, pushRepo string, local bool, cwd string, platfor
 	}
 
 	buildData, _ := json.Marshal(build)
-	sharedKey := digest.SHA256(getCacheKey(ctx), cwd, string(buildData), fmt.Sprint(local))
+	sharedKey := digest.SHA256(getCacheKey(ctx), cwd, string(buildData), fmt.Sprint(local), fmt.Sprint(sbom))
 	logrus.Debugf("sharedKey=[%s] cacheKey=[%s] cwd=[%s], buildData=[%s] local=[%v]",
 		sharedKey, getCacheKey(ctx), cwd, buildData, local)
 
@@ -108,6 +110,11 @@ func Build(ctx context.Context, pushRepo string, local bool, cwd string, platfor
 			options.FrontendAttrs["build-arg:"+key] = value
 		}
 
+		if sbom {
+			// scans the final image with the default buildkit SBOM generator
+			options.FrontendAttrs["attest:sbom"] = ""
+		}
+
 		ch, progressDone := progress(messages)
 		defer func() { <-progressDone }()
 
@@ -14,6 +14,7 @@ import (
 	"github.com/acorn-io/runtime/pkg/condition"
 	"github.com/acorn-io/runtime/pkg/imagesystem"
 	"github.com/acorn-io/runtime/pkg/k8schannel"
+	"github.com/acorn-io/runtime/pkg/metrics"
 	"github.com/acorn-io/runtime/pkg/pullsecret"
 	"github.com/sirupsen/logrus"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -86,7 +87,10 @@ func (s *Server) serveHTTP(rw http.ResponseWriter, req *http.Request) error {
 	m.Start(req.Context())
 
 	logrus.Infof("Starting build [%s/%s] [%s]", token.Build.Namespace, token.Build.Name, token.Build.UID)
+	start := time.Now()
 	image, err := s.build(req.Context(), m, token)
+	metrics.Builds.WithLabelValues(metrics.Result(err)).Inc()
+	metrics.BuildDuration.WithLabelValues(metrics.Result(err)).Observe(time.Since(start).Seconds())
 	if err == nil {
 		_ = m.Send(&buildclient.Message{
 			AppImage: image,
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"log"
@@ -8,6 +9,7 @@ import (
 
 	cli "github.com/acorn-io/runtime/pkg/cli/builder"
 	"github.com/acorn-io/runtime/pkg/client/term"
+	"github.com/acorn-io/runtime/pkg/tracing"
 	"github.com/google/go-containerregistry/pkg/logs"
 	"github.com/pterm/pterm"
 	"github.com/sirupsen/logrus"
@@ -45,6 +47,7 @@ func New() *cobra.Command {
 		NewDev(cmdContext),
 		NewRender(cmdContext),
 		NewExec(cmdContext),
+		NewCp(cmdContext),
 		NewPortForward(cmdContext),
 		NewEvent(cmdContext),
 		NewFmt(cmdContext),
@@ -53,6 +56,7 @@ func New() *cobra.Command {
 		NewOfferings(cmdContext),
 		NewUninstall(cmdContext),
 		NewInfo(cmdContext),
+		NewJob(cmdContext),
 		NewLogs(cmdContext),
 		NewCredentialLogin(true, cmdContext),
 		NewCredentialLogout(true, cmdContext),
@@ -60,13 +64,16 @@ func New() *cobra.Command {
 		NewPull(cmdContext),
 		NewPush(cmdContext),
 		NewRm(cmdContext),
+		NewRollback(cmdContext),
 		NewRun(cmdContext),
 		NewUpdate(cmdContext),
 		NewSecret(cmdContext),
 		NewStart(cmdContext),
 		NewStop(cmdContext),
 		NewTag(cmdContext),
+		NewTop(cmdContext),
 		NewVolume(cmdContext),
+		NewVolBackup(cmdContext),
 		NewWait(cmdContext),
 		NewVersion(cmdContext),
 	)
@@ -124,6 +131,31 @@ func (a *Acorn) PersistentPre(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	return startTrace(cmd)
+}
+
+// startTrace starts a span for the command if the ACORN_TRACE_ENDPOINT environment variable is set to the address of
+// an OpenTelemetry collector. The trace context is sent with the requests of the command, so the api-server and
+// controller add their spans to the same trace.
+func startTrace(cmd *cobra.Command) error {
+	endpoint := os.Getenv("ACORN_TRACE_ENDPOINT")
+	if endpoint == "" {
+		return nil
+	}
+
+	shutdown, err := tracing.Init(cmd.Context(), "acorn-cli", endpoint)
+	if err != nil {
+		return err
+	}
+
+	ctx, span := tracing.Start(cmd.Context(), cmd.CommandPath())
+	cmd.SetContext(ctx)
+	cobra.OnFinalize(func() {
+		span.End()
+		if err := shutdown(context.Background()); err != nil {
+			logrus.Errorf("Failed to export trace: %v", err)
+		}
+	})
 	return nil
 }
 
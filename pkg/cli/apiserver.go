@@ -1,9 +1,14 @@
 package cli
 
 import (
+	"context"
+
 	minkserver "github.com/acorn-io/mink/pkg/server"
 	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/k8sclient"
+	"github.com/acorn-io/runtime/pkg/metrics"
 	"github.com/acorn-io/runtime/pkg/server"
+	"github.com/acorn-io/runtime/pkg/tracing"
 	"github.com/spf13/cobra"
 )
 
@@ -24,10 +29,22 @@ func NewApiServer(c CommandContext) *cobra.Command {
 }
 
 type APIServer struct {
-	client ClientFactory
+	MetricsPort int `usage:"Port to serve Prometheus metrics on, 0 to disable" default:"9090"`
+	client      ClientFactory
 }
 
 func (a *APIServer) Run(cmd *cobra.Command, args []string) error {
+	c, err := k8sclient.Default()
+	if err != nil {
+		return err
+	}
+
+	shutdown, err := tracing.InitFromConfig(cmd.Context(), c, "acorn-api-server")
+	if err != nil {
+		return err
+	}
+	defer shutdown(context.Background())
+
 	cfg, err := server.New(server.Config{
 		Version:     cmd.Version,
 		DefaultOpts: opts,
@@ -36,6 +53,8 @@ func (a *APIServer) Run(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	metrics.Serve(cmd.Context(), a.MetricsPort)
+
 	if err := cfg.Run(cmd.Context()); err != nil {
 		return err
 	}
@@ -14,15 +14,19 @@ import (
 )
 
 func NewApp(c CommandContext) *cobra.Command {
-	return cli.Command(&App{client: c.ClientFactory}, cobra.Command{
+	cmd := cli.Command(&App{client: c.ClientFactory}, cobra.Command{
 		Use:     "app [flags] [APP_NAME...]",
 		Aliases: []string{"apps", "a", "ps"},
 		Example: `
 acorn app`,
 		SilenceUsage:      true,
 		Short:             "List or get apps",
+		Args:              cobra.ArbitraryArgs,
 		ValidArgsFunction: newCompletion(c.ClientFactory, appsCompletion).complete,
 	})
+	cmd.AddCommand(NewAppHistory(c))
+	cmd.AddCommand(NewAppMetrics(c))
+	return cmd
 }
 
 type App struct {
@@ -0,0 +1,44 @@
+package cli
+
+import (
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/cli/builder/table"
+	"github.com/acorn-io/runtime/pkg/tables"
+	"github.com/spf13/cobra"
+)
+
+func NewAppHistory(c CommandContext) *cobra.Command {
+	return cli.Command(&AppHistory{client: c.ClientFactory}, cobra.Command{
+		Use: "history [flags] APP_NAME",
+		Example: `
+acorn app history my-app`,
+		SilenceUsage:      true,
+		Short:             "List the revisions of an app",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: newCompletion(c.ClientFactory, appsCompletion).withShouldCompleteOptions(onlyNumArgs(1)).complete,
+	})
+}
+
+type AppHistory struct {
+	Output string `usage:"Output format (json, yaml, {{gotemplate}})" short:"o"`
+	client ClientFactory
+}
+
+func (a *AppHistory) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	revisions, err := c.AppHistory(cmd.Context(), args[0])
+	if err != nil {
+		return err
+	}
+
+	out := table.NewWriter(tables.AppRevision, false, a.Output)
+	for _, rev := range revisions {
+		out.WriteFormatted(rev, nil)
+	}
+
+	return out.Err()
+}
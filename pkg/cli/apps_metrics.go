@@ -0,0 +1,237 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/cli/builder/table"
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/acorn-io/runtime/pkg/tables"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"github.com/spf13/cobra"
+	"golang.org/x/exp/maps"
+)
+
+func NewAppMetrics(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&AppMetrics{client: c.ClientFactory}, cobra.Command{
+		Use: "metrics [flags] APP_NAME",
+		Example: `
+# Print the metrics of the container of my-app that declares metrics
+acorn app metrics my-app
+
+# Print the metrics of the web container in the Prometheus text format
+acorn app metrics -c web -o raw my-app`,
+		SilenceUsage:      true,
+		Short:             "Print the current metrics of an app's container",
+		Long:              "Print the current metrics of an app's container. The container must declare the port and path of its metrics endpoint with the metrics field in the Acornfile.",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: newCompletion(c.ClientFactory, appsCompletion).withShouldCompleteOptions(onlyNumArgs(1)).complete,
+	})
+
+	// This will produce an error if the container flag doesn't exist or a completion function has already
+	// been registered for this flag. Not returning the error since neither of these is likely occur.
+	if err := cmd.RegisterFlagCompletionFunc("container", newCompletion(c.ClientFactory, acornContainerCompletion).complete); err != nil {
+		cmd.Printf("Error registering completion function for -c flag: %v\n", err)
+	}
+
+	return cmd
+}
+
+type AppMetrics struct {
+	Container string `usage:"Name of container to read metrics from" short:"c"`
+	Output    string `usage:"Output format (json, yaml, raw, {{gotemplate}})" short:"o"`
+	client    ClientFactory
+}
+
+// MetricSample is a single sample of a metric family, as printed by acorn app metrics
+type MetricSample struct {
+	Name   string  `json:"name"`
+	Type   string  `json:"type"`
+	Labels string  `json:"labels,omitempty"`
+	Value  float64 `json:"value"`
+}
+
+func (a *AppMetrics) Run(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	app, err := c.AppGet(ctx, args[0])
+	if err != nil {
+		return err
+	}
+
+	container, err := getMetricsContainerForApp(ctx, c, app, a.Container)
+	if err != nil {
+		return err
+	}
+
+	dialer, err := c.ContainerReplicaPortForward(ctx, container.Name, int(container.Spec.Metrics.Port))
+	if err != nil {
+		return err
+	}
+
+	body, err := scrapeMetrics(ctx, dialer, container)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	if a.Output == "raw" {
+		_, err := io.Copy(os.Stdout, body)
+		return err
+	}
+
+	families, err := new(expfmt.TextParser).TextToMetricFamilies(body)
+	if err != nil {
+		return fmt.Errorf("parsing metrics of container %s: %w", container.Name, err)
+	}
+
+	out := table.NewWriter(tables.MetricSample, false, a.Output)
+	for _, sample := range toMetricSamples(families) {
+		out.WriteFormatted(sample, nil)
+	}
+
+	return out.Err()
+}
+
+// getMetricsContainerForApp returns a running replica of the app's container that declares metrics. If more than one
+// container declares metrics, the container name must be given.
+func getMetricsContainerForApp(ctx context.Context, c client.Client, app *apiv1.App, containerName string) (*apiv1.ContainerReplica, error) {
+	containers, err := c.ContainerReplicaList(ctx, &client.ContainerReplicaListOptions{
+		App: app.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		result *apiv1.ContainerReplica
+		names  = map[string]struct{}{}
+	)
+	for _, container := range filterContainers(containerName, containers) {
+		if container.Spec.Metrics.Port == 0 || container.Status.Columns.State == "stopped" {
+			continue
+		}
+		names[container.Spec.ContainerName] = struct{}{}
+		if result == nil {
+			result = container.DeepCopy()
+		}
+	}
+
+	switch {
+	case result == nil && containerName != "":
+		return nil, fmt.Errorf("container %s of app %s is not running or does not declare metrics", containerName, app.Name)
+	case result == nil:
+		return nil, fmt.Errorf("app %s has no running containers that declare metrics", app.Name)
+	case len(names) > 1:
+		keys := maps.Keys(names)
+		sort.Strings(keys)
+		return nil, fmt.Errorf("more than one container of app %s declares metrics, choose one with --container: %s", app.Name, strings.Join(keys, ", "))
+	}
+
+	return result, nil
+}
+
+// scrapeMetrics requests the metrics endpoint of the container through the port forward dialer
+func scrapeMetrics(ctx context.Context, dialer client.PortForwardDialer, container *apiv1.ContainerReplica) (io.ReadCloser, error) {
+	httpClient := &http.Client{
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				return dialer(ctx)
+			},
+		},
+	}
+
+	path := container.Spec.Metrics.Path
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("http://%s:%d%s", container.Name, container.Spec.Metrics.Port, path), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", string(expfmt.FmtText))
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("requesting metrics of container %s: %w", container.Name, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("requesting metrics of container %s: unexpected status %s", container.Name, resp.Status)
+	}
+
+	return resp.Body, nil
+}
+
+// toMetricSamples flattens the metric families into samples sorted by name. Histograms are reduced to their sum and
+// count, summaries to their quantiles, sum and count.
+func toMetricSamples(families map[string]*dto.MetricFamily) (result []MetricSample) {
+	names := maps.Keys(families)
+	sort.Strings(names)
+
+	for _, name := range names {
+		family := families[name]
+		typ := strings.ToLower(family.GetType().String())
+		for _, metric := range family.Metric {
+			labels := formatLabels(metric.Label)
+			add := func(name, labels string, value float64) {
+				result = append(result, MetricSample{
+					Name:   name,
+					Type:   typ,
+					Labels: labels,
+					Value:  value,
+				})
+			}
+
+			switch family.GetType() {
+			case dto.MetricType_COUNTER:
+				add(name, labels, metric.GetCounter().GetValue())
+			case dto.MetricType_GAUGE:
+				add(name, labels, metric.GetGauge().GetValue())
+			case dto.MetricType_HISTOGRAM:
+				add(name+"_sum", labels, metric.GetHistogram().GetSampleSum())
+				add(name+"_count", labels, float64(metric.GetHistogram().GetSampleCount()))
+			case dto.MetricType_SUMMARY:
+				for _, q := range metric.GetSummary().GetQuantile() {
+					add(name, joinLabels(labels, fmt.Sprintf("quantile=%q", fmt.Sprint(q.GetQuantile()))), q.GetValue())
+				}
+				add(name+"_sum", labels, metric.GetSummary().GetSampleSum())
+				add(name+"_count", labels, float64(metric.GetSummary().GetSampleCount()))
+			default:
+				add(name, labels, metric.GetUntyped().GetValue())
+			}
+		}
+	}
+
+	return result
+}
+
+func formatLabels(labels []*dto.LabelPair) string {
+	pairs := make([]string, 0, len(labels))
+	for _, label := range labels {
+		pairs = append(pairs, fmt.Sprintf("%s=%q", label.GetName(), label.GetValue()))
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+func joinLabels(labels, label string) string {
+	if labels == "" {
+		return label
+	}
+	return labels + "," + label
+}
@@ -0,0 +1,146 @@
+package cli
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const testMetrics = `# HELP http_requests_total Requests served
+# TYPE http_requests_total counter
+http_requests_total{method="GET",code="200"} 42
+# HELP queue_depth Items waiting
+# TYPE queue_depth gauge
+queue_depth 3
+# HELP request_seconds Request latency
+# TYPE request_seconds histogram
+request_seconds_bucket{le="1"} 1
+request_seconds_bucket{le="+Inf"} 2
+request_seconds_sum 2.5
+request_seconds_count 2
+`
+
+type metricsMockClient struct {
+	*testdata.MockClient
+	address string
+	port    int
+}
+
+func (m *metricsMockClient) ContainerReplicaPortForward(_ context.Context, _ string, port int) (client.PortForwardDialer, error) {
+	m.port = port
+	return func(ctx context.Context) (net.Conn, error) {
+		return (&net.Dialer{}).DialContext(ctx, "tcp", m.address)
+	}, nil
+}
+
+func TestAppMetrics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/metrics" {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = rw.Write([]byte(testMetrics))
+	}))
+	defer server.Close()
+
+	container := func(name, containerName string, metrics v1.MetricsDef) apiv1.ContainerReplica {
+		return apiv1.ContainerReplica{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: apiv1.ContainerReplicaSpec{
+				AppName:           "found",
+				ContainerName:     containerName,
+				EmbeddedContainer: apiv1.EmbeddedContainer{Metrics: metrics},
+			},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		args       []string
+		containers []apiv1.ContainerReplica
+		wantErr    string
+		wantOut    string
+	}{
+		{
+			name: "acorn app metrics found",
+			args: []string{"metrics", "found"},
+			containers: []apiv1.ContainerReplica{
+				container("found.db-abc", "db", v1.MetricsDef{}),
+				container("found.web-abc", "web", v1.MetricsDef{Port: 9100, Path: "metrics"}),
+			},
+			wantOut: `NAME                    TYPE        LABELS                    VALUE
+http_requests_total     counter     code="200",method="GET"   42
+queue_depth             gauge                                 3
+request_seconds_sum     histogram                             2.5
+request_seconds_count   histogram                             2
+`,
+		},
+		{
+			name: "acorn app metrics -o raw found",
+			args: []string{"metrics", "-o", "raw", "found"},
+			containers: []apiv1.ContainerReplica{
+				container("found.web-abc", "web", v1.MetricsDef{Port: 9100, Path: "/metrics"}),
+			},
+			wantOut: testMetrics,
+		},
+		{
+			name: "acorn app metrics -c db found",
+			args: []string{"metrics", "-c", "db", "found"},
+			containers: []apiv1.ContainerReplica{
+				container("found.db-abc", "db", v1.MetricsDef{}),
+				container("found.web-abc", "web", v1.MetricsDef{Port: 9100, Path: "/metrics"}),
+			},
+			wantErr: "container db of app found is not running or does not declare metrics",
+		},
+		{
+			name: "acorn app metrics found with two containers",
+			args: []string{"metrics", "found"},
+			containers: []apiv1.ContainerReplica{
+				container("found.web-abc", "web", v1.MetricsDef{Port: 9100, Path: "/metrics"}),
+				container("found.api-abc", "api", v1.MetricsDef{Port: 9100, Path: "/metrics"}),
+			},
+			wantErr: "more than one container of app found declares metrics, choose one with --container: api, web",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, stdout, _ := os.Pipe()
+			os.Stdout = stdout
+			c := &metricsMockClient{
+				MockClient: &testdata.MockClient{Containers: tt.containers},
+				address:    strings.TrimPrefix(server.URL, "http://"),
+			}
+			cmd := NewApp(CommandContext{
+				ClientFactory: &testdata.MockClientFactoryManual{Client: c},
+				StdOut:        stdout,
+				StdErr:        stdout,
+				StdIn:         strings.NewReader(""),
+			})
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			stdout.Close()
+			if tt.wantErr != "" {
+				if assert.Error(t, err) {
+					assert.Equal(t, tt.wantErr, err.Error())
+				}
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, 9100, c.port)
+			out, _ := io.ReadAll(r)
+			assert.Equal(t, tt.wantOut, string(out))
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	adminv1 "github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/tags"
 	"github.com/rancher/wrangler/pkg/data/convert"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/duration"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -40,6 +41,9 @@ var (
 		"memoryToRange": MemoryToRange,
 		"defaultMemory": DefaultMemory,
 		"ownerName":     OwnerReferenceName,
+		"cpu":           FormatCPU,
+		"memory":        FormatMemory,
+		"percent":       Percent,
 	}
 )
 
@@ -292,3 +296,44 @@ func OwnerReferenceName(obj metav1.Object) string {
 
 	return owners[0].Name
 }
+
+func toQuantity(obj any) (*resource.Quantity, error) {
+	switch q := obj.(type) {
+	case resource.Quantity:
+		return &q, nil
+	case *resource.Quantity:
+		return q, nil
+	}
+	return nil, fmt.Errorf("object passed is not a Quantity")
+}
+
+// FormatCPU formats a CPU quantity in millicores
+func FormatCPU(obj any) (string, error) {
+	q, err := toQuantity(obj)
+	if err != nil || q == nil {
+		return "", err
+	}
+	return fmt.Sprintf("%dm", q.MilliValue()), nil
+}
+
+// FormatMemory formats a memory quantity in mebibytes
+func FormatMemory(obj any) (string, error) {
+	q, err := toQuantity(obj)
+	if err != nil || q == nil {
+		return "", err
+	}
+	return fmt.Sprintf("%dMi", q.Value()/(1024*1024)), nil
+}
+
+// Percent returns the percentage of total that used is, or an empty string if total is unset or zero
+func Percent(used, total any) (string, error) {
+	u, err := toQuantity(used)
+	if err != nil || u == nil {
+		return "", err
+	}
+	t, err := toQuantity(total)
+	if err != nil || t == nil || t.IsZero() {
+		return "", err
+	}
+	return fmt.Sprintf("%d%%", u.MilliValue()*100/t.MilliValue()), nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/acorn-io/runtime/pkg/buildserver"
 	cli "github.com/acorn-io/runtime/pkg/cli/builder"
 	"github.com/acorn-io/runtime/pkg/k8sclient"
+	"github.com/acorn-io/runtime/pkg/metrics"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"inet.af/tcpproxy"
@@ -31,6 +32,7 @@ type BuildServer struct {
 	ListenPort     int    `usage:"HTTP listen port" env:"ACORN_BUILD_SERVER_PORT" default:"8080"`
 	ForwardPort    int    `usage:"Forward TCP Listen Port" default:"5000"`
 	ForwardService string `usage:"Forwarding Address" env:"ACORN_BUILD_SERVER_FORWARD_SERVICE"`
+	MetricsPort    int    `usage:"Port to serve Prometheus metrics on, 0 to disable" default:"9090"`
 }
 
 func (s *BuildServer) Run(cmd *cobra.Command, args []string) error {
@@ -56,6 +58,8 @@ func (s *BuildServer) Run(cmd *cobra.Command, args []string) error {
 		}()
 	}
 
+	metrics.Serve(cmd.Context(), s.MetricsPort)
+
 	logrus.Infof("Listening on %s", address)
 	return http.ListenAndServe(address, server)
 }
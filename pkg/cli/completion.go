@@ -241,6 +241,22 @@ func volumesCompletion(ctx context.Context, c client.Client, toComplete string)
 	return result, nil
 }
 
+func volumeSnapshotsCompletion(ctx context.Context, c client.Client, toComplete string) ([]string, error) {
+	snapshots, err := c.VolumeSnapshotList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []string
+	for _, snapshot := range snapshots {
+		if strings.HasPrefix(snapshot.Name, toComplete) {
+			result = append(result, snapshot.Name)
+		}
+	}
+
+	return result, nil
+}
+
 func secretsCompletion(ctx context.Context, c client.Client, toComplete string) ([]string, error) {
 	secrets, err := c.SecretList(ctx)
 	if err != nil {
@@ -406,3 +422,27 @@ func eventsCompletion(ctx context.Context, c client.Client, toComplete string) (
 
 	return result, nil
 }
+
+// jobsCompletion completes job names in the form <app>.<job>, optionally only for jobs that have a schedule.
+func jobsCompletion(scheduledOnly bool) completionFunc {
+	return func(ctx context.Context, c client.Client, toComplete string) ([]string, error) {
+		apps, err := c.AppList(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		var result []string
+		for _, app := range apps {
+			for _, entry := range typed.Sorted(app.Status.AppSpec.Jobs) {
+				if scheduledOnly && entry.Value.Schedule == "" {
+					continue
+				}
+				if name := app.Name + "." + entry.Key; strings.HasPrefix(name, toComplete) {
+					result = append(result, name)
+				}
+			}
+		}
+
+		return result, nil
+	}
+}
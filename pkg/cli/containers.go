@@ -22,6 +22,7 @@ acorn containers`,
 		ValidArgsFunction: newCompletion(c.ClientFactory, containersCompletion).complete,
 	})
 	cmd.AddCommand(NewContainerDelete(c))
+	cmd.AddCommand(NewContainerTop(c))
 	return cmd
 }
 
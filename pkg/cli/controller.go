@@ -3,6 +3,9 @@ package cli
 import (
 	cli "github.com/acorn-io/runtime/pkg/cli/builder"
 	"github.com/acorn-io/runtime/pkg/controller"
+	"github.com/acorn-io/runtime/pkg/k8sclient"
+	"github.com/acorn-io/runtime/pkg/metrics"
+	"github.com/acorn-io/runtime/pkg/tracing"
 	"github.com/spf13/cobra"
 )
 
@@ -17,14 +20,27 @@ func NewController(c CommandContext) *cobra.Command {
 }
 
 type Controller struct {
-	client ClientFactory
+	MetricsPort int `usage:"Port to serve Prometheus metrics on, 0 to disable" default:"9090"`
+	client      ClientFactory
 }
 
 func (s *Controller) Run(cmd *cobra.Command, _ []string) error {
+	client, err := k8sclient.Default()
+	if err != nil {
+		return err
+	}
+
+	// The controller never returns, so the exporter isn't shutdown and spans that are not yet exported are lost on exit
+	if _, err := tracing.InitFromConfig(cmd.Context(), client, "acorn-controller"); err != nil {
+		return err
+	}
+
 	c, err := controller.New()
 	if err != nil {
 		return err
 	}
+	metrics.Serve(cmd.Context(), s.MetricsPort)
+
 	if err := c.Start(cmd.Context()); err != nil {
 		return err
 	}
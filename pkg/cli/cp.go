@@ -0,0 +1,66 @@
+package cli
+
+import (
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/cp"
+	"github.com/acorn-io/runtime/pkg/progressbar"
+	"github.com/spf13/cobra"
+)
+
+func NewCp(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&Cp{client: c.ClientFactory}, cobra.Command{
+		Use: "cp [flags] SOURCE DEST",
+		Example: `
+# Copy a heap dump out of a container replica into the current directory
+acorn cp my-app.web-6d7c4f5b8-x2kqz:/tmp/heap.hprof .
+
+# Copy a local directory into the "web" container of the app "my-app"
+acorn cp -c web ./config my-app:/etc/my-app`,
+		SilenceUsage:      true,
+		Short:             "Copy files and directories between the local disk and a container",
+		Long:              "Copy files and directories between the local disk and a container. A path in a container is given as APP_NAME:PATH or CONTAINER_NAME:PATH, the other path is on the local disk. Directories are copied recursively with their file modes. The container must have sh and tar.",
+		ValidArgsFunction: newCompletion(c.ClientFactory, appsThenContainersCompletion).complete,
+		Args:              cobra.ExactArgs(2),
+	})
+
+	// This will produce an error if the container flag doesn't exist or a completion function has already
+	// been registered for this flag. Not returning the error since neither of these is likely occur.
+	if err := cmd.RegisterFlagCompletionFunc("container", newCompletion(c.ClientFactory, acornContainerCompletion).complete); err != nil {
+		cmd.Printf("Error registering completion function for -c flag: %v\n", err)
+	}
+
+	return cmd
+}
+
+type Cp struct {
+	Container string `usage:"Name of container to copy to or from if an app name is given" short:"c"`
+	client    ClientFactory
+}
+
+func (s *Cp) Run(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	c, err := s.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	locations := []cp.Location{cp.ParseLocation(args[0]), cp.ParseLocation(args[1])}
+	for i, location := range locations {
+		if !location.IsRemote() {
+			continue
+		}
+		app, appErr := c.AppGet(ctx, location.Replica)
+		if appErr == nil && app != nil {
+			locations[i].Replica, err = getContainerForApp(ctx, c, app, s.Container, true)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	progress, err := cp.Copy(ctx, c, locations[0], locations[1])
+	if err != nil {
+		return err
+	}
+	return progressbar.Print(progress)
+}
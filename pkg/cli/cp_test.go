@@ -0,0 +1,56 @@
+package cli
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCp(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "acorn cp a b",
+			args:    []string{"a", "b"},
+			wantErr: "one of a and b must be a path in a container, in the form REPLICA:PATH",
+		},
+		{
+			name:    "acorn cp dne:/a dne:/b",
+			args:    []string{"dne:/a", "dne:/b"},
+			wantErr: "one of dne:/a and dne:/b must be a path in a container, in the form REPLICA:PATH",
+		},
+		{
+			name:    "acorn cp a dne:",
+			args:    []string{"a", "dne:"},
+			wantErr: "a path is required to copy from a to dne:",
+		},
+		{
+			name:    "acorn cp a",
+			args:    []string{"a"},
+			wantErr: "accepts 2 arg(s), received 1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, stdout, _ := os.Pipe()
+			cmd := NewCp(CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        stdout,
+				StdErr:        stdout,
+				StdIn:         strings.NewReader(""),
+			})
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			stdout.Close()
+			if assert.Error(t, err) {
+				assert.Equal(t, tt.wantErr, err.Error())
+			}
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 func NewEvent(c CommandContext) *cobra.Command {
 	cmd := cli.Command(&Events{client: c.ClientFactory}, cobra.Command{
 		Use:               "events [flags] [PREFIX]",
+		Aliases:           []string{"event"},
 		SilenceUsage:      true,
 		Short:             "List events about Acorn resources",
 		Args:              cobra.MaximumNArgs(1),
@@ -53,14 +54,15 @@ func NewEvent(c CommandContext) *cobra.Command {
   # This flag must be used in conjunction with a non-table output format, like '-o=yaml'.
   acorn events --details -o yaml
 `})
+	cmd.AddCommand(NewEventSubscription(c))
 	return cmd
 }
 
 type Events struct {
-	Tail    int    `usage:"Return this number of latest events" short:"t"`
-	Follow  bool   `usage:"Follow the event log" short:"f"`
-	Details bool   `usage:"Don't strip event details from response" short:"d"`
-	Output  string `usage:"Output format (json, yaml, {{gotemplate}})" short:"o"`
+	Tail    int    `usage:"Return this number of latest events" short:"t" local:"true"`
+	Follow  bool   `usage:"Follow the event log" short:"f" local:"true"`
+	Details bool   `usage:"Don't strip event details from response" short:"d" local:"true"`
+	Output  string `usage:"Output format (json, yaml, {{gotemplate}})" short:"o" local:"true"`
 	client  ClientFactory
 }
 
@@ -0,0 +1,175 @@
+package cli
+
+import (
+	"fmt"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/cli/builder/table"
+	"github.com/acorn-io/runtime/pkg/tables"
+	"github.com/pterm/pterm"
+	"github.com/rancher/wrangler/pkg/randomtoken"
+	"github.com/spf13/cobra"
+	"k8s.io/utils/strings/slices"
+)
+
+func NewEventSubscription(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&EventSubscriptionList{client: c.ClientFactory}, cobra.Command{
+		Use:     "subscription [flags] [SUBSCRIPTION_NAME...]",
+		Aliases: []string{"subscriptions", "sub"},
+		Example: `
+acorn events subscription`,
+		SilenceUsage: true,
+		Short:        "Manage subscriptions that deliver events to webhooks",
+	})
+	cmd.AddCommand(NewEventSubscriptionCreate(c))
+	cmd.AddCommand(NewEventSubscriptionList(c))
+	cmd.AddCommand(NewEventSubscriptionDelete(c))
+	return cmd
+}
+
+func NewEventSubscriptionList(c CommandContext) *cobra.Command {
+	return cli.Command(&EventSubscriptionList{client: c.ClientFactory}, cobra.Command{
+		Use:     "list [flags] [SUBSCRIPTION_NAME...]",
+		Aliases: []string{"ls"},
+		Example: `
+acorn events subscription ls`,
+		SilenceUsage: true,
+		Short:        "List event subscriptions",
+	})
+}
+
+type EventSubscriptionList struct {
+	Quiet  bool   `usage:"Output only names" short:"q"`
+	Output string `usage:"Output format (json, yaml, {{gotemplate}})" short:"o"`
+	client ClientFactory
+}
+
+func (a *EventSubscriptionList) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	out := table.NewWriter(tables.EventSubscription, a.Quiet, a.Output)
+
+	subs, err := c.EventSubscriptionList(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	for _, sub := range subs {
+		if len(args) == 0 || slices.Contains(args, sub.Name) {
+			out.Write(&sub)
+		}
+	}
+
+	return out.Err()
+}
+
+func NewEventSubscriptionCreate(c CommandContext) *cobra.Command {
+	return cli.Command(&EventSubscriptionCreate{client: c.ClientFactory}, cobra.Command{
+		Use: "create [flags] SUBSCRIPTION_NAME",
+		Example: `
+# Deliver all events of the current project to a webhook
+acorn events subscription create my-hook --url https://example.com/hook
+
+# Deliver only critical events about apps whose name starts with "prod-"
+acorn events subscription create my-hook --url https://example.com/hook --severity critical --source-kind app --source-name-prefix prod-`,
+		SilenceUsage: true,
+		Short:        "Create a subscription that delivers events to a webhook",
+		Long: `Create a subscription that POSTs the events of the current project that match its filters to a webhook.
+
+Every delivery is signed with HMAC-SHA256 and the signature is sent in the X-Acorn-Signature header. Unless
+--signing-secret is given, a token secret named SUBSCRIPTION_NAME-signing-key is created for the key.`,
+		Args: cobra.ExactArgs(1),
+	})
+}
+
+type EventSubscriptionCreate struct {
+	URL              string   `usage:"URL of the webhook events are POSTed to" name:"url"`
+	Type             []string `usage:"Only deliver events of this type"`
+	Severity         []string `usage:"Only deliver events with this severity (info, warn, critical)"`
+	SourceKind       []string `usage:"Only deliver events with a source of this kind (e.g. app)"`
+	SourceNamePrefix string   `usage:"Only deliver events with a source name starting with this prefix"`
+	SigningSecret    string   `usage:"Name of an existing token secret whose token is used to sign deliveries"`
+	client           ClientFactory
+}
+
+func (a *EventSubscriptionCreate) Run(cmd *cobra.Command, args []string) error {
+	if a.URL == "" {
+		return fmt.Errorf("--url is required")
+	}
+
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	spec := v1.EventSubscriptionSpec{
+		URL:               a.URL,
+		SigningSecretName: a.SigningSecret,
+		Types:             a.Type,
+		SourceKinds:       a.SourceKind,
+		SourceNamePrefix:  a.SourceNamePrefix,
+	}
+	for _, severity := range a.Severity {
+		spec.Severities = append(spec.Severities, v1.EventSeverity(severity))
+	}
+
+	if spec.SigningSecretName == "" {
+		token, err := randomtoken.Generate()
+		if err != nil {
+			return err
+		}
+		spec.SigningSecretName = args[0] + "-signing-key"
+		if _, err := c.SecretCreate(cmd.Context(), spec.SigningSecretName, string(v1.SecretTypeToken), map[string][]byte{
+			"token": []byte(token),
+		}); err != nil {
+			return fmt.Errorf("creating signing secret %s: %w", spec.SigningSecretName, err)
+		}
+		pterm.Info.Printfln("Created secret %s with the signing key, run \"acorn secret reveal %[1]s\" to show it", spec.SigningSecretName)
+	}
+
+	sub, err := c.EventSubscriptionCreate(cmd.Context(), args[0], spec)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(sub.Name)
+	return nil
+}
+
+func NewEventSubscriptionDelete(c CommandContext) *cobra.Command {
+	return cli.Command(&EventSubscriptionDelete{client: c.ClientFactory}, cobra.Command{
+		Use:          "rm [SUBSCRIPTION_NAME...]",
+		Example:      `acorn events subscription rm my-hook`,
+		SilenceUsage: true,
+		Short:        "Delete an event subscription",
+	})
+}
+
+type EventSubscriptionDelete struct {
+	client ClientFactory
+}
+
+func (a *EventSubscriptionDelete) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	for _, name := range args {
+		deleted, err := c.EventSubscriptionDelete(cmd.Context(), name)
+		if err != nil {
+			return fmt.Errorf("deleting %s: %w", name, err)
+		}
+		if deleted != nil {
+			fmt.Println(name)
+		} else {
+			fmt.Printf("Error: No such event subscription: %s\n", name)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventSubscription(t *testing.T) {
+	var _, w, _ = os.Pipe()
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr bool
+		wantOut string
+	}{
+		{
+			name:    "acorn events subscription",
+			args:    []string{"subscription"},
+			wantOut: "NAME      URL                        DELIVERED   LAST-ATTEMPT   LAST-ERROR\nfound     https://example.com/hook   3                          \n",
+		},
+		{
+			name:    "acorn events subscription ls -q",
+			args:    []string{"subscription", "ls", "-q"},
+			wantOut: "found\n",
+		},
+		{
+			name:    "acorn events subscription create",
+			args:    []string{"subscription", "create", "my-hook", "--url", "https://example.com/hook", "--signing-secret", "key"},
+			wantOut: "my-hook\n",
+		},
+		{
+			name:    "acorn events subscription create without url",
+			args:    []string{"subscription", "create", "my-hook"},
+			wantErr: true,
+			wantOut: "--url is required",
+		},
+		{
+			name:    "acorn events subscription rm found",
+			args:    []string{"subscription", "rm", "found"},
+			wantOut: "found\n",
+		},
+		{
+			name:    "acorn events subscription rm dne",
+			args:    []string{"subscription", "rm", "dne"},
+			wantOut: "Error: No such event subscription: dne\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, stdout, _ := os.Pipe()
+			os.Stdout = stdout
+			cmd := NewEvent(CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			})
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			if tt.wantErr {
+				if assert.Error(t, err) {
+					assert.Equal(t, tt.wantOut, err.Error())
+				}
+				return
+			}
+			assert.NoError(t, err)
+			stdout.Close()
+			out, _ := io.ReadAll(r)
+			assert.Equal(t, tt.wantOut, string(out))
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"fmt"
+
 	cli "github.com/acorn-io/runtime/pkg/cli/builder"
 	"github.com/acorn-io/runtime/pkg/cli/builder/table"
 	"github.com/acorn-io/runtime/pkg/client"
@@ -12,8 +14,11 @@ import (
 
 func NewImageDetails(c CommandContext) *cobra.Command {
 	cmd := cli.Command(&ImageDetails{client: c.ClientFactory}, cobra.Command{
-		Use:               "details IMAGE_NAME [NESTED DIGEST]",
-		Example:           `acorn image details my-image`,
+		Use: "details IMAGE_NAME [NESTED DIGEST]",
+		Example: `acorn image details my-image
+
+# Show the SBOMs generated when the image was built
+acorn image details my-image --sbom`,
 		Aliases:           []string{"detail"},
 		SilenceUsage:      true,
 		Short:             "Show details of an Image",
@@ -26,6 +31,7 @@ func NewImageDetails(c CommandContext) *cobra.Command {
 type ImageDetails struct {
 	client ClientFactory
 	Output string `usage:"Output format (json, yaml, aml)" short:"o" local:"true" default:"yaml"`
+	SBOM   bool   `usage:"Show the SBOMs attested for the image instead of its details" local:"true"`
 }
 
 func (a *ImageDetails) Run(cmd *cobra.Command, args []string) error {
@@ -62,13 +68,21 @@ func (a *ImageDetails) Run(cmd *cobra.Command, args []string) error {
 	image, err := c.ImageDetails(cmd.Context(), args[0], &client.ImageDetailsOptions{
 		NestedDigest: nested,
 		Auth:         auth,
+		IncludeSBOM:  a.SBOM,
 	})
 	if err != nil {
 		return err
 	}
 
 	w := table.NewWriter(nil, false, a.Output)
-	w.WriteFormatted(image.AppImage, nil)
+	if a.SBOM {
+		if len(image.SBOMs) == 0 {
+			return fmt.Errorf("no SBOMs found for image %s", args[0])
+		}
+		w.WriteFormatted(image.SBOMs, nil)
+	} else {
+		w.WriteFormatted(image.AppImage, nil)
+	}
 
 	return w.Close()
 }
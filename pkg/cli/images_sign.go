@@ -0,0 +1,124 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/AlecAivazis/survey/v2"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/acorn-io/runtime/pkg/client/term"
+	"github.com/acorn-io/runtime/pkg/config"
+	"github.com/acorn-io/runtime/pkg/cosign"
+	"github.com/acorn-io/runtime/pkg/credentials"
+	"github.com/acorn-io/runtime/pkg/images"
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/pterm/pterm"
+	ociremote "github.com/sigstore/cosign/v2/pkg/oci/remote"
+	"github.com/spf13/cobra"
+)
+
+func NewImageSign(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&ImageSign{client: c.ClientFactory}, cobra.Command{
+		Use: "sign IMAGE_NAME [flags]",
+		Example: `# Sign an image and all images nested in it with a local cosign key
+acorn image sign ghcr.io/myorg/myapp:v1 --key ./cosign.key
+
+# Add annotations to the signature that ImageAllowRules can match on
+acorn image sign ghcr.io/myorg/myapp:v1 --key ./cosign.key --annotation tag=ok`,
+		SilenceUsage: true,
+		Short:        "Sign an Image",
+		Long: `Sign an Image and all images nested in it and push the signatures to the registry of the image.
+
+The password of an encrypted key is read from the COSIGN_PASSWORD environment variable or prompted for.`,
+		Args: cobra.ExactArgs(1),
+	})
+	return cmd
+}
+
+type ImageSign struct {
+	client     ClientFactory
+	Key        string   `usage:"Key to sign with (path to a cosign private key, k8s://, pkcs11:// or a KMS reference)" short:"k" local:"true"`
+	Annotation []string `usage:"Annotation to add to the signature (format key=value)" short:"a" local:"true"`
+}
+
+func (a *ImageSign) Run(cmd *cobra.Command, args []string) error {
+	if a.Key == "" {
+		return fmt.Errorf("--key is required")
+	}
+
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	ref, err := name.ParseReference(args[0])
+	if err != nil {
+		return err
+	}
+
+	keychain, err := registryKeychain(cmd.Context(), c, ref)
+	if err != nil {
+		return err
+	}
+
+	annotations := map[string]interface{}{}
+	for _, nv := range v1.ParseNameValues(false, a.Annotation...) {
+		annotations[nv.Name] = nv.Value
+	}
+
+	signed, err := cosign.SignImage(cmd.Context(), args[0], cosign.SignOpts{
+		Key:           a.Key,
+		PassFunc:      keyPassword,
+		Annotations:   annotations,
+		OciRemoteOpts: []ociremote.Option{ociremote.WithRemoteOptions(remote.WithContext(cmd.Context()), remote.WithAuthFromKeychain(keychain))},
+		CraneOpts:     []crane.Option{crane.WithContext(cmd.Context()), crane.WithAuthFromKeychain(keychain)},
+	})
+	for _, digest := range signed {
+		pterm.Success.Printfln("Signed %s", digest)
+	}
+	return err
+}
+
+// registryKeychain returns a keychain that authenticates against the registry of the image with the credentials known
+// to acorn, falling back to the docker credentials of the local machine
+func registryKeychain(ctx context.Context, c client.Client, ref name.Reference) (authn.Keychain, error) {
+	cfg, err := config.ReadCLIConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	creds, err := credentials.NewStore(cfg, c)
+	if err != nil {
+		return nil, err
+	}
+
+	auth, found, err := creds.Get(ctx, ref.Context().RegistryStr())
+	if err != nil {
+		return nil, err
+	} else if !found {
+		return authn.DefaultKeychain, nil
+	}
+
+	return images.NewSimpleKeychain(ref.Context(), *auth, authn.DefaultKeychain), nil
+}
+
+// keyPassword follows the cosign CLI in reading the password of an encrypted private key from the environment before
+// prompting for it
+func keyPassword(_ bool) ([]byte, error) {
+	if pass, ok := os.LookupEnv("COSIGN_PASSWORD"); ok {
+		return []byte(pass), nil
+	}
+	if !term.IsTerminal(os.Stdin) {
+		return nil, nil
+	}
+
+	var pass string
+	err := survey.AskOne(&survey.Password{Message: "Password for private key"}, &pass)
+	return []byte(pass), err
+}
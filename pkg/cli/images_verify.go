@@ -0,0 +1,137 @@
+package cli
+
+import (
+	"fmt"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/cli/builder/table"
+	"github.com/acorn-io/runtime/pkg/cosign"
+	"github.com/acorn-io/runtime/pkg/imageallowrules"
+	"github.com/acorn-io/runtime/pkg/tables"
+	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	ociremote "github.com/sigstore/cosign/v2/pkg/oci/remote"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// keyRuleName is the name shown for the rule built from the --key and --annotation flags
+const keyRuleName = "(flags)"
+
+func NewImageVerify(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&ImageVerify{client: c.ClientFactory}, cobra.Command{
+		Use: "verify IMAGE_NAME [flags]",
+		Example: `# Verify an image against the ImageAllowRules of the current project
+acorn image verify ghcr.io/myorg/myapp:v1
+
+# Verify an image was signed with a key and with matching annotations
+acorn image verify ghcr.io/myorg/myapp:v1 --key ./cosign.pub --annotation tag=ok`,
+		SilenceUsage: true,
+		Short:        "Verify the signatures of an Image",
+		Long: `Verify the signatures of an Image locally and show which rules it is allowed by.
+
+Without --key, the image is checked against the ImageAllowRules of the current project.`,
+		Args: cobra.ExactArgs(1),
+	})
+	return cmd
+}
+
+type ImageVerify struct {
+	client             ClientFactory
+	Key                []string `usage:"Key the image must be signed with (path to a public key, k8s://, or a KMS reference)" short:"k" local:"true"`
+	Annotation         []string `usage:"Annotation the signature must have when verifying with --key (format key=value)" short:"a" local:"true"`
+	SignatureAlgorithm string   `usage:"Signature algorithm (sha256, sha512)" local:"true" default:"sha256"`
+	Output             string   `usage:"Output format (json, yaml, {{gotemplate}})" short:"o" local:"true"`
+}
+
+func (a *ImageVerify) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	ref, err := name.ParseReference(args[0])
+	if err != nil {
+		return err
+	}
+
+	keychain, err := registryKeychain(cmd.Context(), c, ref)
+	if err != nil {
+		return err
+	}
+
+	verifyOpts := cosign.VerifyOpts{
+		SignatureAlgorithm: a.SignatureAlgorithm,
+		OciRemoteOpts:      []ociremote.Option{ociremote.WithRemoteOptions(remote.WithContext(cmd.Context()), remote.WithAuthFromKeychain(keychain))},
+		CraneOpts:          []crane.Option{crane.WithContext(cmd.Context()), crane.WithAuthFromKeychain(keychain)},
+		// the signatures are verified locally, not from the cache of the cluster
+		NoCache: true,
+	}
+	if err := cosign.EnsureReferences(cmd.Context(), nil, args[0], &verifyOpts); err != nil {
+		return err
+	}
+
+	var rules []v1.ImageAllowRuleInstance
+	if len(a.Key) > 0 {
+		annotations := map[string]string{}
+		for _, nv := range v1.ParseNameValues(false, a.Annotation...) {
+			annotations[nv.Name] = nv.Value
+		}
+		rules = append(rules, v1.ImageAllowRuleInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: keyRuleName,
+			},
+			// the rule only exists for this image, so it covers all images
+			Images: []string{"**"},
+			Signatures: v1.ImageAllowRuleSignatures{
+				Rules: []v1.SignatureRules{
+					{
+						SignedBy: v1.SignedBy{
+							AllOf: a.Key,
+						},
+						Annotations: v1.SignatureAnnotations{
+							Match: annotations,
+						},
+					},
+				},
+			},
+		})
+	} else {
+		wc, err := c.GetClient()
+		if err != nil {
+			return err
+		}
+
+		iars := &apiv1.ImageAllowRuleList{}
+		if err := wc.List(cmd.Context(), iars, kclient.InNamespace(c.GetNamespace())); err != nil {
+			return err
+		}
+		for _, iar := range iars.Items {
+			rules = append(rules, v1.ImageAllowRuleInstance(iar))
+		}
+	}
+
+	results, err := imageallowrules.CheckImageAgainstEachRule(cmd.Context(), nil, c.GetNamespace(), args[0], verifyOpts.ImageRef.DigestStr(), rules, verifyOpts)
+	if err != nil {
+		return err
+	}
+
+	out := table.NewWriter(tables.ImageVerifyResult, false, a.Output)
+	allowed := false
+	for _, r := range results {
+		out.WriteFormatted(&r, nil)
+		allowed = allowed || r.Allowed
+	}
+	if err := out.Close(); err != nil {
+		return err
+	}
+
+	if !allowed {
+		return fmt.Errorf("image %s is not allowed by any rule", args[0])
+	}
+	return nil
+}
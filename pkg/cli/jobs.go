@@ -0,0 +1,176 @@
+package cli
+
+import (
+	"fmt"
+
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/cli/builder/table"
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/acorn-io/runtime/pkg/log"
+	"github.com/acorn-io/runtime/pkg/tables"
+	"github.com/spf13/cobra"
+)
+
+func NewJob(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&JobList{client: c.ClientFactory}, cobra.Command{
+		Use:     "job [flags] [APP_NAME...]",
+		Aliases: []string{"jobs"},
+		Example: `
+acorn jobs`,
+		SilenceUsage:      true,
+		Short:             "Manage jobs",
+		Args:              cobra.ArbitraryArgs,
+		ValidArgsFunction: newCompletion(c.ClientFactory, appsCompletion).complete,
+	})
+	cmd.AddCommand(NewJobList(c))
+	cmd.AddCommand(NewJobHistory(c))
+	cmd.AddCommand(NewJobRun(c))
+	return cmd
+}
+
+func NewJobList(c CommandContext) *cobra.Command {
+	return cli.Command(&JobList{client: c.ClientFactory}, cobra.Command{
+		Use:     "list [flags] [APP_NAME...]",
+		Aliases: []string{"ls"},
+		Example: `
+acorn job list
+acorn job list my-app`,
+		SilenceUsage:      true,
+		Short:             "List the most recent execution of each job",
+		ValidArgsFunction: newCompletion(c.ClientFactory, appsCompletion).complete,
+	})
+}
+
+type JobList struct {
+	Quiet  bool   `usage:"Output only names" short:"q"`
+	Output string `usage:"Output format (json, yaml, {{gotemplate}})" short:"o"`
+	client ClientFactory
+}
+
+func (a *JobList) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	out := table.NewWriter(tables.JobExecution, a.Quiet, a.Output)
+
+	if len(args) == 0 {
+		args = []string{""}
+	}
+
+	for _, appName := range args {
+		executions, err := c.JobExecutionList(cmd.Context(), &client.JobExecutionListOptions{App: appName})
+		if err != nil {
+			return err
+		}
+
+		// Executions are sorted newest first, so the first execution of each job is its most recent
+		seen := map[string]bool{}
+		for _, execution := range executions {
+			key := execution.Spec.AppName + "." + execution.Spec.JobName
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			out.Write(&execution)
+		}
+	}
+
+	return out.Err()
+}
+
+func NewJobHistory(c CommandContext) *cobra.Command {
+	return cli.Command(&JobHistory{client: c.ClientFactory}, cobra.Command{
+		Use: "history [flags] APP_NAME.JOB_NAME",
+		Example: `
+acorn job history my-app.backup
+acorn job history --logs my-app.backup`,
+		SilenceUsage:      true,
+		Short:             "List the executions of a job",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: newCompletion(c.ClientFactory, jobsCompletion(false)).withShouldCompleteOptions(onlyNumArgs(1)).complete,
+	})
+}
+
+type JobHistory struct {
+	Quiet  bool   `usage:"Output only names" short:"q"`
+	Output string `usage:"Output format (json, yaml, {{gotemplate}})" short:"o"`
+	Logs   bool   `usage:"Print the logs of each execution" short:"l"`
+	client ClientFactory
+}
+
+func (a *JobHistory) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	appName, jobName, ok := client.SplitJobName(args[0])
+	if !ok {
+		return fmt.Errorf("invalid job name [%s], must be in the form <app>.<job>", args[0])
+	}
+
+	executions, err := c.JobExecutionList(cmd.Context(), &client.JobExecutionListOptions{
+		App: appName,
+		Job: jobName,
+	})
+	if err != nil {
+		return err
+	}
+
+	if !a.Logs {
+		out := table.NewWriter(tables.JobExecution, a.Quiet, a.Output)
+		for _, execution := range executions {
+			out.Write(&execution)
+		}
+		return out.Err()
+	}
+
+	for _, execution := range executions {
+		out := table.NewWriter(tables.JobExecution, a.Quiet, a.Output)
+		out.Write(&execution)
+		if err := out.Err(); err != nil {
+			return err
+		}
+		for _, replica := range execution.Status.ContainerReplicaNames {
+			if err := log.Output(cmd.Context(), c, replica, &client.LogOptions{}); err != nil {
+				return err
+			}
+		}
+		fmt.Println()
+	}
+
+	return nil
+}
+
+func NewJobRun(c CommandContext) *cobra.Command {
+	return cli.Command(&JobRun{client: c.ClientFactory}, cobra.Command{
+		Use: "run [flags] APP_NAME.JOB_NAME",
+		Example: `
+acorn job run my-app.backup`,
+		SilenceUsage:      true,
+		Short:             "Run a scheduled job now",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: newCompletion(c.ClientFactory, jobsCompletion(true)).withShouldCompleteOptions(onlyNumArgs(1)).complete,
+	})
+}
+
+type JobRun struct {
+	client ClientFactory
+}
+
+func (a *JobRun) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	execution, err := c.JobRun(cmd.Context(), args[0])
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(execution.Name)
+	return nil
+}
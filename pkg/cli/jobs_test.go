@@ -0,0 +1,84 @@
+package cli
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJob(t *testing.T) {
+	tests := []struct {
+		name    string
+		cmd     func(CommandContext) *cobra.Command
+		args    []string
+		wantErr bool
+		wantOut string
+	}{
+		{
+			name:    "acorn job list -q",
+			cmd:     NewJobList,
+			args:    []string{"-q"},
+			wantOut: "found.backup-manual-abcde\n",
+		},
+		{
+			name:    "acorn job history -q found.backup",
+			cmd:     NewJobHistory,
+			args:    []string{"-q", "found.backup"},
+			wantOut: "found.backup-manual-abcde\nfound.backup-28123456\n",
+		},
+		{
+			name:    "acorn job history -o template found.backup",
+			cmd:     NewJobHistory,
+			args:    []string{"-o", "{{.Status.Trigger}} {{.Status.State}} {{.Status.ExitCode}}", "found.backup"},
+			wantOut: "manual succeeded 0\nschedule failed 1\n",
+		},
+		{
+			name:    "acorn job history found",
+			cmd:     NewJobHistory,
+			args:    []string{"found"},
+			wantErr: true,
+			wantOut: "invalid job name [found], must be in the form <app>.<job>",
+		},
+		{
+			name:    "acorn job run found.backup",
+			cmd:     NewJobRun,
+			args:    []string{"found.backup"},
+			wantOut: "found.backup-manual-abcde\n",
+		},
+		{
+			name:    "acorn job run found.dne",
+			cmd:     NewJobRun,
+			args:    []string{"found.dne"},
+			wantErr: true,
+			wantOut: "error: job found.dne does not exist",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+			cmd := tt.cmd(CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			})
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			if err != nil && !tt.wantErr {
+				assert.Failf(t, "got err when err not expected", "got err: %s", err.Error())
+			} else if err != nil && tt.wantErr {
+				assert.Equal(t, tt.wantOut, err.Error())
+			} else {
+				w.Close()
+				out, _ := io.ReadAll(r)
+				assert.Equal(t, tt.wantOut, string(out))
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"regexp"
 
 	cli "github.com/acorn-io/runtime/pkg/cli/builder"
 	"github.com/acorn-io/runtime/pkg/client"
@@ -12,9 +13,14 @@ import (
 func NewLogs(c CommandContext) *cobra.Command {
 	logs := &Logs{client: c.ClientFactory}
 	return cli.Command(logs, cobra.Command{
-		Use:               "logs [flags] [APP_NAME|CONTAINER_REPLICA_NAME]",
-		SilenceUsage:      true,
-		Short:             "Log all workloads from an app",
+		Use:          "logs [flags] [APP_NAME|CONTAINER_REPLICA_NAME]",
+		SilenceUsage: true,
+		Short:        "Log all workloads from an app",
+		Example: `# Show the lines of the last hour that contain "error" as JSON
+acorn logs -s 1h --grep error -o json my-app
+
+# Show the logs of one container replica up to 10 minutes ago
+acorn logs --until 10m my-app.web-5b9f8d7f4-x2x7k`,
 		Args:              cobra.MaximumNArgs(1),
 		ValidArgsFunction: newCompletion(c.ClientFactory, appsThenContainersCompletion).withShouldCompleteOptions(onlyNumArgs(1)).complete,
 	})
@@ -23,12 +29,25 @@ func NewLogs(c CommandContext) *cobra.Command {
 type Logs struct {
 	Follow    bool   `short:"f" usage:"Follow log output"`
 	Since     string `short:"s" usage:"Show logs since timestamp (e.g. 42m for 42 minutes)"`
+	Until     string `usage:"Show logs until timestamp (e.g. 42m for 42 minutes ago, or 2023-07-01T12:00:00Z)"`
 	Tail      int64  `short:"n" usage:"Number of lines in log output"`
 	Container string `short:"c" usage:"Container name or Job name within app to follow"`
+	Grep      string `short:"g" usage:"Only show lines matching the regular expression"`
+	Output    string `short:"o" usage:"Output format (json)"`
 	client    ClientFactory
 }
 
 func (s *Logs) Run(cmd *cobra.Command, args []string) error {
+	if s.Output != "" && s.Output != "json" {
+		return fmt.Errorf("invalid output format %q: must be json", s.Output)
+	}
+	if s.Follow && s.Until != "" {
+		return fmt.Errorf("--until cannot be used with --follow")
+	}
+	if _, err := regexp.Compile(s.Grep); err != nil {
+		return fmt.Errorf("invalid grep expression: %w", err)
+	}
+
 	c, err := s.client.CreateDefault()
 	if err != nil {
 		return err
@@ -51,10 +70,17 @@ func (s *Logs) Run(cmd *cobra.Command, args []string) error {
 	} else {
 		tailLines = &s.Tail
 	}
-	return log.Output(cmd.Context(), c, args[0], &client.LogOptions{
+
+	output := log.Output
+	if s.Output == "json" {
+		output = log.OutputJSON
+	}
+	return output(cmd.Context(), c, args[0], &client.LogOptions{
 		Follow:    s.Follow,
 		Container: s.Container,
 		Tail:      tailLines,
 		Since:     s.Since,
+		Until:     s.Until,
+		Grep:      s.Grep,
 	})
 }
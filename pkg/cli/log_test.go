@@ -107,6 +107,82 @@ func TestLog(t *testing.T) {
 			wantErr: false,
 			wantOut: "",
 		},
+		{
+			name: "acorn logs -o json", fields: fields{
+				All:    false,
+				Quiet:  false,
+				Output: "",
+			},
+			commandContext: CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			},
+			args: args{
+				args:   []string{"-o", "json", "found-with-logs"},
+				client: &testdata.MockClient{},
+			},
+			wantErr: false,
+			wantOut: "{\"line\":\"hello\",\"appName\":\"found-with-logs\",\"containerName\":\"found-with-logs-pod\",\"replicaName\":\"found-with-logs.found-with-logs-pod\",\"time\":\"2023-07-01T12:00:00Z\"}\n",
+		},
+		{
+			name: "acorn logs -o yaml", fields: fields{
+				All:    false,
+				Quiet:  false,
+				Output: "",
+			},
+			commandContext: CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			},
+			args: args{
+				args:   []string{"-o", "yaml", "found"},
+				client: &testdata.MockClient{},
+			},
+			wantErr: true,
+			wantOut: "invalid output format \"yaml\": must be json",
+		},
+		{
+			name: "acorn logs --grep invalid", fields: fields{
+				All:    false,
+				Quiet:  false,
+				Output: "",
+			},
+			commandContext: CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			},
+			args: args{
+				args:   []string{"--grep", "(", "found"},
+				client: &testdata.MockClient{},
+			},
+			wantErr: true,
+			wantOut: "invalid grep expression: error parsing regexp: missing closing ): `(`",
+		},
+		{
+			name: "acorn logs --until -f", fields: fields{
+				All:    false,
+				Quiet:  false,
+				Output: "",
+			},
+			commandContext: CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			},
+			args: args{
+				args:   []string{"--until", "10m", "-f", "found"},
+				client: &testdata.MockClient{},
+			},
+			wantErr: true,
+			wantOut: "--until cannot be used with --follow",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
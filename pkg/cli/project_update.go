@@ -3,7 +3,9 @@ package cli
 import (
 	"fmt"
 
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/logsink"
 	"github.com/acorn-io/runtime/pkg/project"
 	"github.com/spf13/cobra"
 )
@@ -13,6 +15,12 @@ func NewProjectUpdate(c CommandContext) *cobra.Command {
 		Use: "update [flags] PROJECT_NAME",
 		Example: `
 acorn project update my-project
+
+# Forward the logs of all apps in the project to a syslog server and an HTTP endpoint
+acorn project update my-project --log-sink syslog+tcp://logs.example.com:514 --log-sink https://logs.example.com/ingest
+
+# Stop forwarding logs
+acorn project update my-project --log-sink ""
 `,
 		SilenceUsage:      true,
 		Short:             "Update project",
@@ -34,6 +42,7 @@ type ProjectUpdate struct {
 	client           ClientFactory
 	DefaultRegion    string   `usage:"Default region for project resources"`
 	SupportedRegions []string `name:"supported-region" usage:"Supported regions for the created project"`
+	LogSinks         []string `name:"log-sink" usage:"Forward the logs of all apps in the project (syslog+tcp://host:port, syslog+udp://host:port, http(s)://host/path or file:///path), use \"\" to clear"`
 }
 
 func (a *ProjectUpdate) Run(cmd *cobra.Command, args []string) error {
@@ -41,6 +50,20 @@ func (a *ProjectUpdate) Run(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	if len(a.LogSinks) != 0 {
+		var sinks []apiv1.LogSink
+		for _, s := range a.LogSinks {
+			if s == "" {
+				continue
+			}
+			sink, err := logsink.Parse(s)
+			if err != nil {
+				return err
+			}
+			sinks = append(sinks, sink)
+		}
+		projectsDetails[0].Project.Spec.LogSinks = sinks
+	}
 	if err := project.Update(cmd.Context(), a.client.Options(), projectsDetails[0], a.DefaultRegion, a.SupportedRegions); err != nil {
 		return err
 	} else {
@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"fmt"
+
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/spf13/cobra"
+)
+
+func NewRollback(c CommandContext) *cobra.Command {
+	return cli.Command(&Rollback{client: c.ClientFactory}, cobra.Command{
+		Use: "rollback [flags] APP_NAME",
+		Example: `
+# Roll back to the revision before the current one
+acorn rollback my-app
+
+# Roll back to revision 3 as listed by "acorn app history my-app"
+acorn rollback my-app --to 3`,
+		SilenceUsage:      true,
+		Short:             "Restore a previous revision of an app",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: newCompletion(c.ClientFactory, appsCompletion).withShouldCompleteOptions(onlyNumArgs(1)).complete,
+	})
+}
+
+type Rollback struct {
+	To     int64 `usage:"Revision to roll back to (default: the previous revision)"`
+	client ClientFactory
+}
+
+func (s *Rollback) Run(cmd *cobra.Command, args []string) error {
+	if s.To < 0 {
+		return fmt.Errorf("invalid revision %d", s.To)
+	}
+
+	c, err := s.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	app, err := c.AppRollback(cmd.Context(), args[0], s.To)
+	if err != nil {
+		return fmt.Errorf("rolling back %s: %w", args[0], err)
+	}
+
+	fmt.Println(app.Name)
+	return nil
+}
@@ -0,0 +1,82 @@
+package cli
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRollback(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr bool
+		wantOut string
+	}{
+		{
+			name:    "acorn rollback found",
+			args:    []string{"found"},
+			wantOut: "found\n",
+		},
+		{
+			name:    "acorn rollback found --to 1",
+			args:    []string{"found", "--to", "1"},
+			wantOut: "found\n",
+		},
+		{
+			name:    "acorn rollback found --to 5",
+			args:    []string{"found", "--to", "5"},
+			wantErr: true,
+			wantOut: "rolling back found: revision 5 of app found not found",
+		},
+		{
+			name:    "acorn rollback dne",
+			args:    []string{"dne"},
+			wantErr: true,
+			wantOut: "rolling back dne: error: app dne does not exist",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+			cmd := NewRollback(CommandContext{
+				ClientFactory: &testdata.MockClientFactory{},
+				StdOut:        w,
+				StdErr:        w,
+				StdIn:         strings.NewReader(""),
+			})
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			if err != nil && !tt.wantErr {
+				assert.Failf(t, "got err when err not expected", "got err: %s", err.Error())
+			} else if err != nil && tt.wantErr {
+				assert.Equal(t, tt.wantOut, err.Error())
+			} else {
+				w.Close()
+				out, _ := io.ReadAll(r)
+				assert.Equal(t, tt.wantOut, string(out))
+			}
+		})
+	}
+}
+
+func TestAppHistory(t *testing.T) {
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+	cmd := NewAppHistory(CommandContext{
+		ClientFactory: &testdata.MockClientFactory{},
+		StdOut:        w,
+		StdErr:        w,
+		StdIn:         strings.NewReader(""),
+	})
+	cmd.SetArgs([]string{"found", "-o", "{{.Revision}} {{.Image}} {{.UpdatedBy}}"})
+	assert.NoError(t, cmd.Execute())
+	w.Close()
+	out, _ := io.ReadAll(r)
+	assert.Equal(t, "2 test-image:v2 user\n1 test-image:v1 user\n", string(out))
+}
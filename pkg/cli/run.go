@@ -123,10 +123,14 @@ More Usages:
      - Create the volume named "mydata" with a size of 5 gigabyes and using the "fast" storage class
         acorn run --volume mydata,size=5G,class=fast .
      - Bind the acorn volume named "mydata" into the current app, replacing the volume named "data", See "acorn volumes --help for more info"
-        acorn run --volume mydata:data .`
+        acorn run --volume mydata:data .
+     - Create the volume named "data" from the volume snapshot named "before-upgrade". See "acorn volume snapshot --help" for more info
+        acorn run --volume data,snapshot=before-upgrade .
+     - Create the volume named "data" as a copy of the volume "data" of the app "staging"
+        acorn run --volume data,clone-of=staging.data .`
 
-var hideRunFlags = []string{"dangerous", "memory", "target-namespace", "secret", "volume", "region", "publish-all",
-	"publish", "link", "label", "interval", "env", "compute-class", "annotation", "update", "replace"}
+var hideRunFlags = []string{"dangerous", "memory", "cpu", "target-namespace", "secret", "volume", "region", "publish-all",
+	"publish", "link", "label", "interval", "env", "compute-class", "annotation", "rollout", "update", "replace"}
 
 type Run struct {
 	RunArgs
@@ -166,11 +170,21 @@ func (s RunArgs) ToOpts() (client.AppRunOptions, error) {
 		return opts, err
 	}
 
+	opts.CPU, err = v1.ParseCPU(s.CPU)
+	if err != nil {
+		return opts, err
+	}
+
 	opts.ComputeClasses, err = v1.ParseComputeClass(s.ComputeClass)
 	if err != nil {
 		return opts, err
 	}
 
+	opts.Rollout, err = v1.ParseRolloutStrategy(s.Rollout)
+	if err != nil {
+		return opts, err
+	}
+
 	opts.Volumes, err = v1.ParseVolumes(s.Volume, true)
 	if err != nil {
 		return opts, err
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"time"
 
 	"github.com/acorn-io/runtime/pkg/labels"
 
@@ -91,10 +92,13 @@ type MockClient struct {
 	AppItem          *apiv1.App
 	Containers       []apiv1.ContainerReplica
 	ContainerItem    *apiv1.ContainerReplica
+	ContainerUsages  []apiv1.ContainerReplicaUsage
 	Credentials      []apiv1.Credential
 	CredentialItem   *apiv1.Credential
 	Volumes          []apiv1.Volume
 	VolumeItem       *apiv1.Volume
+	VolumeSnapshots  []apiv1.VolumeSnapshot
+	VolumeBackups    []apiv1.VolumeBackup
 	Secrets          []apiv1.Secret
 	SecretItem       *apiv1.Secret
 	Images           []apiv1.Image
@@ -156,6 +160,28 @@ func (m *MockClient) AppIgnoreDeleteCleanup(ctx context.Context, name string) er
 	return nil
 }
 
+func (m *MockClient) AppHistory(ctx context.Context, name string) ([]v1.AppRevision, error) {
+	switch name {
+	case "found":
+		return []v1.AppRevision{
+			{Revision: 2, Image: "test-image:v2", ImageDigest: "sha256:v2", UpdatedBy: "user"},
+			{Revision: 1, Image: "test-image:v1", ImageDigest: "sha256:v1", UpdatedBy: "user"},
+		}, nil
+	}
+	return nil, fmt.Errorf("error: app %s does not exist", name)
+}
+
+func (m *MockClient) AppRollback(ctx context.Context, name string, revision int64) (*apiv1.App, error) {
+	switch name {
+	case "found":
+		if revision > 2 {
+			return nil, fmt.Errorf("revision %d of app %s not found", revision, name)
+		}
+		return &apiv1.App{ObjectMeta: metav1.ObjectMeta{Name: name}}, nil
+	}
+	return nil, fmt.Errorf("error: app %s does not exist", name)
+}
+
 func (m *MockClient) AppGet(ctx context.Context, name string) (*apiv1.App, error) {
 	if m.AppItem != nil {
 		return m.AppItem, nil
@@ -261,6 +287,17 @@ func (m *MockClient) AppLog(ctx context.Context, name string, opts *client.LogOp
 		progresses := make(chan apiv1.LogMessage)
 		close(progresses)
 		return progresses, nil
+	case "found-with-logs":
+		progresses := make(chan apiv1.LogMessage, 1)
+		progresses <- apiv1.LogMessage{
+			Line:          "hello",
+			AppName:       "found-with-logs",
+			ContainerName: "found-with-logs-pod",
+			ReplicaName:   "found-with-logs.found-with-logs-pod",
+			Time:          metav1.NewTime(time.Unix(1688212800, 0).UTC()),
+		}
+		close(progresses)
+		return progresses, nil
 	case "dne":
 		progresses := make(chan apiv1.LogMessage)
 		close(progresses)
@@ -445,6 +482,19 @@ func (m *MockClient) ContainerReplicaList(ctx context.Context, opts *client.Cont
 	}}, nil
 }
 
+func (m *MockClient) ContainerReplicaUsageList(ctx context.Context, opts *client.ContainerReplicaListOptions) ([]apiv1.ContainerReplicaUsage, error) {
+	if opts == nil || opts.App == "" {
+		return m.ContainerUsages, nil
+	}
+	result := make([]apiv1.ContainerReplicaUsage, 0, len(m.ContainerUsages))
+	for _, u := range m.ContainerUsages {
+		if u.AppName == opts.App {
+			result = append(result, u)
+		}
+	}
+	return result, nil
+}
+
 func (m *MockClient) ContainerReplicaGet(ctx context.Context, name string) (*apiv1.ContainerReplica, error) {
 	if m.ContainerItem != nil {
 		return m.ContainerItem, nil
@@ -492,6 +542,64 @@ func (m *MockClient) ContainerReplicaPortForward(ctx context.Context, name strin
 	return nil, nil
 }
 
+func (m *MockClient) JobExecutionList(ctx context.Context, opts *client.JobExecutionListOptions) ([]apiv1.JobExecution, error) {
+	executions := []apiv1.JobExecution{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "found.backup-manual-abcde"},
+			Spec:       apiv1.JobExecutionSpec{AppName: "found", JobName: "backup"},
+			Status: apiv1.JobExecutionStatus{
+				Trigger:  apiv1.JobExecutionTriggerManual,
+				State:    apiv1.JobExecutionStateSucceeded,
+				ExitCode: &[]int32{0}[0],
+				Attempts: 1,
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "found.backup-28123456"},
+			Spec:       apiv1.JobExecutionSpec{AppName: "found", JobName: "backup"},
+			Status: apiv1.JobExecutionStatus{
+				Trigger:  apiv1.JobExecutionTriggerSchedule,
+				State:    apiv1.JobExecutionStateFailed,
+				ExitCode: &[]int32{1}[0],
+				Attempts: 2,
+				Message:  "Job has reached the specified backoff limit",
+			},
+		},
+	}
+	if opts == nil {
+		return executions, nil
+	}
+	// Do the filtering to make testing simpler
+	result := make([]apiv1.JobExecution, 0, len(executions))
+	for _, e := range executions {
+		if (opts.App == "" || e.Spec.AppName == opts.App) && (opts.Job == "" || e.Spec.JobName == opts.Job) {
+			result = append(result, e)
+		}
+	}
+	return result, nil
+}
+
+func (m *MockClient) JobExecutionGet(ctx context.Context, name string) (*apiv1.JobExecution, error) {
+	executions, _ := m.JobExecutionList(ctx, nil)
+	for _, e := range executions {
+		if e.Name == name {
+			return &e, nil
+		}
+	}
+	return nil, fmt.Errorf("error: job execution %s does not exist", name)
+}
+
+func (m *MockClient) JobRun(ctx context.Context, name string) (*apiv1.JobExecution, error) {
+	switch name {
+	case "found.backup":
+		return &apiv1.JobExecution{
+			ObjectMeta: metav1.ObjectMeta{Name: "found.backup-manual-abcde"},
+			Spec:       apiv1.JobExecutionSpec{AppName: "found", JobName: "backup"},
+		}, nil
+	}
+	return nil, fmt.Errorf("error: job %s does not exist", name)
+}
+
 func (m *MockClient) VolumeList(ctx context.Context) ([]apiv1.Volume, error) {
 	if m.Volumes != nil {
 		return m.Volumes, nil
@@ -556,6 +664,72 @@ func (m *MockClient) VolumeDelete(ctx context.Context, name string) (*apiv1.Volu
 	return nil, nil
 }
 
+func (m *MockClient) VolumeSnapshotCreate(ctx context.Context, name, volume, class string) (*apiv1.VolumeSnapshot, error) {
+	if volume == "dne" {
+		return nil, fmt.Errorf("error: volume %s does not exist", volume)
+	}
+	return &apiv1.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: apiv1.VolumeSnapshotSpec{
+			Volume: volume,
+			Class:  class,
+		},
+	}, nil
+}
+
+func (m *MockClient) VolumeSnapshotList(ctx context.Context) ([]apiv1.VolumeSnapshot, error) {
+	return m.VolumeSnapshots, nil
+}
+
+func (m *MockClient) VolumeSnapshotGet(ctx context.Context, name string) (*apiv1.VolumeSnapshot, error) {
+	for i := range m.VolumeSnapshots {
+		if m.VolumeSnapshots[i].Name == name {
+			return &m.VolumeSnapshots[i], nil
+		}
+	}
+	return nil, fmt.Errorf("error: volume snapshot %s does not exist", name)
+}
+
+func (m *MockClient) VolumeSnapshotDelete(ctx context.Context, name string) (*apiv1.VolumeSnapshot, error) {
+	for i := range m.VolumeSnapshots {
+		if m.VolumeSnapshots[i].Name == name {
+			return &m.VolumeSnapshots[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *MockClient) VolumeBackupCreate(ctx context.Context, name string, spec apiv1.VolumeBackupSpec) (*apiv1.VolumeBackup, error) {
+	if spec.Volume == "dne" {
+		return nil, fmt.Errorf("error: volume %s does not exist", spec.Volume)
+	}
+	url := spec.To
+	if url == "" {
+		url = spec.From
+	}
+	return &apiv1.VolumeBackup{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       spec,
+		Status: apiv1.VolumeBackupStatus{
+			URL:   url,
+			State: apiv1.VolumeBackupStateSucceeded,
+		},
+	}, nil
+}
+
+func (m *MockClient) VolumeBackupList(ctx context.Context) ([]apiv1.VolumeBackup, error) {
+	return m.VolumeBackups, nil
+}
+
+func (m *MockClient) VolumeBackupGet(ctx context.Context, name string) (*apiv1.VolumeBackup, error) {
+	for i := range m.VolumeBackups {
+		if m.VolumeBackups[i].Name == name {
+			return &m.VolumeBackups[i], nil
+		}
+	}
+	return nil, fmt.Errorf("error: volume backup %s does not exist", name)
+}
+
 func (m *MockClient) ImageList(ctx context.Context) ([]apiv1.Image, error) {
 	if m.Images != nil {
 		return m.Images, nil
@@ -835,3 +1009,43 @@ func (m *MockClient) EventStream(context.Context, *client.EventStreamOptions) (<
 	// TODO: Implement me
 	return nil, nil
 }
+
+func (m *MockClient) EventSubscriptionCreate(_ context.Context, name string, spec v1.EventSubscriptionSpec) (*apiv1.EventSubscription, error) {
+	return &apiv1.EventSubscription{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       spec,
+	}, nil
+}
+
+func (m *MockClient) EventSubscriptionList(context.Context) ([]apiv1.EventSubscription, error) {
+	return []apiv1.EventSubscription{{
+		ObjectMeta: metav1.ObjectMeta{Name: "found"},
+		Spec: v1.EventSubscriptionSpec{
+			URL: "https://example.com/hook",
+		},
+		Status: v1.EventSubscriptionStatus{
+			Delivered: 3,
+		},
+	}}, nil
+}
+
+func (m *MockClient) EventSubscriptionGet(ctx context.Context, name string) (*apiv1.EventSubscription, error) {
+	subs, _ := m.EventSubscriptionList(ctx)
+	for _, sub := range subs {
+		if sub.Name == name {
+			return &sub, nil
+		}
+	}
+	return nil, apierrors.NewNotFound(schema.GroupResource{
+		Group:    "api.acorn.io",
+		Resource: "eventsubscriptions",
+	}, name)
+}
+
+func (m *MockClient) EventSubscriptionDelete(ctx context.Context, name string) (*apiv1.EventSubscription, error) {
+	sub, err := m.EventSubscriptionGet(ctx, name)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	return sub, err
+}
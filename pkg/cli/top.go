@@ -0,0 +1,148 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/cli/builder/table"
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/acorn-io/runtime/pkg/tables"
+	"github.com/spf13/cobra"
+	"k8s.io/utils/strings/slices"
+)
+
+// clearScreen moves the cursor to the top left of the terminal and clears it
+const clearScreen = "\033[H\033[2J"
+
+func NewTop(c CommandContext) *cobra.Command {
+	return cli.Command(&Top{client: c.ClientFactory}, cobra.Command{
+		Use: "top [flags] [APP_NAME...]",
+		Example: `
+# Show the CPU and memory usage of all containers
+acorn top
+
+# Show the usage of the containers of my-app, refreshing every 10 seconds
+acorn top -w --interval 10s my-app`,
+		SilenceUsage:      true,
+		Short:             "Show the CPU and memory usage of apps",
+		Long:              "Show the current CPU and memory usage of the running containers of apps, as reported by the metrics API of the cluster, against the memory they requested.",
+		ValidArgsFunction: newCompletion(c.ClientFactory, appsCompletion).complete,
+	})
+}
+
+type Top struct {
+	Output   string `usage:"Output format (json, yaml, {{gotemplate}})" short:"o"`
+	Watch    bool   `usage:"Refresh the usage until interrupted" short:"w"`
+	Interval string `usage:"Time between refreshes in watch mode (ex: 10s, 1m)" default:"5s"`
+	client   ClientFactory
+}
+
+func (a *Top) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	return printUsage(cmd.Context(), a.Output, a.Watch, a.Interval, func() ([]apiv1.ContainerReplicaUsage, error) {
+		if len(args) == 0 {
+			return c.ContainerReplicaUsageList(cmd.Context(), nil)
+		}
+
+		var result []apiv1.ContainerReplicaUsage
+		for _, arg := range args {
+			usages, err := c.ContainerReplicaUsageList(cmd.Context(), &client.ContainerReplicaListOptions{App: arg})
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, usages...)
+		}
+		return result, nil
+	})
+}
+
+func NewContainerTop(c CommandContext) *cobra.Command {
+	return cli.Command(&ContainerTop{client: c.ClientFactory}, cobra.Command{
+		Use: "top [flags] [CONTAINER_NAME...]",
+		Example: `
+# Show the CPU and memory usage of all containers
+acorn container top
+
+# Show the usage of a single container, refreshing every 5 seconds
+acorn container top -w my-app.web-6b5b5b6bc4-4nbgh`,
+		SilenceUsage:      true,
+		Short:             "Show the CPU and memory usage of containers",
+		ValidArgsFunction: newCompletion(c.ClientFactory, containersCompletion).complete,
+	})
+}
+
+type ContainerTop struct {
+	Output   string `usage:"Output format (json, yaml, {{gotemplate}})" short:"o"`
+	Watch    bool   `usage:"Refresh the usage until interrupted" short:"w"`
+	Interval string `usage:"Time between refreshes in watch mode (ex: 10s, 1m)" default:"5s"`
+	client   ClientFactory
+}
+
+func (a *ContainerTop) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	return printUsage(cmd.Context(), a.Output, a.Watch, a.Interval, func() ([]apiv1.ContainerReplicaUsage, error) {
+		usages, err := c.ContainerReplicaUsageList(cmd.Context(), nil)
+		if err != nil || len(args) == 0 {
+			return usages, err
+		}
+
+		var result []apiv1.ContainerReplicaUsage
+		for _, usage := range usages {
+			if slices.Contains(args, usage.Name) {
+				result = append(result, usage)
+			}
+		}
+		return result, nil
+	})
+}
+
+// printUsage prints the usages returned by list. In watch mode the usages are printed again after every interval
+// until the context is canceled, and table output is redrawn in place.
+func printUsage(ctx context.Context, output string, watch bool, interval string, list func() ([]apiv1.ContainerReplicaUsage, error)) error {
+	period, err := time.ParseDuration(interval)
+	if err != nil {
+		return fmt.Errorf("invalid interval %s: %w", interval, err)
+	} else if period <= 0 {
+		return fmt.Errorf("invalid interval %s: must be greater than zero", interval)
+	}
+
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+
+	for {
+		usages, err := list()
+		if err != nil {
+			return err
+		}
+
+		if watch && (output == "" || output == "table") {
+			fmt.Fprint(os.Stdout, clearScreen)
+		}
+
+		out := table.NewWriter(tables.ContainerReplicaUsage, false, output)
+		for i := range usages {
+			out.Write(&usages[i])
+		}
+		if err := out.Err(); err != nil || !watch {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
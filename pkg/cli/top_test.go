@@ -0,0 +1,100 @@
+package cli
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestTop(t *testing.T) {
+	quantity := func(s string) *resource.Quantity {
+		q := resource.MustParse(s)
+		return &q
+	}
+	usages := []apiv1.ContainerReplicaUsage{
+		{
+			ObjectMeta:    metav1.ObjectMeta{Name: "found.web-abc"},
+			AppName:       "found",
+			ComputeClass:  "general",
+			CPU:           resource.MustParse("12m"),
+			Memory:        resource.MustParse("64Mi"),
+			MemoryRequest: quantity("128Mi"),
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "other.db-abc"},
+			AppName:    "other",
+			CPU:        resource.MustParse("1"),
+			Memory:     resource.MustParse("1Gi"),
+		},
+	}
+
+	tests := []struct {
+		name    string
+		cmd     func(CommandContext) *cobra.Command
+		args    []string
+		wantErr string
+		wantOut string
+	}{
+		{
+			name: "acorn top",
+			cmd:  NewTop,
+			wantOut: `NAME            APP       CPU       MEMORY    MEMORY-REQUEST   MEMORY-%   COMPUTE-CLASS
+found.web-abc   found     12m       64Mi      128Mi            50%        general
+other.db-abc    other     1000m     1024Mi                                
+`,
+		},
+		{
+			name: "acorn top found",
+			cmd:  NewTop,
+			args: []string{"found"},
+			wantOut: `NAME            APP       CPU       MEMORY    MEMORY-REQUEST   MEMORY-%   COMPUTE-CLASS
+found.web-abc   found     12m       64Mi      128Mi            50%        general
+`,
+		},
+		{
+			name: "acorn container top other.db-abc",
+			cmd:  NewContainerTop,
+			args: []string{"-o", "{{.Name}} {{.Memory}}", "other.db-abc"},
+			wantOut: `other.db-abc 1Gi
+`,
+		},
+		{
+			name:    "acorn top --interval 0s",
+			cmd:     NewTop,
+			args:    []string{"--interval", "0s"},
+			wantErr: "invalid interval 0s: must be greater than zero",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, stdout, _ := os.Pipe()
+			os.Stdout = stdout
+			cmd := tt.cmd(CommandContext{
+				ClientFactory: &testdata.MockClientFactoryManual{Client: &testdata.MockClient{ContainerUsages: usages}},
+				StdOut:        stdout,
+				StdErr:        stdout,
+				StdIn:         strings.NewReader(""),
+			})
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			stdout.Close()
+			if tt.wantErr != "" {
+				if assert.Error(t, err) {
+					assert.Equal(t, tt.wantErr, err.Error())
+				}
+				return
+			}
+			assert.NoError(t, err)
+			out, _ := io.ReadAll(r)
+			assert.Equal(t, tt.wantOut, string(out))
+		})
+	}
+}
@@ -8,8 +8,8 @@ import (
 	"github.com/spf13/cobra"
 )
 
-var hideUpdateFlags = []string{"dangerous", "memory", "target-namespace", "secret", "volume", "region", "publish-all",
-	"publish", "link", "label", "interval", "env", "compute-class", "annotation"}
+var hideUpdateFlags = []string{"dangerous", "memory", "cpu", "target-namespace", "secret", "volume", "region", "publish-all",
+	"publish", "link", "label", "interval", "env", "compute-class", "annotation", "rollout"}
 
 func NewUpdate(c CommandContext) *cobra.Command {
 	cmd := cli.Command(&Update{out: c.StdOut, client: c.ClientFactory}, cobra.Command{
@@ -43,7 +43,9 @@ type UpdateArgs struct {
 	AutoUpgrade     *bool    `usage:"Enabled automatic upgrades."`
 	Interval        string   `usage:"If configured for auto-upgrade, this is the time interval at which to check for new releases (ex: 1h, 5m)"`
 	Memory          []string `usage:"Set memory for a workload in the format of workload=memory. Only specify an amount to set all workloads. (ex foo=512Mi or 512Mi)" short:"m"`
+	CPU             []string `usage:"Set the CPU request for a workload in the format of workload=cpu. Only specify an amount to set all workloads. (ex foo=500m or 2)"`
 	ComputeClass    []string `usage:"Set computeclass for a workload in the format of workload=computeclass. Specify a single computeclass to set all workloads. (ex foo=example-class or example-class)"`
+	Rollout         string   `usage:"Set the strategy used to roll out new images in the format TYPE[:WEIGHT[/PAUSE],...] where TYPE is rolling, blueGreen or canary (ex blueGreen, canary:10/5m,50/5m)"`
 }
 
 type Update struct {
@@ -0,0 +1,54 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/acorn-io/runtime/pkg/backup"
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/spf13/cobra"
+)
+
+func NewVolBackup(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&VolBackup{}, cobra.Command{
+		Use:          backup.HelperCommand + " [flags] URL DIRECTORY",
+		Hidden:       true,
+		SilenceUsage: true,
+		Short:        "Back up a directory to, or restore a directory from, a backup URL, or copy a directory",
+		Args:         cobra.ExactArgs(2),
+	})
+	return cmd
+}
+
+type VolBackup struct {
+	Restore             bool   `usage:"Restore the backup at URL into DIRECTORY, replacing its contents"`
+	Copy                bool   `usage:"Copy the directory at URL into DIRECTORY, replacing its contents"`
+	Name                string `usage:"Name the backup is stored as if URL is a prefix" default:"volume"`
+	CredentialsRequired bool   `usage:"Fail if no credentials for the object store are found instead of accessing it anonymously"`
+	TerminationLog      string `usage:"File to write the URL of the backup to when done"`
+}
+
+func (s *VolBackup) Run(cmd *cobra.Command, args []string) (err error) {
+	target, dir := args[0], args[1]
+
+	if s.Copy {
+		err = backup.Copy(target, dir)
+	} else if s.Restore {
+		err = backup.Restore(cmd.Context(), dir, target, s.CredentialsRequired)
+	} else {
+		target, err = backup.ResolveURL(target, s.Name, time.Now())
+		if err == nil {
+			err = backup.Backup(cmd.Context(), dir, target, s.CredentialsRequired)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(target)
+	if s.TerminationLog != "" {
+		return os.WriteFile(s.TerminationLog, []byte(target), 0644)
+	}
+	return nil
+}
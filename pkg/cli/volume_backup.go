@@ -0,0 +1,153 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/cli/builder/table"
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/acorn-io/runtime/pkg/tables"
+	"github.com/spf13/cobra"
+)
+
+func NewVolumeBackup(c CommandContext) *cobra.Command {
+	return cli.Command(&VolumeBackup{client: c.ClientFactory}, cobra.Command{
+		Use: "backup [flags] [VOLUME_NAME]",
+		Example: `
+# List the backups and restores of volumes
+acorn volume backup
+
+# Back up the volume "data" of the app "my-app" to an S3 bucket, using the credentials in the secret "s3-creds"
+acorn volume backup --to s3://my-bucket/backups/ --secret s3-creds my-app.data
+
+# Back up a volume to a MinIO server
+acorn volume backup --to "s3://my-bucket/backups/?endpoint=http://minio.minio:9000" --secret minio-creds my-app.data
+
+# Back up a volume to the volume backup directory on the node the volume is attached to
+acorn volume backup --to file:///my-app/ my-app.data`,
+		SilenceUsage:      true,
+		Short:             "Back up a volume to object storage, or list backups",
+		Long:              "Back up a volume by running a job that mounts the volume and streams a compressed tarball of its contents to an S3 compatible object store (s3://bucket/path) or to a path in the volume backup directory of the node (file:///path), if an admin configured one. A URL that ends with a slash is a prefix the backup is stored under with a generated name. The credentials of the object store are read from the AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY keys of the secret set with --secret.",
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: newCompletion(c.ClientFactory, volumesCompletion).withShouldCompleteOptions(onlyNumArgs(1)).complete,
+	})
+}
+
+type VolumeBackup struct {
+	To     string `usage:"s3:// or file:// URL to store the backup at"`
+	Secret string `usage:"Secret with the credentials of the object store"`
+	Name   string `usage:"Name of the backup, a name is generated if not set" short:"n"`
+	Wait   *bool  `usage:"Wait for the backup to finish before command exiting (default: true)"`
+	Quiet  bool   `usage:"Output only names" short:"q"`
+	Output string `usage:"Output format (json, yaml, {{gotemplate}})" short:"o"`
+	client ClientFactory
+}
+
+func (a *VolumeBackup) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	if len(args) == 0 {
+		out := table.NewWriter(tables.VolumeBackup, a.Quiet, a.Output)
+
+		backups, err := c.VolumeBackupList(cmd.Context())
+		if err != nil {
+			return err
+		}
+		for _, backup := range backups {
+			out.Write(&backup)
+		}
+		return out.Err()
+	}
+
+	if a.To == "" {
+		return fmt.Errorf("--to is required to back up volume %s", args[0])
+	}
+
+	return runVolumeBackup(cmd, c, a.Name, "backup-", a.Wait, apiv1.VolumeBackupSpec{
+		Volume: args[0],
+		To:     a.To,
+		Secret: a.Secret,
+	})
+}
+
+func NewVolumeRestore(c CommandContext) *cobra.Command {
+	return cli.Command(&VolumeRestore{client: c.ClientFactory}, cobra.Command{
+		Use: "restore [flags] VOLUME_NAME",
+		Example: `
+# Replace the contents of the volume "data" of the app "my-app" with a backup
+acorn volume restore --from s3://my-bucket/backups/data-20230601T120000Z.tar.gz --secret s3-creds my-app.data`,
+		SilenceUsage:      true,
+		Short:             "Restore a volume from a backup in object storage",
+		Long:              "Restore a volume by running a job that mounts the volume, deletes its contents and extracts the backup into it. A volume of an app that was not provisioned yet is created by the restore. The app should be stopped while a volume it uses is restored.",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: newCompletion(c.ClientFactory, volumesCompletion).withShouldCompleteOptions(onlyNumArgs(1)).complete,
+	})
+}
+
+type VolumeRestore struct {
+	From   string `usage:"s3:// or file:// URL of the backup to restore"`
+	Secret string `usage:"Secret with the credentials of the object store"`
+	Name   string `usage:"Name of the restore, a name is generated if not set" short:"n"`
+	Wait   *bool  `usage:"Wait for the restore to finish before command exiting (default: true)"`
+	client ClientFactory
+}
+
+func (a *VolumeRestore) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	if a.From == "" {
+		return fmt.Errorf("--from is required to restore volume %s", args[0])
+	}
+
+	return runVolumeBackup(cmd, c, a.Name, "restore-", a.Wait, apiv1.VolumeBackupSpec{
+		Volume: args[0],
+		From:   a.From,
+		Secret: a.Secret,
+	})
+}
+
+// runVolumeBackup starts the backup or restore and, unless told not to, waits for it to finish and prints the URL of
+// the backup
+func runVolumeBackup(cmd *cobra.Command, c client.Client, name, generateName string, wait *bool, spec apiv1.VolumeBackupSpec) error {
+	if name == "" {
+		name = generateName
+	}
+
+	backup, err := c.VolumeBackupCreate(cmd.Context(), name, spec)
+	if err != nil {
+		return err
+	}
+
+	if wait != nil && !*wait {
+		fmt.Println(backup.Name)
+		return nil
+	}
+
+	for {
+		switch backup.Status.State {
+		case apiv1.VolumeBackupStateSucceeded:
+			fmt.Println(backup.Status.URL)
+			return nil
+		case apiv1.VolumeBackupStateFailed:
+			return fmt.Errorf("%s failed: %s", backup.Name, backup.Status.Message)
+		}
+
+		select {
+		case <-cmd.Context().Done():
+			return cmd.Context().Err()
+		case <-time.After(time.Second):
+		}
+
+		if backup, err = c.VolumeBackupGet(cmd.Context(), backup.Name); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestVolumeBackup(t *testing.T) {
+	backups := []apiv1.VolumeBackup{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "backup-abcde"},
+			Spec:       apiv1.VolumeBackupSpec{Volume: "pvc-1234", To: "s3://bucket/backups/"},
+			Status: apiv1.VolumeBackupStatus{
+				AppName:    "found",
+				VolumeName: "vol",
+				URL:        "s3://bucket/backups/vol-20230601T120000Z.tar.gz",
+				State:      apiv1.VolumeBackupStateSucceeded,
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+		wantOut string
+	}{
+		{
+			name:    "acorn volume backup -q",
+			args:    []string{"backup", "-q"},
+			wantOut: "backup-abcde\n",
+		},
+		{
+			name:    "acorn volume backup -o {{.Status.URL}}",
+			args:    []string{"backup", "-o", "{{.Status.URL}}"},
+			wantOut: "s3://bucket/backups/vol-20230601T120000Z.tar.gz\n",
+		},
+		{
+			name:    "acorn volume backup --to s3://bucket/data.tar.gz found.vol",
+			args:    []string{"backup", "--to", "s3://bucket/data.tar.gz", "found.vol"},
+			wantOut: "s3://bucket/data.tar.gz\n",
+		},
+		{
+			name:    "acorn volume backup --wait=false --name my-backup --to s3://bucket/ found.vol",
+			args:    []string{"backup", "--wait=false", "--name", "my-backup", "--to", "s3://bucket/", "found.vol"},
+			wantOut: "my-backup\n",
+		},
+		{
+			name:    "acorn volume backup found.vol",
+			args:    []string{"backup", "found.vol"},
+			wantErr: "--to is required to back up volume found.vol",
+		},
+		{
+			name:    "acorn volume backup --to s3://bucket/ dne",
+			args:    []string{"backup", "--to", "s3://bucket/", "dne"},
+			wantErr: "error: volume dne does not exist",
+		},
+		{
+			name:    "acorn volume restore --from file:///var/backups/data.tar.gz found.vol",
+			args:    []string{"restore", "--from", "file:///var/backups/data.tar.gz", "found.vol"},
+			wantOut: "file:///var/backups/data.tar.gz\n",
+		},
+		{
+			name:    "acorn volume restore found.vol",
+			args:    []string{"restore", "found.vol"},
+			wantErr: "--from is required to restore volume found.vol",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, stdout, _ := os.Pipe()
+			os.Stdout = stdout
+			cmd := NewVolume(CommandContext{
+				ClientFactory: &testdata.MockClientFactoryManual{
+					Client: &testdata.MockClient{VolumeBackups: backups},
+				},
+				StdOut: stdout,
+				StdErr: stdout,
+				StdIn:  strings.NewReader(""),
+			})
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			stdout.Close()
+			if tt.wantErr != "" {
+				if assert.Error(t, err) {
+					assert.Equal(t, tt.wantErr, err.Error())
+				}
+				return
+			}
+			assert.NoError(t, err)
+			out, _ := io.ReadAll(r)
+			assert.Equal(t, tt.wantOut, string(out))
+		})
+	}
+}
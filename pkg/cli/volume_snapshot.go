@@ -0,0 +1,207 @@
+package cli
+
+import (
+	"fmt"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/cli/builder/table"
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/acorn-io/runtime/pkg/tables"
+	"github.com/spf13/cobra"
+	"k8s.io/utils/strings/slices"
+)
+
+func NewVolumeSnapshot(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&VolumeSnapshotList{client: c.ClientFactory}, cobra.Command{
+		Use:     "snapshot [flags] [SNAPSHOT_NAME...]",
+		Aliases: []string{"snapshots", "snap"},
+		Example: `
+acorn volume snapshot`,
+		SilenceUsage:      true,
+		Short:             "Manage point-in-time snapshots of volumes",
+		Long:              "Manage point-in-time snapshots of volumes. Snapshots are taken with the CSI snapshot support of the cluster, the snapshot.storage.k8s.io CRDs and a snapshot controller must be installed.",
+		ValidArgsFunction: newCompletion(c.ClientFactory, volumeSnapshotsCompletion).complete,
+	})
+	cmd.AddCommand(NewVolumeSnapshotCreate(c))
+	cmd.AddCommand(NewVolumeSnapshotList(c))
+	cmd.AddCommand(NewVolumeSnapshotDelete(c))
+	cmd.AddCommand(NewVolumeSnapshotRestore(c))
+	return cmd
+}
+
+func NewVolumeSnapshotList(c CommandContext) *cobra.Command {
+	return cli.Command(&VolumeSnapshotList{client: c.ClientFactory}, cobra.Command{
+		Use:     "list [flags] [SNAPSHOT_NAME...]",
+		Aliases: []string{"ls"},
+		Example: `
+acorn volume snapshot ls`,
+		SilenceUsage:      true,
+		Short:             "List volume snapshots",
+		ValidArgsFunction: newCompletion(c.ClientFactory, volumeSnapshotsCompletion).complete,
+	})
+}
+
+type VolumeSnapshotList struct {
+	Quiet  bool   `usage:"Output only names" short:"q"`
+	Output string `usage:"Output format (json, yaml, {{gotemplate}})" short:"o"`
+	client ClientFactory
+}
+
+func (a *VolumeSnapshotList) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	out := table.NewWriter(tables.VolumeSnapshot, a.Quiet, a.Output)
+
+	snapshots, err := c.VolumeSnapshotList(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	for _, snapshot := range snapshots {
+		if len(args) == 0 || slices.Contains(args, snapshot.Name) {
+			out.Write(&snapshot)
+		}
+	}
+
+	return out.Err()
+}
+
+func NewVolumeSnapshotCreate(c CommandContext) *cobra.Command {
+	return cli.Command(&VolumeSnapshotCreate{client: c.ClientFactory}, cobra.Command{
+		Use: "create [flags] VOLUME_NAME",
+		Example: `
+# Snapshot the volume "data" of the app "my-app"
+acorn volume snapshot create my-app.data
+
+# Snapshot a volume with a specific name and VolumeSnapshotClass
+acorn volume snapshot create --name before-upgrade --class csi-snapclass my-app.data`,
+		SilenceUsage:      true,
+		Short:             "Create a snapshot of a volume",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: newCompletion(c.ClientFactory, volumesCompletion).withShouldCompleteOptions(onlyNumArgs(1)).complete,
+	})
+}
+
+type VolumeSnapshotCreate struct {
+	Name   string `usage:"Name of the snapshot, a name is generated if not set" short:"n"`
+	Class  string `usage:"VolumeSnapshotClass to take the snapshot with, the cluster default is used if not set"`
+	client ClientFactory
+}
+
+func (a *VolumeSnapshotCreate) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	name := a.Name
+	if name == "" {
+		name = "snapshot-"
+	}
+
+	snapshot, err := c.VolumeSnapshotCreate(cmd.Context(), name, args[0], a.Class)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(snapshot.Name)
+	return nil
+}
+
+func NewVolumeSnapshotDelete(c CommandContext) *cobra.Command {
+	return cli.Command(&VolumeSnapshotDelete{client: c.ClientFactory}, cobra.Command{
+		Use:               "rm [SNAPSHOT_NAME...]",
+		Example:           `acorn volume snapshot rm before-upgrade`,
+		SilenceUsage:      true,
+		Short:             "Delete a volume snapshot",
+		ValidArgsFunction: newCompletion(c.ClientFactory, volumeSnapshotsCompletion).complete,
+	})
+}
+
+type VolumeSnapshotDelete struct {
+	client ClientFactory
+}
+
+func (a *VolumeSnapshotDelete) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	for _, name := range args {
+		deleted, err := c.VolumeSnapshotDelete(cmd.Context(), name)
+		if err != nil {
+			return fmt.Errorf("deleting %s: %w", name, err)
+		}
+		if deleted != nil {
+			fmt.Println(name)
+		} else {
+			fmt.Printf("Error: No such volume snapshot: %s\n", name)
+		}
+	}
+
+	return nil
+}
+
+func NewVolumeSnapshotRestore(c CommandContext) *cobra.Command {
+	return cli.Command(&VolumeSnapshotRestore{client: c.ClientFactory}, cobra.Command{
+		Use: "restore [flags] SNAPSHOT_NAME",
+		Example: `
+# Replace the volume that the snapshot was taken of with a new volume provisioned from the snapshot
+acorn volume snapshot restore before-upgrade
+
+# Restore the snapshot into the volume "data" of another app
+acorn volume snapshot restore --app my-other-app --volume data before-upgrade`,
+		SilenceUsage:      true,
+		Short:             "Restore a volume from a snapshot",
+		Long:              "Restore a volume from a snapshot by binding a new volume provisioned from the snapshot to the app. The volume that was in use before is kept and can be bound again with \"acorn update --volume\".",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: newCompletion(c.ClientFactory, volumeSnapshotsCompletion).withShouldCompleteOptions(onlyNumArgs(1)).complete,
+	})
+}
+
+type VolumeSnapshotRestore struct {
+	App    string `usage:"App to restore the snapshot into, defaults to the app the snapshot was taken of"`
+	Volume string `usage:"Volume of the app to restore the snapshot into, defaults to the volume the snapshot was taken of"`
+	client ClientFactory
+}
+
+func (a *VolumeSnapshotRestore) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	snapshot, err := c.VolumeSnapshotGet(cmd.Context(), args[0])
+	if err != nil {
+		return err
+	}
+
+	appName, volumeName := a.App, a.Volume
+	if appName == "" {
+		appName = snapshot.Status.AppName
+	}
+	if volumeName == "" {
+		volumeName = snapshot.Status.VolumeName
+	}
+	if appName == "" || volumeName == "" {
+		return fmt.Errorf("can not determine the app and volume snapshot %s was taken of, use --app and --volume", snapshot.Name)
+	}
+
+	app, err := c.AppUpdate(cmd.Context(), appName, &client.AppUpdateOptions{
+		Volumes: []v1.VolumeBinding{{
+			Target:   volumeName,
+			Snapshot: snapshot.Name,
+		}},
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(app.Name)
+	return nil
+}
@@ -0,0 +1,108 @@
+package cli
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestVolumeSnapshot(t *testing.T) {
+	size := resource.MustParse("10Gi")
+	snapshots := []apiv1.VolumeSnapshot{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "before-upgrade"},
+			Spec:       apiv1.VolumeSnapshotSpec{Volume: "pvc-1234"},
+			Status: apiv1.VolumeSnapshotStatus{
+				AppName:    "found",
+				VolumeName: "vol",
+				Size:       &size,
+				ReadyToUse: true,
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "orphan"},
+			Spec:       apiv1.VolumeSnapshotSpec{Volume: "pvc-5678"},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+		wantOut string
+	}{
+		{
+			name:    "acorn volume snapshot ls -q",
+			args:    []string{"snapshot", "ls", "-q"},
+			wantOut: "before-upgrade\norphan\n",
+		},
+		{
+			name:    "acorn volume snapshot -o {{.Status.VolumeName}} before-upgrade",
+			args:    []string{"snapshot", "-o", "{{.Status.VolumeName}}", "before-upgrade"},
+			wantOut: "vol\n",
+		},
+		{
+			name:    "acorn volume snapshot create --name my-snapshot found.vol",
+			args:    []string{"snapshot", "create", "--name", "my-snapshot", "found.vol"},
+			wantOut: "my-snapshot\n",
+		},
+		{
+			name:    "acorn volume snapshot create dne",
+			args:    []string{"snapshot", "create", "dne"},
+			wantErr: "error: volume dne does not exist",
+		},
+		{
+			name:    "acorn volume snapshot rm before-upgrade dne",
+			args:    []string{"snapshot", "rm", "before-upgrade", "dne"},
+			wantOut: "before-upgrade\nError: No such volume snapshot: dne\n",
+		},
+		{
+			name:    "acorn volume snapshot restore before-upgrade",
+			args:    []string{"snapshot", "restore", "before-upgrade"},
+			wantOut: "found\n",
+		},
+		{
+			name:    "acorn volume snapshot restore orphan",
+			args:    []string{"snapshot", "restore", "orphan"},
+			wantErr: "can not determine the app and volume snapshot orphan was taken of, use --app and --volume",
+		},
+		{
+			name:    "acorn volume snapshot restore --app found --volume vol orphan",
+			args:    []string{"snapshot", "restore", "--app", "found", "--volume", "vol", "orphan"},
+			wantOut: "found\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, stdout, _ := os.Pipe()
+			os.Stdout = stdout
+			cmd := NewVolume(CommandContext{
+				ClientFactory: &testdata.MockClientFactoryManual{
+					Client: &testdata.MockClient{VolumeSnapshots: snapshots},
+				},
+				StdOut: stdout,
+				StdErr: stdout,
+				StdIn:  strings.NewReader(""),
+			})
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			stdout.Close()
+			if tt.wantErr != "" {
+				if assert.Error(t, err) {
+					assert.Equal(t, tt.wantErr, err.Error())
+				}
+				return
+			}
+			assert.NoError(t, err)
+			out, _ := io.ReadAll(r)
+			assert.Equal(t, tt.wantOut, string(out))
+		})
+	}
+}
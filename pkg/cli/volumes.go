@@ -19,6 +19,9 @@ acorn volume`,
 		ValidArgsFunction: newCompletion(c.ClientFactory, volumesCompletion).complete,
 	})
 	cmd.AddCommand(NewVolumeDelete(c))
+	cmd.AddCommand(NewVolumeSnapshot(c))
+	cmd.AddCommand(NewVolumeBackup(c))
+	cmd.AddCommand(NewVolumeRestore(c))
 	return cmd
 }
 
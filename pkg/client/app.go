@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"sort"
 	"strings"
 
@@ -17,6 +18,8 @@ import (
 	"github.com/acorn-io/runtime/pkg/publicname"
 	"github.com/acorn-io/runtime/pkg/run"
 	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/acorn-io/runtime/pkg/tags"
+	imagename "github.com/google/go-containerregistry/pkg/name"
 	"github.com/gorilla/websocket"
 	"github.com/sirupsen/logrus"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -66,7 +69,9 @@ func ToApp(namespace, image string, opts *AppRunOptions) *apiv1.App {
 			NotifyUpgrade:       opts.NotifyUpgrade,
 			AutoUpgradeInterval: opts.AutoUpgradeInterval,
 			Memory:              opts.Memory,
+			CPU:                 opts.CPU,
 			ComputeClasses:      opts.ComputeClasses,
+			Rollout:             opts.Rollout,
 		},
 	}
 }
@@ -167,9 +172,15 @@ func ToAppUpdate(ctx context.Context, c Client, name string, opts *AppUpdateOpti
 	if len(opts.Memory) != 0 {
 		app.Spec.Memory = opts.Memory
 	}
+	if len(opts.CPU) != 0 {
+		app.Spec.CPU = opts.CPU
+	}
 	if len(opts.ComputeClasses) != 0 {
 		app.Spec.ComputeClasses = opts.ComputeClasses
 	}
+	if opts.Rollout != nil {
+		app.Spec.Rollout = opts.Rollout
+	}
 	if opts.Region != "" {
 		app.Spec.Region = opts.Region
 	}
@@ -610,3 +621,80 @@ func (c *DefaultClient) AppIgnoreDeleteCleanup(ctx context.Context, name string)
 		SubResource("ignorecleanup").
 		Body(&apiv1.IgnoreCleanup{}).Do(ctx).Error()
 }
+
+func (c *DefaultClient) AppHistory(ctx context.Context, name string) ([]v1.AppRevision, error) {
+	app := &apiv1.App{}
+	err := c.Client.Get(ctx, kclient.ObjectKey{
+		Name:      name,
+		Namespace: c.Namespace,
+	}, app)
+	if err != nil {
+		return nil, err
+	}
+
+	// Latest revision first
+	result := make([]v1.AppRevision, 0, len(app.Status.Revisions))
+	for i := len(app.Status.Revisions) - 1; i >= 0; i-- {
+		result = append(result, app.Status.Revisions[i])
+	}
+	return result, nil
+}
+
+func (c *DefaultClient) AppRollback(ctx context.Context, name string, revision int64) (app *apiv1.App, err error) {
+	for i := 0; i < 5; i++ {
+		app, err = c.appRollback(ctx, name, revision)
+		if apierrors.IsConflict(err) {
+			continue
+		}
+		return
+	}
+	return
+}
+
+func (c *DefaultClient) appRollback(ctx context.Context, name string, revision int64) (*apiv1.App, error) {
+	app := &apiv1.App{}
+	err := c.Client.Get(ctx, kclient.ObjectKey{
+		Name:      name,
+		Namespace: c.Namespace,
+	}, app)
+	if err != nil {
+		return nil, err
+	}
+
+	// Without a revision roll back to the one before the latest
+	if revision == 0 {
+		revision = -1
+	}
+	rev, ok := app.Status.GetRevision(revision)
+	if !ok {
+		if revision < 0 {
+			return nil, fmt.Errorf("app %s has no previous revision to roll back to", name)
+		}
+		return nil, fmt.Errorf("revision %d of app %s not found", revision, name)
+	}
+
+	app.Spec.Image = revisionImage(rev)
+	app.Spec.DeployArgs = rev.DeployArgs
+	app.Spec.Profiles = rev.Profiles
+	app.Spec.PublishMode = rev.PublishMode
+	app.Spec.Volumes = rev.Volumes
+	app.Spec.Secrets = rev.Secrets
+	app.Spec.Links = rev.Links
+	app.Spec.Publish = rev.Publish
+
+	return app, c.Client.Update(ctx, app)
+}
+
+// revisionImage returns a reference to the exact image that was deployed in the revision, rather than the tag
+// that may have moved since.
+func revisionImage(rev v1.AppRevision) string {
+	if tags.SHAPattern.MatchString(rev.ImageID) {
+		return rev.ImageID
+	}
+	if rev.ImageID != "" && rev.ImageDigest != "" {
+		if ref, err := imagename.ParseReference(rev.ImageID); err == nil {
+			return ref.Context().Digest(rev.ImageDigest).String()
+		}
+	}
+	return rev.Image
+}
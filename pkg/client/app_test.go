@@ -88,3 +88,16 @@ func TestLabelsAndAnnotations(t *testing.T) {
 	assert.Equal(t, "v2", app.Annotations["anno2"])
 	assert.NotContains(t, app.Annotations, "anno3")
 }
+
+func TestRevisionImage(t *testing.T) {
+	digest := "sha256:2ba8b18e4ea3e4b5f2f2e4a5b3c6d1f7e8a9b0c1d2e3f4a5b6c7d8e9f0a1b2c3"
+	id := "2ba8b18e4ea3e4b5f2f2e4a5b3c6d1f7e8a9b0c1d2e3f4a5b6c7d8e9f0a1b2c3"
+
+	assert.Equal(t, id, revisionImage(v1.AppRevision{Image: "local", ImageID: id, ImageDigest: digest}))
+	assert.Equal(t, "index.docker.io/acorn/app@"+digest, revisionImage(v1.AppRevision{
+		Image:       "acorn/app:v1",
+		ImageID:     "index.docker.io/acorn/app:v1",
+		ImageDigest: digest,
+	}))
+	assert.Equal(t, "acorn/app:v1", revisionImage(v1.AppRevision{Image: "acorn/app:v1"}))
+}
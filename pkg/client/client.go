@@ -15,6 +15,7 @@ import (
 	"github.com/acorn-io/runtime/pkg/scheme"
 	"github.com/acorn-io/runtime/pkg/streams"
 	"github.com/acorn-io/runtime/pkg/system"
+	"github.com/acorn-io/runtime/pkg/tracing"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/client-go/rest"
@@ -40,7 +41,10 @@ func (f *Factory) Namespace(project, namespace string) Client {
 }
 
 func NewClientFactory(restConfig *rest.Config) (*Factory, error) {
-	k8sclient, err := k8sclient.New(restConfig)
+	// Send the trace context of requests so that the api-server continues the trace of the command
+	tracedConfig := tracing.WrapConfig(restConfig)
+
+	k8sclient, err := k8sclient.New(tracedConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -50,7 +54,7 @@ func NewClientFactory(restConfig *rest.Config) (*Factory, error) {
 		return nil, err
 	}
 
-	cfg := rest.CopyConfig(restConfig)
+	cfg := rest.CopyConfig(tracedConfig)
 	cfg.APIPath = "/apis"
 	cfg.GroupVersion = &apiv1.SchemeGroupVersion
 	restconfig.SetScheme(cfg, scheme.Scheme)
@@ -100,7 +104,9 @@ type AppUpdateOptions struct {
 	NotifyUpgrade       *bool
 	AutoUpgradeInterval string
 	Memory              v1.MemoryMap
+	CPU                 v1.CPUMap
 	ComputeClasses      v1.ComputeClassMap
+	Rollout             *v1.RolloutStrategy
 	Region              string
 	DevSessionClient    *v1.DevSessionInstanceClient
 }
@@ -127,7 +133,9 @@ type AppRunOptions struct {
 	NotifyUpgrade       *bool
 	AutoUpgradeInterval string
 	Memory              v1.MemoryMap
+	CPU                 v1.CPUMap
 	ComputeClasses      v1.ComputeClassMap
+	Rollout             *v1.RolloutStrategy
 }
 
 func (a AppRunOptions) ToUpdate() AppUpdateOptions {
@@ -149,7 +157,9 @@ func (a AppRunOptions) ToUpdate() AppUpdateOptions {
 		NotifyUpgrade:       a.NotifyUpgrade,
 		AutoUpgradeInterval: a.AutoUpgradeInterval,
 		Memory:              a.Memory,
+		CPU:                 a.CPU,
 		ComputeClasses:      a.ComputeClasses,
+		Rollout:             a.Rollout,
 		Region:              a.Region,
 	}
 }
@@ -172,7 +182,9 @@ func (a AppUpdateOptions) ToRun() AppRunOptions {
 		NotifyUpgrade:       a.NotifyUpgrade,
 		AutoUpgradeInterval: a.AutoUpgradeInterval,
 		Memory:              a.Memory,
+		CPU:                 a.CPU,
 		ComputeClasses:      a.ComputeClasses,
+		Rollout:             a.Rollout,
 	}
 }
 
@@ -183,10 +195,11 @@ type ImageProgress struct {
 }
 
 type ImageDetails struct {
-	AppImage   v1.AppImage   `json:"appImage,omitempty"`
-	AppSpec    *v1.AppSpec   `json:"appSpec,omitempty"`
-	Params     *v1.ParamSpec `json:"params,omitempty"`
-	ParseError string        `json:"parseError,omitempty"`
+	AppImage   v1.AppImage    `json:"appImage,omitempty"`
+	AppSpec    *v1.AppSpec    `json:"appSpec,omitempty"`
+	Params     *v1.ParamSpec  `json:"params,omitempty"`
+	ParseError string         `json:"parseError,omitempty"`
+	SBOMs      []v1.ImageSBOM `json:"sboms,omitempty"`
 }
 
 type PortForwardDialer func(ctx context.Context) (net.Conn, error)
@@ -203,6 +216,8 @@ type Client interface {
 	AppConfirmUpgrade(ctx context.Context, name string) error
 	AppPullImage(ctx context.Context, name string) error
 	AppIgnoreDeleteCleanup(ctx context.Context, name string) error
+	AppHistory(ctx context.Context, name string) ([]v1.AppRevision, error)
+	AppRollback(ctx context.Context, name string, revision int64) (*apiv1.App, error)
 
 	DevSessionRenew(ctx context.Context, name string, client v1.DevSessionInstanceClient) error
 	DevSessionRelease(ctx context.Context, name string) error
@@ -222,14 +237,28 @@ type Client interface {
 
 	ContainerReplicaList(ctx context.Context, opts *ContainerReplicaListOptions) ([]apiv1.ContainerReplica, error)
 	ContainerReplicaGet(ctx context.Context, name string) (*apiv1.ContainerReplica, error)
+	ContainerReplicaUsageList(ctx context.Context, opts *ContainerReplicaListOptions) ([]apiv1.ContainerReplicaUsage, error)
 	ContainerReplicaDelete(ctx context.Context, name string) (*apiv1.ContainerReplica, error)
 	ContainerReplicaExec(ctx context.Context, name string, args []string, tty bool, opts *ContainerReplicaExecOptions) (*term.ExecIO, error)
 	ContainerReplicaPortForward(ctx context.Context, name string, port int) (PortForwardDialer, error)
 
+	JobExecutionList(ctx context.Context, opts *JobExecutionListOptions) ([]apiv1.JobExecution, error)
+	JobExecutionGet(ctx context.Context, name string) (*apiv1.JobExecution, error)
+	JobRun(ctx context.Context, name string) (*apiv1.JobExecution, error)
+
 	VolumeList(ctx context.Context) ([]apiv1.Volume, error)
 	VolumeGet(ctx context.Context, name string) (*apiv1.Volume, error)
 	VolumeDelete(ctx context.Context, name string) (*apiv1.Volume, error)
 
+	VolumeSnapshotCreate(ctx context.Context, name, volume, class string) (*apiv1.VolumeSnapshot, error)
+	VolumeSnapshotList(ctx context.Context) ([]apiv1.VolumeSnapshot, error)
+	VolumeSnapshotGet(ctx context.Context, name string) (*apiv1.VolumeSnapshot, error)
+	VolumeSnapshotDelete(ctx context.Context, name string) (*apiv1.VolumeSnapshot, error)
+
+	VolumeBackupCreate(ctx context.Context, name string, spec apiv1.VolumeBackupSpec) (*apiv1.VolumeBackup, error)
+	VolumeBackupList(ctx context.Context) ([]apiv1.VolumeBackup, error)
+	VolumeBackupGet(ctx context.Context, name string) (*apiv1.VolumeBackup, error)
+
 	ImageList(ctx context.Context) ([]apiv1.Image, error)
 	ImageGet(ctx context.Context, name string) (*apiv1.Image, error)
 	ImageDelete(ctx context.Context, name string, opts *ImageDeleteOptions) (*apiv1.Image, []string, error) // returns the modified/deleted image and a list of deleted tags
@@ -262,6 +291,11 @@ type Client interface {
 
 	EventStream(ctx context.Context, opts *EventStreamOptions) (<-chan apiv1.Event, error)
 
+	EventSubscriptionCreate(ctx context.Context, name string, spec v1.EventSubscriptionSpec) (*apiv1.EventSubscription, error)
+	EventSubscriptionList(ctx context.Context) ([]apiv1.EventSubscription, error)
+	EventSubscriptionGet(ctx context.Context, name string) (*apiv1.EventSubscription, error)
+	EventSubscriptionDelete(ctx context.Context, name string) (*apiv1.EventSubscription, error)
+
 	GetProject() string
 	GetNamespace() string
 	GetClient() (kclient.WithWatch, error)
@@ -309,6 +343,7 @@ type ImageDetailsOptions struct {
 	Profiles     []string
 	DeployArgs   map[string]any
 	Auth         *apiv1.RegistryAuth
+	IncludeSBOM  bool
 }
 
 type ImageDeleteOptions struct {
@@ -323,6 +358,11 @@ type ContainerReplicaListOptions struct {
 	App string `json:"app,omitempty"`
 }
 
+type JobExecutionListOptions struct {
+	App string `json:"app,omitempty"`
+	Job string `json:"job,omitempty"`
+}
+
 type EventStreamOptions struct {
 	Tail            int    `json:"tail,omitempty"`
 	Follow          bool   `json:"follow,omitempty"`
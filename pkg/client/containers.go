@@ -47,6 +47,32 @@ func (c *DefaultClient) ContainerReplicaList(ctx context.Context, opts *Containe
 	return result.Items, nil
 }
 
+func (c *DefaultClient) ContainerReplicaUsageList(ctx context.Context, opts *ContainerReplicaListOptions) ([]apiv1.ContainerReplicaUsage, error) {
+	result := &apiv1.ContainerReplicaUsageList{}
+	err := c.Client.List(ctx, result, &kclient.ListOptions{
+		Namespace: c.Namespace,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(result.Items, func(i, j int) bool {
+		return result.Items[i].Name < result.Items[j].Name
+	})
+
+	if opts != nil && opts.App != "" {
+		var newResult []apiv1.ContainerReplicaUsage
+		for _, usage := range result.Items {
+			if usage.AppName == opts.App {
+				newResult = append(newResult, usage)
+			}
+		}
+		return newResult, nil
+	}
+
+	return result.Items, nil
+}
+
 func (c *DefaultClient) ContainerReplicaDelete(ctx context.Context, name string) (*apiv1.ContainerReplica, error) {
 	container, err := c.ContainerReplicaGet(ctx, name)
 	if apierrors.IsNotFound(err) {
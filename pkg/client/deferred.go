@@ -108,6 +108,20 @@ func (d *DeferredClient) AppIgnoreDeleteCleanup(ctx context.Context, name string
 	return d.Client.AppIgnoreDeleteCleanup(ctx, name)
 }
 
+func (d *DeferredClient) AppHistory(ctx context.Context, name string) ([]v1.AppRevision, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.AppHistory(ctx, name)
+}
+
+func (d *DeferredClient) AppRollback(ctx context.Context, name string, revision int64) (*apiv1.App, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.AppRollback(ctx, name, revision)
+}
+
 func (d *DeferredClient) DevSessionRenew(ctx context.Context, name string, client v1.DevSessionInstanceClient) error {
 	if err := d.create(); err != nil {
 		return err
@@ -213,6 +227,34 @@ func (d *DeferredClient) ContainerReplicaGet(ctx context.Context, name string) (
 	return d.Client.ContainerReplicaGet(ctx, name)
 }
 
+func (d *DeferredClient) ContainerReplicaUsageList(ctx context.Context, opts *ContainerReplicaListOptions) ([]apiv1.ContainerReplicaUsage, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.ContainerReplicaUsageList(ctx, opts)
+}
+
+func (d *DeferredClient) JobExecutionList(ctx context.Context, opts *JobExecutionListOptions) ([]apiv1.JobExecution, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.JobExecutionList(ctx, opts)
+}
+
+func (d *DeferredClient) JobExecutionGet(ctx context.Context, name string) (*apiv1.JobExecution, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.JobExecutionGet(ctx, name)
+}
+
+func (d *DeferredClient) JobRun(ctx context.Context, name string) (*apiv1.JobExecution, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.JobRun(ctx, name)
+}
+
 func (d *DeferredClient) ContainerReplicaDelete(ctx context.Context, name string) (*apiv1.ContainerReplica, error) {
 	if err := d.create(); err != nil {
 		return nil, err
@@ -255,6 +297,55 @@ func (d *DeferredClient) VolumeDelete(ctx context.Context, name string) (*apiv1.
 	return d.Client.VolumeDelete(ctx, name)
 }
 
+func (d *DeferredClient) VolumeSnapshotCreate(ctx context.Context, name, volume, class string) (*apiv1.VolumeSnapshot, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.VolumeSnapshotCreate(ctx, name, volume, class)
+}
+
+func (d *DeferredClient) VolumeSnapshotList(ctx context.Context) ([]apiv1.VolumeSnapshot, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.VolumeSnapshotList(ctx)
+}
+
+func (d *DeferredClient) VolumeSnapshotGet(ctx context.Context, name string) (*apiv1.VolumeSnapshot, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.VolumeSnapshotGet(ctx, name)
+}
+
+func (d *DeferredClient) VolumeSnapshotDelete(ctx context.Context, name string) (*apiv1.VolumeSnapshot, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.VolumeSnapshotDelete(ctx, name)
+}
+
+func (d *DeferredClient) VolumeBackupCreate(ctx context.Context, name string, spec apiv1.VolumeBackupSpec) (*apiv1.VolumeBackup, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.VolumeBackupCreate(ctx, name, spec)
+}
+
+func (d *DeferredClient) VolumeBackupList(ctx context.Context) ([]apiv1.VolumeBackup, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.VolumeBackupList(ctx)
+}
+
+func (d *DeferredClient) VolumeBackupGet(ctx context.Context, name string) (*apiv1.VolumeBackup, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.VolumeBackupGet(ctx, name)
+}
+
 func (d *DeferredClient) ImageList(ctx context.Context) ([]apiv1.Image, error) {
 	if err := d.create(); err != nil {
 		return nil, err
@@ -423,6 +514,34 @@ func (d *DeferredClient) EventStream(ctx context.Context, opts *EventStreamOptio
 	return d.Client.EventStream(ctx, opts)
 }
 
+func (d *DeferredClient) EventSubscriptionCreate(ctx context.Context, name string, spec v1.EventSubscriptionSpec) (*apiv1.EventSubscription, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.EventSubscriptionCreate(ctx, name, spec)
+}
+
+func (d *DeferredClient) EventSubscriptionList(ctx context.Context) ([]apiv1.EventSubscription, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.EventSubscriptionList(ctx)
+}
+
+func (d *DeferredClient) EventSubscriptionGet(ctx context.Context, name string) (*apiv1.EventSubscription, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.EventSubscriptionGet(ctx, name)
+}
+
+func (d *DeferredClient) EventSubscriptionDelete(ctx context.Context, name string) (*apiv1.EventSubscription, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.EventSubscriptionDelete(ctx, name)
+}
+
 func (d *DeferredClient) GetProject() string {
 	return d.Project
 }
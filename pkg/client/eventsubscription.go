@@ -0,0 +1,58 @@
+package client
+
+import (
+	"context"
+	"sort"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	apierror "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func (c *DefaultClient) EventSubscriptionCreate(ctx context.Context, name string, spec v1.EventSubscriptionSpec) (*apiv1.EventSubscription, error) {
+	sub := &apiv1.EventSubscription{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: c.Namespace,
+		},
+		Spec: spec,
+	}
+	return sub, c.Client.Create(ctx, sub)
+}
+
+func (c *DefaultClient) EventSubscriptionList(ctx context.Context) ([]apiv1.EventSubscription, error) {
+	subs := &apiv1.EventSubscriptionList{}
+	err := c.Client.List(ctx, subs, &kclient.ListOptions{
+		Namespace: c.Namespace,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(subs.Items, func(i, j int) bool {
+		return subs.Items[i].Name < subs.Items[j].Name
+	})
+
+	return subs.Items, nil
+}
+
+func (c *DefaultClient) EventSubscriptionGet(ctx context.Context, name string) (*apiv1.EventSubscription, error) {
+	sub := &apiv1.EventSubscription{}
+	return sub, c.Client.Get(ctx, kclient.ObjectKey{
+		Name:      name,
+		Namespace: c.Namespace,
+	}, sub)
+}
+
+func (c *DefaultClient) EventSubscriptionDelete(ctx context.Context, name string) (*apiv1.EventSubscription, error) {
+	// get first to ensure the namespace matches
+	sub, err := c.EventSubscriptionGet(ctx, name)
+	if apierror.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return sub, c.Client.Delete(ctx, sub)
+}
@@ -131,6 +131,14 @@ func (c *IgnoreUninstalled) AppSkipDeleteCleanup(ctx context.Context, name strin
 	return c.Client.AppIgnoreDeleteCleanup(ctx, name)
 }
 
+func (c IgnoreUninstalled) AppHistory(ctx context.Context, name string) ([]v1.AppRevision, error) {
+	return c.Client.AppHistory(ctx, name)
+}
+
+func (c IgnoreUninstalled) AppRollback(ctx context.Context, name string, revision int64) (*apiv1.App, error) {
+	return c.Client.AppRollback(ctx, name, revision)
+}
+
 func (c *IgnoreUninstalled) DevSessionRenew(ctx context.Context, name string, client v1.DevSessionInstanceClient) error {
 	return c.Client.DevSessionRenew(ctx, name, client)
 }
@@ -147,6 +155,22 @@ func (c IgnoreUninstalled) ContainerReplicaGet(ctx context.Context, name string)
 	return c.Client.ContainerReplicaGet(ctx, name)
 }
 
+func (c IgnoreUninstalled) ContainerReplicaUsageList(ctx context.Context, opts *ContainerReplicaListOptions) ([]apiv1.ContainerReplicaUsage, error) {
+	return ignoreUninstalled(c.Client.ContainerReplicaUsageList(ctx, opts))
+}
+
+func (c IgnoreUninstalled) JobExecutionList(ctx context.Context, opts *JobExecutionListOptions) ([]apiv1.JobExecution, error) {
+	return ignoreUninstalled(c.Client.JobExecutionList(ctx, opts))
+}
+
+func (c IgnoreUninstalled) JobExecutionGet(ctx context.Context, name string) (*apiv1.JobExecution, error) {
+	return c.Client.JobExecutionGet(ctx, name)
+}
+
+func (c IgnoreUninstalled) JobRun(ctx context.Context, name string) (*apiv1.JobExecution, error) {
+	return c.Client.JobRun(ctx, name)
+}
+
 func (c IgnoreUninstalled) ContainerReplicaDelete(ctx context.Context, name string) (*apiv1.ContainerReplica, error) {
 	return ignoreUninstalled(c.Client.ContainerReplicaDelete(ctx, name))
 }
@@ -171,6 +195,38 @@ func (c IgnoreUninstalled) VolumeDelete(ctx context.Context, name string) (*apiv
 	return ignoreUninstalled(c.Client.VolumeDelete(ctx, name))
 }
 
+func (c IgnoreUninstalled) VolumeSnapshotCreate(ctx context.Context, name, volume, class string) (*apiv1.VolumeSnapshot, error) {
+	return promptInstall(ctx, func() (*apiv1.VolumeSnapshot, error) {
+		return c.Client.VolumeSnapshotCreate(ctx, name, volume, class)
+	})
+}
+
+func (c IgnoreUninstalled) VolumeSnapshotList(ctx context.Context) ([]apiv1.VolumeSnapshot, error) {
+	return ignoreUninstalled(c.Client.VolumeSnapshotList(ctx))
+}
+
+func (c IgnoreUninstalled) VolumeSnapshotGet(ctx context.Context, name string) (*apiv1.VolumeSnapshot, error) {
+	return c.Client.VolumeSnapshotGet(ctx, name)
+}
+
+func (c IgnoreUninstalled) VolumeSnapshotDelete(ctx context.Context, name string) (*apiv1.VolumeSnapshot, error) {
+	return ignoreUninstalled(c.Client.VolumeSnapshotDelete(ctx, name))
+}
+
+func (c IgnoreUninstalled) VolumeBackupCreate(ctx context.Context, name string, spec apiv1.VolumeBackupSpec) (*apiv1.VolumeBackup, error) {
+	return promptInstall(ctx, func() (*apiv1.VolumeBackup, error) {
+		return c.Client.VolumeBackupCreate(ctx, name, spec)
+	})
+}
+
+func (c IgnoreUninstalled) VolumeBackupList(ctx context.Context) ([]apiv1.VolumeBackup, error) {
+	return ignoreUninstalled(c.Client.VolumeBackupList(ctx))
+}
+
+func (c IgnoreUninstalled) VolumeBackupGet(ctx context.Context, name string) (*apiv1.VolumeBackup, error) {
+	return c.Client.VolumeBackupGet(ctx, name)
+}
+
 func (c IgnoreUninstalled) ImageList(ctx context.Context) ([]apiv1.Image, error) {
 	return ignoreUninstalled(c.Client.ImageList(ctx))
 }
@@ -310,3 +366,19 @@ func (c IgnoreUninstalled) VolumeClassList(ctx context.Context) ([]apiv1.VolumeC
 func (c IgnoreUninstalled) VolumeClassGet(ctx context.Context, name string) (*apiv1.VolumeClass, error) {
 	return c.Client.VolumeClassGet(ctx, name)
 }
+
+func (c IgnoreUninstalled) EventSubscriptionCreate(ctx context.Context, name string, spec v1.EventSubscriptionSpec) (*apiv1.EventSubscription, error) {
+	return c.Client.EventSubscriptionCreate(ctx, name, spec)
+}
+
+func (c IgnoreUninstalled) EventSubscriptionList(ctx context.Context) ([]apiv1.EventSubscription, error) {
+	return ignoreUninstalled(c.Client.EventSubscriptionList(ctx))
+}
+
+func (c IgnoreUninstalled) EventSubscriptionGet(ctx context.Context, name string) (*apiv1.EventSubscription, error) {
+	return c.Client.EventSubscriptionGet(ctx, name)
+}
+
+func (c IgnoreUninstalled) EventSubscriptionDelete(ctx context.Context, name string) (*apiv1.EventSubscription, error) {
+	return ignoreUninstalled(c.Client.EventSubscriptionDelete(ctx, name))
+}
@@ -42,6 +42,7 @@ func (c *DefaultClient) ImageDetails(ctx context.Context, imageName string, opts
 		detailsResult.Profiles = opts.Profiles
 		detailsResult.NestedDigest = opts.NestedDigest
 		detailsResult.Auth = opts.Auth
+		detailsResult.IncludeSBOM = opts.IncludeSBOM
 	}
 
 	err := c.RESTClient.Post().
@@ -60,6 +61,7 @@ func (c *DefaultClient) ImageDetails(ctx context.Context, imageName string, opts
 		AppSpec:    detailsResult.AppSpec,
 		Params:     detailsResult.Params,
 		ParseError: detailsResult.ParseError,
+		SBOMs:      detailsResult.SBOMs,
 	}, nil
 }
 
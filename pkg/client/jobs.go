@@ -0,0 +1,81 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func (c *DefaultClient) JobExecutionGet(ctx context.Context, name string) (*apiv1.JobExecution, error) {
+	execution := &apiv1.JobExecution{}
+	return execution, c.Client.Get(ctx, kclient.ObjectKey{
+		Name:      name,
+		Namespace: c.Namespace,
+	}, execution)
+}
+
+func (c *DefaultClient) JobExecutionList(ctx context.Context, opts *JobExecutionListOptions) ([]apiv1.JobExecution, error) {
+	result := &apiv1.JobExecutionList{}
+	err := c.Client.List(ctx, result, &kclient.ListOptions{
+		Namespace: c.Namespace,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(result.Items, func(i, j int) bool {
+		if result.Items[i].CreationTimestamp.Time == result.Items[j].CreationTimestamp.Time {
+			return result.Items[i].Name < result.Items[j].Name
+		}
+		return result.Items[i].CreationTimestamp.After(result.Items[j].CreationTimestamp.Time)
+	})
+
+	if opts == nil || (opts.App == "" && opts.Job == "") {
+		return result.Items, nil
+	}
+
+	var newResult []apiv1.JobExecution
+	for _, execution := range result.Items {
+		if opts.App != "" && execution.Spec.AppName != opts.App {
+			continue
+		}
+		if opts.Job != "" && execution.Spec.JobName != opts.Job {
+			continue
+		}
+		newResult = append(newResult, execution)
+	}
+	return newResult, nil
+}
+
+// JobRun runs the scheduled job <app>.<job> now, outside its schedule
+func (c *DefaultClient) JobRun(ctx context.Context, name string) (*apiv1.JobExecution, error) {
+	appName, jobName, ok := SplitJobName(name)
+	if !ok {
+		return nil, fmt.Errorf("invalid job name [%s], must be in the form <app>.<job>", name)
+	}
+
+	execution := &apiv1.JobExecution{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: c.Namespace,
+		},
+		Spec: apiv1.JobExecutionSpec{
+			AppName: appName,
+			JobName: jobName,
+		},
+	}
+	return execution, c.Client.Create(ctx, execution)
+}
+
+// SplitJobName splits a job name in the form <app>.<job> into the app and job names
+func SplitJobName(name string) (string, string, bool) {
+	i := strings.LastIndex(name, ".")
+	if i <= 0 || i == len(name)-1 {
+		return "", "", false
+	}
+	return name[:i], name[i+1:], true
+}
@@ -219,6 +219,20 @@ func (m *MultiClient) AppIgnoreDeleteCleanup(ctx context.Context, name string) e
 	return err
 }
 
+func (m *MultiClient) AppHistory(ctx context.Context, name string) (result []v1.AppRevision, err error) {
+	_, err = onOne(ctx, m.Factory, name, func(name string, c Client) (*apiv1.App, error) {
+		result, err = c.AppHistory(ctx, name)
+		return &apiv1.App{}, err
+	})
+	return result, err
+}
+
+func (m *MultiClient) AppRollback(ctx context.Context, name string, revision int64) (*apiv1.App, error) {
+	return onOne(ctx, m.Factory, name, func(name string, c Client) (*apiv1.App, error) {
+		return c.AppRollback(ctx, name, revision)
+	})
+}
+
 func (m *MultiClient) DevSessionRenew(ctx context.Context, name string, client v1.DevSessionInstanceClient) error {
 	_, err := onOne(ctx, m.Factory, name, func(name string, c Client) (*apiv1.App, error) {
 		return &apiv1.App{}, c.DevSessionRenew(ctx, name, client)
@@ -317,6 +331,42 @@ func (m *MultiClient) ContainerReplicaGet(ctx context.Context, name string) (*ap
 	})
 }
 
+func (m *MultiClient) ContainerReplicaUsageList(ctx context.Context, opts *ContainerReplicaListOptions) ([]apiv1.ContainerReplicaUsage, error) {
+	if opts != nil && opts.App != "" {
+		return onOneList(ctx, m.Factory, opts.App, func(name string, c Client) ([]apiv1.ContainerReplicaUsage, error) {
+			opts.App = name
+			return c.ContainerReplicaUsageList(ctx, opts)
+		})
+	}
+	return aggregate(ctx, m.Factory, func(c Client) ([]apiv1.ContainerReplicaUsage, error) {
+		return c.ContainerReplicaUsageList(ctx, opts)
+	})
+}
+
+func (m *MultiClient) JobExecutionList(ctx context.Context, opts *JobExecutionListOptions) ([]apiv1.JobExecution, error) {
+	if opts != nil && opts.App != "" {
+		return onOneList(ctx, m.Factory, opts.App, func(name string, c Client) ([]apiv1.JobExecution, error) {
+			opts.App = name
+			return c.JobExecutionList(ctx, opts)
+		})
+	}
+	return aggregate(ctx, m.Factory, func(c Client) ([]apiv1.JobExecution, error) {
+		return c.JobExecutionList(ctx, opts)
+	})
+}
+
+func (m *MultiClient) JobExecutionGet(ctx context.Context, name string) (*apiv1.JobExecution, error) {
+	return onOne(ctx, m.Factory, name, func(name string, c Client) (*apiv1.JobExecution, error) {
+		return c.JobExecutionGet(ctx, name)
+	})
+}
+
+func (m *MultiClient) JobRun(ctx context.Context, name string) (*apiv1.JobExecution, error) {
+	return onOne(ctx, m.Factory, name, func(name string, c Client) (*apiv1.JobExecution, error) {
+		return c.JobRun(ctx, name)
+	})
+}
+
 func (m *MultiClient) ContainerReplicaDelete(ctx context.Context, name string) (*apiv1.ContainerReplica, error) {
 	return onOne(ctx, m.Factory, name, func(name string, c Client) (*apiv1.ContainerReplica, error) {
 		return c.ContainerReplicaDelete(ctx, name)
@@ -357,6 +407,49 @@ func (m *MultiClient) VolumeDelete(ctx context.Context, name string) (*apiv1.Vol
 	})
 }
 
+func (m *MultiClient) VolumeSnapshotCreate(ctx context.Context, name, volume, class string) (*apiv1.VolumeSnapshot, error) {
+	return onOne(ctx, m.Factory, volume, func(volume string, c Client) (*apiv1.VolumeSnapshot, error) {
+		return c.VolumeSnapshotCreate(ctx, name, volume, class)
+	})
+}
+
+func (m *MultiClient) VolumeSnapshotList(ctx context.Context) ([]apiv1.VolumeSnapshot, error) {
+	return aggregate(ctx, m.Factory, func(c Client) ([]apiv1.VolumeSnapshot, error) {
+		return c.VolumeSnapshotList(ctx)
+	})
+}
+
+func (m *MultiClient) VolumeSnapshotGet(ctx context.Context, name string) (*apiv1.VolumeSnapshot, error) {
+	return onOne(ctx, m.Factory, name, func(name string, c Client) (*apiv1.VolumeSnapshot, error) {
+		return c.VolumeSnapshotGet(ctx, name)
+	})
+}
+
+func (m *MultiClient) VolumeSnapshotDelete(ctx context.Context, name string) (*apiv1.VolumeSnapshot, error) {
+	return onOne(ctx, m.Factory, name, func(name string, c Client) (*apiv1.VolumeSnapshot, error) {
+		return c.VolumeSnapshotDelete(ctx, name)
+	})
+}
+
+func (m *MultiClient) VolumeBackupCreate(ctx context.Context, name string, spec apiv1.VolumeBackupSpec) (*apiv1.VolumeBackup, error) {
+	return onOne(ctx, m.Factory, spec.Volume, func(volume string, c Client) (*apiv1.VolumeBackup, error) {
+		spec.Volume = volume
+		return c.VolumeBackupCreate(ctx, name, spec)
+	})
+}
+
+func (m *MultiClient) VolumeBackupList(ctx context.Context) ([]apiv1.VolumeBackup, error) {
+	return aggregate(ctx, m.Factory, func(c Client) ([]apiv1.VolumeBackup, error) {
+		return c.VolumeBackupList(ctx)
+	})
+}
+
+func (m *MultiClient) VolumeBackupGet(ctx context.Context, name string) (*apiv1.VolumeBackup, error) {
+	return onOne(ctx, m.Factory, name, func(name string, c Client) (*apiv1.VolumeBackup, error) {
+		return c.VolumeBackupGet(ctx, name)
+	})
+}
+
 func (m *MultiClient) ImageList(ctx context.Context) ([]apiv1.Image, error) {
 	c, err := m.Factory.ForProject(ctx, m.Factory.DefaultProject())
 	if err != nil {
@@ -608,6 +701,30 @@ func (m *MultiClient) EventStream(ctx context.Context, opts *EventStreamOptions)
 	return result, nil
 }
 
+func (m *MultiClient) EventSubscriptionCreate(ctx context.Context, name string, spec v1.EventSubscriptionSpec) (*apiv1.EventSubscription, error) {
+	return onOne(ctx, m.Factory, name, func(name string, c Client) (*apiv1.EventSubscription, error) {
+		return c.EventSubscriptionCreate(ctx, name, spec)
+	})
+}
+
+func (m *MultiClient) EventSubscriptionList(ctx context.Context) ([]apiv1.EventSubscription, error) {
+	return aggregate(ctx, m.Factory, func(c Client) ([]apiv1.EventSubscription, error) {
+		return c.EventSubscriptionList(ctx)
+	})
+}
+
+func (m *MultiClient) EventSubscriptionGet(ctx context.Context, name string) (*apiv1.EventSubscription, error) {
+	return onOne(ctx, m.Factory, name, func(name string, c Client) (*apiv1.EventSubscription, error) {
+		return c.EventSubscriptionGet(ctx, name)
+	})
+}
+
+func (m *MultiClient) EventSubscriptionDelete(ctx context.Context, name string) (*apiv1.EventSubscription, error) {
+	return onOne(ctx, m.Factory, name, func(name string, c Client) (*apiv1.EventSubscription, error) {
+		return c.EventSubscriptionDelete(ctx, name)
+	})
+}
+
 func (m *MultiClient) GetProject() string {
 	return m.project
 }
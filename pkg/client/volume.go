@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"sort"
+	"strings"
 
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	apierror "k8s.io/apimachinery/pkg/api/errors"
@@ -51,6 +52,109 @@ func (c *DefaultClient) VolumeDelete(ctx context.Context, name string) (*apiv1.V
 	})
 }
 
+func (c *DefaultClient) VolumeSnapshotCreate(ctx context.Context, name, volume, class string) (*apiv1.VolumeSnapshot, error) {
+	snapshot := &apiv1.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: c.Namespace,
+		},
+		Spec: apiv1.VolumeSnapshotSpec{
+			Volume: volume,
+			Class:  class,
+		},
+	}
+	if strings.HasSuffix(snapshot.Name, "-") {
+		snapshot.GenerateName = snapshot.Name
+		snapshot.Name = ""
+	}
+	return snapshot, c.Client.Create(ctx, snapshot)
+}
+
+func (c *DefaultClient) VolumeSnapshotList(ctx context.Context) ([]apiv1.VolumeSnapshot, error) {
+	snapshots := &apiv1.VolumeSnapshotList{}
+	err := c.Client.List(ctx, snapshots, &kclient.ListOptions{
+		Namespace: c.Namespace,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(snapshots.Items, func(i, j int) bool {
+		if snapshots.Items[i].CreationTimestamp.Time == snapshots.Items[j].CreationTimestamp.Time {
+			return snapshots.Items[i].Name < snapshots.Items[j].Name
+		}
+		return snapshots.Items[i].CreationTimestamp.After(snapshots.Items[j].CreationTimestamp.Time)
+	})
+
+	return snapshots.Items, nil
+}
+
+func (c *DefaultClient) VolumeSnapshotGet(ctx context.Context, name string) (*apiv1.VolumeSnapshot, error) {
+	snapshot := &apiv1.VolumeSnapshot{}
+	return snapshot, c.Client.Get(ctx, kclient.ObjectKey{
+		Name:      name,
+		Namespace: c.Namespace,
+	}, snapshot)
+}
+
+func (c *DefaultClient) VolumeSnapshotDelete(ctx context.Context, name string) (*apiv1.VolumeSnapshot, error) {
+	// get first to ensure the namespace matches
+	snapshot, err := c.VolumeSnapshotGet(ctx, name)
+	if apierror.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return snapshot, c.Client.Delete(ctx, &apiv1.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: c.Namespace,
+		},
+	})
+}
+
+func (c *DefaultClient) VolumeBackupCreate(ctx context.Context, name string, spec apiv1.VolumeBackupSpec) (*apiv1.VolumeBackup, error) {
+	backup := &apiv1.VolumeBackup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: c.Namespace,
+		},
+		Spec: spec,
+	}
+	if strings.HasSuffix(backup.Name, "-") {
+		backup.GenerateName = backup.Name
+		backup.Name = ""
+	}
+	return backup, c.Client.Create(ctx, backup)
+}
+
+func (c *DefaultClient) VolumeBackupList(ctx context.Context) ([]apiv1.VolumeBackup, error) {
+	backups := &apiv1.VolumeBackupList{}
+	err := c.Client.List(ctx, backups, &kclient.ListOptions{
+		Namespace: c.Namespace,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(backups.Items, func(i, j int) bool {
+		if backups.Items[i].CreationTimestamp.Time == backups.Items[j].CreationTimestamp.Time {
+			return backups.Items[i].Name < backups.Items[j].Name
+		}
+		return backups.Items[i].CreationTimestamp.After(backups.Items[j].CreationTimestamp.Time)
+	})
+
+	return backups.Items, nil
+}
+
+func (c *DefaultClient) VolumeBackupGet(ctx context.Context, name string) (*apiv1.VolumeBackup, error) {
+	backup := &apiv1.VolumeBackup{}
+	return backup, c.Client.Get(ctx, kclient.ObjectKey{
+		Name:      name,
+		Namespace: c.Namespace,
+	}, backup)
+}
+
 func (c *DefaultClient) VolumeClassList(ctx context.Context) ([]apiv1.VolumeClass, error) {
 	volumeClasses := new(apiv1.VolumeClassList)
 	err := c.Client.List(ctx, volumeClasses, &kclient.ListOptions{Namespace: c.Namespace})
@@ -21,10 +21,11 @@ const gi = float64(1 << 30) // 1 Gibibyte. Note: this is not equivalent to 1 Gig
 
 var (
 	ErrInvalidMemoryForClass = errors.New("memory is invalid")
+	ErrInvalidCPUForClass    = errors.New("cpu is invalid")
 	ErrInvalidClass          = errors.New("compute class is invalid")
 )
 
-type memoryQuantities struct {
+type classQuantities struct {
 	Max    *resource.Quantity
 	Min    *resource.Quantity
 	Def    *resource.Quantity
@@ -38,24 +39,24 @@ func parseQuantity(memory string) (resource.Quantity, error) {
 	return resource.ParseQuantity(memory)
 }
 
-func ParseComputeClassMemory(memory internaladminv1.ComputeClassMemory) (memoryQuantities, error) {
-	var quantities memoryQuantities
+func ParseComputeClassMemory(memory internaladminv1.ComputeClassMemory) (classQuantities, error) {
+	var quantities classQuantities
 
 	minInt, err := parseQuantity(memory.Min)
 	if err != nil {
-		return memoryQuantities{}, err
+		return classQuantities{}, err
 	}
 	quantities.Min = &minInt
 
 	maxInt, err := parseQuantity(memory.Max)
 	if err != nil {
-		return memoryQuantities{}, err
+		return classQuantities{}, err
 	}
 	quantities.Max = &maxInt
 
 	defInt, err := parseQuantity(memory.Default)
 	if err != nil {
-		return memoryQuantities{}, err
+		return classQuantities{}, err
 	}
 	quantities.Def = &defInt
 
@@ -63,7 +64,7 @@ func ParseComputeClassMemory(memory internaladminv1.ComputeClassMemory) (memoryQ
 	for i, value := range memory.Values {
 		valueInt, err := parseQuantity(value)
 		if err != nil {
-			return memoryQuantities{}, err
+			return classQuantities{}, err
 		}
 		quantities.Values[i] = &valueInt
 	}
@@ -71,7 +72,12 @@ func ParseComputeClassMemory(memory internaladminv1.ComputeClassMemory) (memoryQ
 	return quantities, nil
 }
 
-func memoryInValues(parsedMemory memoryQuantities, memory resource.Quantity) bool {
+// ParseComputeClassCPU parses the CPU quantities of a ComputeClass. Use MilliValue to compare them.
+func ParseComputeClassCPU(cpu internaladminv1.ComputeClassCPU) (classQuantities, error) {
+	return ParseComputeClassMemory(internaladminv1.ComputeClassMemory(cpu))
+}
+
+func memoryInValues(parsedMemory classQuantities, memory resource.Quantity) bool {
 	value := memory.Value()
 	for _, allowedMemory := range parsedMemory.Values {
 		if allowedMemory != nil && value == allowedMemory.Value() {
@@ -117,11 +123,62 @@ func Validate(cc apiv1.ComputeClass, memory resource.Quantity, memDefault *int64
 	return nil
 }
 
-func CalculateCPU(cc internaladminv1.ProjectComputeClassInstance, memDefault *int64, memory resource.Quantity) (resource.Quantity, error) {
+func cpuInValues(parsedCPU classQuantities, milliCPU int64) bool {
+	for _, allowedCPU := range parsedCPU.Values {
+		if allowedCPU != nil && milliCPU == allowedCPU.MilliValue() {
+			return true
+		}
+	}
+	return len(parsedCPU.Values) == 0
+}
+
+// ValidateCPU checks that the CPU of a workload is allowed by the ComputeClass
+func ValidateCPU(cc apiv1.ComputeClass, cpu resource.Quantity) error {
+	parsedCPU, err := ParseComputeClassCPU(cc.CPU)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidClass, err)
+	}
+
+	milliCPU := cpu.MilliValue()
+	if !cpuInValues(parsedCPU, milliCPU) {
+		return fmt.Errorf("%w: defined cpu %v is not an allowed value for the ComputeClass %v. allowed values: %v",
+			ErrInvalidCPUForClass, cpu.String(), cc.Name, cc.CPU.Values)
+	}
+	if max := parsedCPU.Max.MilliValue(); max != 0 && milliCPU > max {
+		return fmt.Errorf("%w: defined cpu %v exceeds the maximum cpu for the ComputeClass %v of %v",
+			ErrInvalidCPUForClass, cpu.String(), cc.Name, parsedCPU.Max.String())
+	}
+	if min := parsedCPU.Min.MilliValue(); milliCPU < min {
+		return fmt.Errorf("%w: defined cpu %v is below the minimum cpu for the ComputeClass %v of %v",
+			ErrInvalidCPUForClass, cpu.String(), cc.Name, parsedCPU.Min.String())
+	}
+
+	return nil
+}
+
+// CalculateCPU determines the CPU to request for a workload. If the workload doesn't set its CPU, the default CPU of
+// the ComputeClass is used. If neither is set, the CPU is scaled from the memory with the CPUScaler of the ComputeClass.
+func CalculateCPU(cc internaladminv1.ProjectComputeClassInstance, memDefault *int64, memory resource.Quantity, cpu *internalv1.MilliCPU) (resource.Quantity, error) {
 	if err := ValidateProjectComputeClass(cc, memory, memDefault); err != nil {
 		return resource.Quantity{}, err
 	}
 
+	if cpu == nil && cc.CPU.Default != "" {
+		parsedCPU, err := ParseComputeClassCPU(cc.CPU)
+		if err != nil {
+			return resource.Quantity{}, fmt.Errorf("%w: %v", ErrInvalidClass, err)
+		}
+		cpu = &[]internalv1.MilliCPU{internalv1.MilliCPU(parsedCPU.Def.MilliValue())}[0]
+	}
+
+	if cpu != nil {
+		cpuQuantity := cpu.Quantity()
+		if err := ValidateCPU(toComputeClass(cc), cpuQuantity); err != nil {
+			return resource.Quantity{}, err
+		}
+		return cpuQuantity, nil
+	}
+
 	// The CPU scaler calculates the CPUs per Gi of memory so get the memory in a ratio of Gi
 	memoryInGi := memory.AsApproximateFloat64() / gi
 	// Since we're putting this in to mili-cpu's, multiply memoryInGi by the scaler and by 1000
@@ -131,15 +188,20 @@ func CalculateCPU(cc internaladminv1.ProjectComputeClassInstance, memDefault *in
 }
 
 func ValidateProjectComputeClass(cc internaladminv1.ProjectComputeClassInstance, memory resource.Quantity, memDefault *int64) error {
-	return Validate(apiv1.ComputeClass{
+	return Validate(toComputeClass(cc), memory, memDefault)
+}
+
+func toComputeClass(cc internaladminv1.ProjectComputeClassInstance) apiv1.ComputeClass {
+	return apiv1.ComputeClass{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: cc.Name,
 		},
 		Memory:           cc.Memory,
+		CPU:              cc.CPU,
 		Description:      cc.Description,
 		Default:          cc.Default,
 		SupportedRegions: cc.SupportedRegions,
-	}, memory, memDefault)
+	}
 }
 
 func GetComputeClassNameForWorkload(workload string, container internalv1.Container, computeClasses internalv1.ComputeClassMap) string {
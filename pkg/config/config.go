@@ -70,6 +70,9 @@ func complete(ctx context.Context, c *apiv1.Config, getter kclient.Reader) error
 	if c.RecordBuilds == nil {
 		c.RecordBuilds = new(bool)
 	}
+	if c.BuildSBOM == nil {
+		c.BuildSBOM = new(bool)
+	}
 	if c.PublishBuilders == nil {
 		c.PublishBuilders = new(bool)
 	}
@@ -118,6 +121,24 @@ func complete(ctx context.Context, c *apiv1.Config, getter kclient.Reader) error
 	if c.CertManagerIssuer == nil {
 		c.CertManagerIssuer = new(string)
 	}
+	if c.VaultAddress == nil {
+		c.VaultAddress = new(string)
+	}
+	if c.ExternalSecretsDirectory == nil {
+		c.ExternalSecretsDirectory = new(string)
+	}
+	if c.VolumeBackupDirectory == nil {
+		c.VolumeBackupDirectory = new(string)
+	}
+	if c.LogSinkDirectory == nil {
+		c.LogSinkDirectory = new(string)
+	}
+	if c.PrometheusPodMonitors == nil {
+		c.PrometheusPodMonitors = new(bool)
+	}
+	if c.TracingEndpoint == nil {
+		c.TracingEndpoint = new(string)
+	}
 	return nil
 }
 
@@ -312,6 +333,9 @@ func merge(oldConfig, newConfig *apiv1.Config) *apiv1.Config {
 	if newConfig.RecordBuilds != nil {
 		mergedConfig.RecordBuilds = newConfig.RecordBuilds
 	}
+	if newConfig.BuildSBOM != nil {
+		mergedConfig.BuildSBOM = newConfig.BuildSBOM
+	}
 	if newConfig.PublishBuilders != nil {
 		mergedConfig.PublishBuilders = newConfig.PublishBuilders
 	}
@@ -379,6 +403,26 @@ func merge(oldConfig, newConfig *apiv1.Config) *apiv1.Config {
 		mergedConfig.CertManagerIssuer = newConfig.CertManagerIssuer
 	}
 
+	if newConfig.VaultAddress != nil {
+		mergedConfig.VaultAddress = newConfig.VaultAddress
+	}
+
+	if newConfig.ExternalSecretsDirectory != nil {
+		mergedConfig.ExternalSecretsDirectory = newConfig.ExternalSecretsDirectory
+	}
+	if newConfig.VolumeBackupDirectory != nil {
+		mergedConfig.VolumeBackupDirectory = newConfig.VolumeBackupDirectory
+	}
+	if newConfig.LogSinkDirectory != nil {
+		mergedConfig.LogSinkDirectory = newConfig.LogSinkDirectory
+	}
+	if newConfig.PrometheusPodMonitors != nil {
+		mergedConfig.PrometheusPodMonitors = newConfig.PrometheusPodMonitors
+	}
+	if newConfig.TracingEndpoint != nil {
+		mergedConfig.TracingEndpoint = newConfig.TracingEndpoint
+	}
+
 	return &mergedConfig
 }
 
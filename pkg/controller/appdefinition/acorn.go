@@ -56,6 +56,7 @@ func toAcorns(appInstance *v1.AppInstance, tag name.Reference, pullSecrets *Pull
 			NotifyUpgrade:       service.NotifyUpgrade,
 			AutoUpgradeInterval: service.AutoUpgradeInterval,
 			Memory:              service.Memory,
+			CPU:                 service.CPU,
 		}))
 	}
 	return result
@@ -140,6 +141,7 @@ func toAcorn(appInstance *v1.AppInstance, tag name.Reference, pullSecrets *PullS
 			AutoUpgrade:         acorn.AutoUpgrade,
 			AutoUpgradeInterval: acorn.AutoUpgradeInterval,
 			NotifyUpgrade:       acorn.NotifyUpgrade,
+			CPU:                 acorn.CPU,
 		},
 	}
 
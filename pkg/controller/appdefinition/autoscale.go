@@ -0,0 +1,80 @@
+package appdefinition
+
+import (
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// isAutoscaled returns true if the replicas of the container's deployment should be managed by a
+// HorizontalPodAutoscaler instead of the controller
+func isAutoscaled(appInstance *v1.AppInstance, container v1.Container) bool {
+	return container.Autoscale != nil &&
+		!appInstance.GetStopped() &&
+		!isStateful(appInstance, container)
+}
+
+func toHorizontalPodAutoscaler(appInstance *v1.AppInstance, dep *appsv1.Deployment, container v1.Container) *autoscalingv2.HorizontalPodAutoscaler {
+	if !isAutoscaled(appInstance, container) {
+		return nil
+	}
+
+	minReplicas := container.Autoscale.GetMinReplicas(container.Scale)
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        dep.Name,
+			Namespace:   dep.Namespace,
+			Labels:      dep.Labels,
+			Annotations: dep.Annotations,
+		},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				APIVersion: appsv1.SchemeGroupVersion.String(),
+				Kind:       "Deployment",
+				Name:       dep.Name,
+			},
+			MinReplicas: &minReplicas,
+			MaxReplicas: container.Autoscale.MaxReplicas,
+		},
+	}
+
+	if container.Autoscale.CPU != nil {
+		hpa.Spec.Metrics = append(hpa.Spec.Metrics, toUtilizationMetric(corev1.ResourceCPU, *container.Autoscale.CPU))
+	}
+	if container.Autoscale.Memory != nil {
+		hpa.Spec.Metrics = append(hpa.Spec.Metrics, toUtilizationMetric(corev1.ResourceMemory, *container.Autoscale.Memory))
+	}
+	for _, metric := range container.Autoscale.Metrics {
+		target := resource.MustParse(metric.Target)
+		hpa.Spec.Metrics = append(hpa.Spec.Metrics, autoscalingv2.MetricSpec{
+			Type: autoscalingv2.PodsMetricSourceType,
+			Pods: &autoscalingv2.PodsMetricSource{
+				Metric: autoscalingv2.MetricIdentifier{
+					Name: metric.Name,
+				},
+				Target: autoscalingv2.MetricTarget{
+					Type:         autoscalingv2.AverageValueMetricType,
+					AverageValue: &target,
+				},
+			},
+		})
+	}
+
+	return hpa
+}
+
+func toUtilizationMetric(name corev1.ResourceName, utilization int32) autoscalingv2.MetricSpec {
+	return autoscalingv2.MetricSpec{
+		Type: autoscalingv2.ResourceMetricSourceType,
+		Resource: &autoscalingv2.ResourceMetricSource{
+			Name: name,
+			Target: autoscalingv2.MetricTarget{
+				Type:               autoscalingv2.UtilizationMetricType,
+				AverageUtilization: &utilization,
+			},
+		},
+	}
+}
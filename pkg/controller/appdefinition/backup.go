@@ -0,0 +1,168 @@
+package appdefinition
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/baaah/pkg/typed"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/appdefinition"
+	"github.com/acorn-io/runtime/pkg/backup"
+	"github.com/acorn-io/runtime/pkg/config"
+	"github.com/acorn-io/runtime/pkg/labels"
+	name2 "github.com/rancher/wrangler/pkg/name"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func addVolumeBackups(req router.Request, appInstance *v1.AppInstance, resp router.Response) error {
+	cfg, err := config.Get(req.Ctx, req.Client)
+	if err != nil {
+		return err
+	}
+
+	cronJobs, err := toVolumeBackups(appInstance, *cfg.VolumeBackupDirectory)
+	if err != nil {
+		return err
+	}
+	resp.Objects(cronJobs...)
+	return nil
+}
+
+// toVolumeBackups returns a CronJob for every volume with a backup policy that backs up the volume on its schedule.
+// file:// URLs are stored in the directory of the project in the volume backup directory on the node.
+func toVolumeBackups(appInstance *v1.AppInstance, hostDirectory string) (result []kclient.Object, _ error) {
+	for _, entry := range typed.Sorted(appInstance.Status.AppSpec.Volumes) {
+		vol, policy := entry.Key, entry.Value.Backup
+		if policy == nil || strings.EqualFold(entry.Value.Class, v1.VolumeRequestTypeEphemeral) {
+			continue
+		}
+
+		if policy.Schedule == "" || policy.To == "" {
+			return nil, fmt.Errorf("volume [%s]: %w: a schedule and the URL to back up to are required to back up a volume", vol, appdefinition.ErrInvalidInput)
+		}
+		if err := backup.Validate(policy.To); err != nil {
+			return nil, fmt.Errorf("volume [%s]: %w: %w", vol, appdefinition.ErrInvalidInput, err)
+		}
+
+		claimName, _ := toVolumeName(appInstance, vol)
+		podSpec, err := backup.PodSpec(backup.JobOptions{
+			Type:          backup.TypeBackup,
+			URL:           policy.To,
+			Name:          vol,
+			ClaimName:     claimName,
+			Secret:        policy.Secret,
+			Affinity:      volumeAffinity(appInstance, vol),
+			HostDirectory: hostDirectory,
+			Project:       appInstance.Namespace,
+		})
+		if errors.Is(err, backup.ErrFileBackupsDisabled) {
+			return nil, fmt.Errorf("volume [%s]: %w: %w", vol, appdefinition.ErrInvalidInput, err)
+		} else if err != nil {
+			return nil, err
+		}
+
+		backupLabels := map[string]string{
+			labels.AcornManaged:          "true",
+			labels.AcornAppName:          appInstance.Name,
+			labels.AcornAppNamespace:     appInstance.Namespace,
+			labels.AcornVolumeName:       vol,
+			labels.AcornVolumeBackupType: backup.TypeBackup,
+		}
+		backupAnnotations := map[string]string{
+			labels.AcornVolumeBackupURL:    policy.To,
+			labels.AcornVolumeBackupSecret: policy.Secret,
+		}
+
+		result = append(result, &batchv1.CronJob{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name2.SafeConcatName(vol, "volume", "backup"),
+				Namespace:   appInstance.Status.Namespace,
+				Labels:      backupLabels,
+				Annotations: backupAnnotations,
+			},
+			Spec: batchv1.CronJobSpec{
+				FailedJobsHistoryLimit:     &[]int32{3}[0],
+				SuccessfulJobsHistoryLimit: &[]int32{3}[0],
+				ConcurrencyPolicy:          batchv1.ForbidConcurrent,
+				Schedule:                   toCronJobSchedule(policy.Schedule),
+				JobTemplate: batchv1.JobTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels:      backupLabels,
+						Annotations: backupAnnotations,
+					},
+					Spec: batchv1.JobSpec{
+						BackoffLimit: &[]int32{2}[0],
+						Template: corev1.PodTemplateSpec{
+							ObjectMeta: metav1.ObjectMeta{
+								Labels: backupLabels,
+							},
+							Spec: podSpec,
+						},
+					},
+				},
+			},
+		})
+	}
+	return
+}
+
+// volumeAffinity prefers the node of the pods of the containers that mount the volume, a volume that can only be
+// attached to one node at a time can't be backed up anywhere else while the app is running
+func volumeAffinity(appInstance *v1.AppInstance, vol string) *corev1.Affinity {
+	containerNames := sets.New[string]()
+	for _, containers := range []map[string]v1.Container{appInstance.Status.AppSpec.Containers, appInstance.Status.AppSpec.Jobs} {
+		for name, container := range containers {
+			if mountsVolume(container, vol) {
+				containerNames.Insert(name)
+			}
+			for _, sidecar := range container.Sidecars {
+				if mountsVolume(sidecar, vol) {
+					containerNames.Insert(name)
+				}
+			}
+		}
+	}
+	if containerNames.Len() == 0 {
+		return nil
+	}
+
+	return &corev1.Affinity{
+		PodAffinity: &corev1.PodAffinity{
+			PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{
+				{
+					Weight: 100,
+					PodAffinityTerm: corev1.PodAffinityTerm{
+						LabelSelector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{
+								labels.AcornAppName: appInstance.Name,
+							},
+							MatchExpressions: []metav1.LabelSelectorRequirement{
+								{
+									Key:      labels.AcornContainerName,
+									Operator: metav1.LabelSelectorOpIn,
+									Values:   sets.List(containerNames),
+								},
+							},
+						},
+						TopologyKey: corev1.LabelHostname,
+					},
+				},
+			},
+		},
+	}
+}
+
+func mountsVolume(container v1.Container, vol string) bool {
+	for _, mount := range container.Dirs {
+		if mount.Volume == vol {
+			return true
+		}
+	}
+	return false
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/acorn-io/baaah/pkg/router"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/condition"
+	"github.com/acorn-io/runtime/pkg/event"
 	"github.com/acorn-io/runtime/pkg/imageallowrules"
 	"github.com/google/go-containerregistry/pkg/name"
 	"github.com/google/go-containerregistry/pkg/v1/remote"
@@ -15,9 +16,11 @@ import (
 
 // CheckImageAllowedHandler is a router handler that checks if the image is allowed by the image allow rules and sets a status field accordingly
 // This is only working on the currently specified image, referenced by digest, to avoid false positives (alerts) if the remote image has been updated
-func CheckImageAllowedHandler(transport http.RoundTripper) router.HandlerFunc {
+// An ImageNotAllowed event is recorded when the image becomes disallowed.
+func CheckImageAllowedHandler(transport http.RoundTripper, recorder event.Recorder) router.HandlerFunc {
 	return func(req router.Request, resp router.Response) error {
 		appInstance := req.Object.(*v1.AppInstance)
+		previous := appInstance.Status.Condition(v1.AppInstanceConditionImageAllowed)
 		cond := condition.Setter(appInstance, resp, v1.AppInstanceConditionImageAllowed)
 
 		// We're only checking against the currently used image, so if the image name or digest is empty, we can't check
@@ -39,6 +42,12 @@ func CheckImageAllowedHandler(transport http.RoundTripper) router.HandlerFunc {
 
 		if err := imageallowrules.CheckImageAllowed(req.Ctx, req.Client, appInstance.Namespace, targetImage, targetImageDigest, remote.WithTransport(transport)); err != nil {
 			if _, ok := err.(*imageallowrules.ErrImageNotAllowed); ok {
+				if !previous.Error || previous.Message != err.Error() {
+					imageallowrules.RecordNotAllowedEvent(req.Ctx, recorder, appInstance, imageallowrules.ImageNotAllowedEventDetails{
+						Image:  targetImage,
+						Digest: targetImageDigest,
+					})
+				}
 				cond.Error(err)
 				return nil
 			} else {
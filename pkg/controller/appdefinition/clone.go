@@ -0,0 +1,260 @@
+package appdefinition
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	adminv1 "github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/backup"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/volume"
+	name2 "github.com/rancher/wrangler/pkg/name"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	klabels "k8s.io/apimachinery/pkg/labels"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// cloneBindName is the name of the PVC of a volume that is provisioned as a copy of another volume. Like a volume
+// provisioned from a snapshot, the PVC can't reuse the name of the volume because its data source can't be changed.
+func cloneBindName(volume, source string) string {
+	return name2.SafeConcatName(volume, "clone", source)
+}
+
+// cloneSource returns the name of the volume that the volume is provisioned as a copy of, if any
+func cloneSource(appInstance *v1.AppInstance, volume string, binding v1.VolumeBinding) string {
+	if binding.CloneOf != "" {
+		return binding.CloneOf
+	}
+	if volumeRequest, ok := appInstance.Status.AppSpec.Volumes[volume]; ok && !strings.EqualFold(volumeRequest.Class, v1.VolumeRequestTypeEphemeral) {
+		return volumeRequest.From
+	}
+	return ""
+}
+
+// cloneVolume provisions the PVC as a copy of the source volume. If the storage driver can clone the volume, the
+// source is the data source of the PVC. Otherwise, a job copies the source into a new volume in the namespace of the
+// source, and the volume is handed over to the PVC once the copy is done. The objects of the copy are returned, and
+// whether the PVC can be created.
+func cloneVolume(req router.Request, appInstance *v1.AppInstance, pvc *corev1.PersistentVolumeClaim, source string, volumeClasses map[string]adminv1.ProjectVolumeClassInstance) ([]kclient.Object, bool, error) {
+	if exists, err := useExistingPVC(req, pvc); err != nil {
+		return nil, false, err
+	} else if exists {
+		// The volume was already provisioned as a copy, the source isn't needed anymore and may be deleted
+		return nil, true, nil
+	}
+
+	// A copy that is done was handed over to this app, but the PVC wasn't created yet
+	if pvName, err := lookupExistingPV(req, appInstance, pvc.Name); err != nil {
+		return nil, false, err
+	} else if pvName != "" {
+		pvc.Spec.VolumeName = pvName
+		return nil, true, nil
+	}
+
+	sourcePV, err := getCloneSource(req, appInstance, source)
+	if err != nil {
+		return nil, false, err
+	}
+	if sourcePV.Spec.ClaimRef == nil || sourcePV.Status.Phase != corev1.VolumeBound {
+		return nil, false, fmt.Errorf("volume %s is not in use by an app, only volumes that are bound to an app can be cloned", source)
+	}
+
+	sourceClaim := &corev1.PersistentVolumeClaim{}
+	if err := req.Get(sourceClaim, sourcePV.Spec.ClaimRef.Namespace, sourcePV.Spec.ClaimRef.Name); err != nil {
+		return nil, false, err
+	}
+
+	// the new volume must be at least as big as the volume it is a copy of
+	if capacity := sourcePV.Spec.Capacity.Storage(); pvc.Spec.Resources.Requests.Storage().Cmp(*capacity) < 0 {
+		pvc.Spec.Resources.Requests[corev1.ResourceStorage] = *capacity
+	}
+	if volClass, ok := volumeClasses[pvc.Labels[labels.AcornVolumeClass]]; ok && volClass.Size.Max != "" &&
+		pvc.Spec.Resources.Requests.Storage().Cmp(*v1.MustParseResourceQuantity(volClass.Size.Max)) > 0 {
+		return nil, false, fmt.Errorf("volume %s can not be cloned from %s, its size %s is greater than volume class %s maximum of %v",
+			pvc.Labels[labels.AcornVolumeName], source, pvc.Spec.Resources.Requests.Storage().String(), volClass.Name, volClass.Size.Max)
+	}
+
+	if ok, err := canCloneWithCSI(req, sourceClaim, pvc); err != nil {
+		return nil, false, err
+	} else if ok {
+		pvc.Spec.DataSource = &corev1.TypedLocalObjectReference{
+			Kind: "PersistentVolumeClaim",
+			Name: sourceClaim.Name,
+		}
+		return nil, true, nil
+	}
+
+	return copyVolume(req, appInstance, pvc, source, sourceClaim)
+}
+
+// getCloneSource returns the PersistentVolume of the volume to clone. The volume is either the name of a volume of the
+// project or its <app>.<volume> alias.
+func getCloneSource(req router.Request, appInstance *v1.AppInstance, source string) (*corev1.PersistentVolume, error) {
+	notFound := fmt.Errorf("no Acorn-managed volume found with name %q in project %q", source, appInstance.Namespace)
+
+	pv := &corev1.PersistentVolume{}
+	if err := req.Get(pv, "", source); apierrors.IsNotFound(err) {
+		pvs := &corev1.PersistentVolumeList{}
+		if err := req.List(pvs, &kclient.ListOptions{
+			LabelSelector: klabels.SelectorFromSet(map[string]string{
+				labels.AcornManaged:      "true",
+				labels.AcornAppNamespace: appInstance.Namespace,
+				labels.AcornPublicName:   source,
+			}),
+		}); err != nil {
+			return nil, err
+		}
+		if len(pvs.Items) != 1 {
+			return nil, notFound
+		}
+		pv = &pvs.Items[0]
+	} else if err != nil {
+		return nil, err
+	}
+
+	// make sure this PV is managed by acorn and in the project of the app
+	if pv.Labels[labels.AcornManaged] != "true" || pv.Labels[labels.AcornAppNamespace] != appInstance.Namespace {
+		return nil, notFound
+	}
+	return pv, nil
+}
+
+// canCloneWithCSI returns true if the storage driver of the source can clone it into the PVC. A CSI driver can only
+// clone a volume into a PVC of the same namespace and storage class.
+func canCloneWithCSI(req router.Request, sourceClaim, pvc *corev1.PersistentVolumeClaim) (bool, error) {
+	if sourceClaim.Namespace != pvc.Namespace ||
+		sourceClaim.Spec.StorageClassName == nil || *sourceClaim.Spec.StorageClassName == "" ||
+		pvc.Spec.StorageClassName == nil || *pvc.Spec.StorageClassName != *sourceClaim.Spec.StorageClassName {
+		return false, nil
+	}
+
+	storageClass := &storagev1.StorageClass{}
+	if err := req.Get(storageClass, "", *pvc.Spec.StorageClassName); apierrors.IsNotFound(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	if err := req.Get(&storagev1.CSIDriver{}, "", storageClass.Provisioner); apierrors.IsNotFound(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// copyVolume returns a PVC in the namespace of the source and a job that copies the source into it. When the job is
+// done, the volume of the copy is labeled as a volume of this app so that it is bound to the PVC, and the copy is
+// removed.
+func copyVolume(req router.Request, appInstance *v1.AppInstance, pvc *corev1.PersistentVolumeClaim, source string, sourceClaim *corev1.PersistentVolumeClaim) ([]kclient.Object, bool, error) {
+	copyName := name2.SafeConcatName(appInstance.Name, pvc.Name, "copy")
+
+	job := &batchv1.Job{}
+	if err := req.Get(job, sourceClaim.Namespace, copyName); err == nil {
+		for _, cond := range job.Status.Conditions {
+			if cond.Status != corev1.ConditionTrue {
+				continue
+			}
+			switch cond.Type {
+			case batchv1.JobComplete:
+				return nil, true, handOverCopy(req, appInstance, pvc, sourceClaim.Namespace, copyName)
+			case batchv1.JobFailed:
+				return nil, false, fmt.Errorf("copying volume %s into volume %s failed: %s", source, pvc.Labels[labels.AcornVolumeName], cond.Message)
+			}
+		}
+	} else if !apierrors.IsNotFound(err) {
+		return nil, false, err
+	}
+
+	nodeName, err := volume.NodeOfClaim(req.Ctx, req.Client, sourceClaim)
+	if err != nil {
+		return nil, false, err
+	}
+
+	podSpec, err := backup.PodSpec(backup.JobOptions{
+		Type:            backup.TypeCopy,
+		ClaimName:       copyName,
+		SourceClaimName: sourceClaim.Name,
+		NodeName:        nodeName,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	// The copy is not labeled as managed by acorn until it is done, so that an unfinished copy is deleted with its claim
+	copyLabels := map[string]string{
+		labels.AcornAppName:      appInstance.Name,
+		labels.AcornAppNamespace: appInstance.Namespace,
+		labels.AcornVolumeName:   pvc.Labels[labels.AcornVolumeName],
+	}
+
+	return []kclient.Object{
+		&corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      copyName,
+				Namespace: sourceClaim.Namespace,
+				Labels:    copyLabels,
+			},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				AccessModes:      pvc.Spec.AccessModes,
+				StorageClassName: pvc.Spec.StorageClassName,
+				Resources:        pvc.Spec.Resources,
+			},
+		},
+		&batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      copyName,
+				Namespace: sourceClaim.Namespace,
+				Labels:    copyLabels,
+			},
+			Spec: batchv1.JobSpec{
+				BackoffLimit: &[]int32{2}[0],
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: copyLabels,
+					},
+					Spec: podSpec,
+				},
+			},
+		},
+	}, false, nil
+}
+
+// handOverCopy labels the volume of a finished copy as a volume of this app and binds the PVC to it. The volume is
+// retained when the claim of the copy is deleted, and released to the PVC by ReleaseVolume.
+func handOverCopy(req router.Request, appInstance *v1.AppInstance, pvc *corev1.PersistentVolumeClaim, namespace, copyName string) error {
+	copyClaim := &corev1.PersistentVolumeClaim{}
+	if err := req.Get(copyClaim, namespace, copyName); err != nil {
+		return err
+	}
+	if copyClaim.Spec.VolumeName == "" {
+		return fmt.Errorf("copy of volume %s is not bound", pvc.Labels[labels.AcornVolumeName])
+	}
+
+	pv := &corev1.PersistentVolume{}
+	if err := req.Get(pv, "", copyClaim.Spec.VolumeName); err != nil {
+		return err
+	}
+
+	pv.Labels = labels.Merge(pv.Labels, map[string]string{
+		labels.AcornManaged:      "true",
+		labels.AcornAppName:      appInstance.Name,
+		labels.AcornAppNamespace: appInstance.Namespace,
+		labels.AcornVolumeName:   pvc.Name,
+		labels.AcornPublicName:   pvc.Labels[labels.AcornPublicName],
+		labels.AcornVolumeClass:  pvc.Labels[labels.AcornVolumeClass],
+	})
+	pv.Spec.PersistentVolumeReclaimPolicy = corev1.PersistentVolumeReclaimRetain
+	if err := req.Client.Update(req.Ctx, pv); err != nil {
+		return err
+	}
+
+	pvc.Spec.VolumeName = pv.Name
+	return nil
+}
@@ -4,10 +4,34 @@ import (
 	"strconv"
 
 	"github.com/acorn-io/baaah/pkg/apply"
+	"github.com/acorn-io/baaah/pkg/typed"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/jobs"
 	"github.com/acorn-io/runtime/pkg/labels"
 )
 
+// withPreUpdateDependencies adds a dependency on each job that is running for the pre-update event so that containers
+// are not rolled out until the pre-update jobs have succeeded.
+func withPreUpdateDependencies(app *v1.AppInstance, deps []v1.Dependency) []v1.Dependency {
+	result := append([]v1.Dependency{}, deps...)
+	for _, jobName := range typed.SortedKeys(app.Status.AppSpec.Jobs) {
+		if jobs.IsPreUpdate(jobName, app) {
+			result = append(result, v1.Dependency{TargetName: jobName})
+		}
+	}
+	return result
+}
+
+// withPostUpdateDependencies adds a dependency on each container so that a job running for the post-update event
+// doesn't run until the containers have been rolled out.
+func withPostUpdateDependencies(app *v1.AppInstance, deps []v1.Dependency) []v1.Dependency {
+	result := append([]v1.Dependency{}, deps...)
+	for _, containerName := range typed.SortedKeys(app.Status.AppSpec.Containers) {
+		result = append(result, v1.Dependency{TargetName: containerName})
+	}
+	return result
+}
+
 func getDependencyAnnotations(app *v1.AppInstance, containerOrJobName string, deps []v1.Dependency) map[string]string {
 	result := map[string]string{}
 	if app.Generation > 0 {
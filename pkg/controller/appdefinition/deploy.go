@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/url"
 	"path"
 	"strconv"
@@ -23,9 +24,11 @@ import (
 	"github.com/acorn-io/runtime/pkg/publicname"
 	"github.com/acorn-io/runtime/pkg/secrets"
 	"github.com/acorn-io/runtime/pkg/system"
+	"github.com/acorn-io/runtime/pkg/tracing"
 	"github.com/acorn-io/runtime/pkg/volume"
 	"github.com/google/go-containerregistry/pkg/name"
 	"github.com/rancher/wrangler/pkg/data/convert"
+	"go.opentelemetry.io/otel/trace"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierror "k8s.io/apimachinery/pkg/api/errors"
@@ -55,6 +58,13 @@ func FilterLabelsAndAnnotationsConfig(h router.Handler) router.Handler {
 
 func DeploySpec(req router.Request, resp router.Response) (err error) {
 	appInstance := req.Object.(*v1.AppInstance)
+
+	var span trace.Span
+	req.Ctx, span = startSpan(req, "deploy app")
+	defer func() {
+		tracing.End(span, err)
+	}()
+
 	status := condition.Setter(appInstance, resp, v1.AppInstanceConditionDefined)
 	interpolator := secrets.NewInterpolator(req.Ctx, req.Client, appInstance)
 
@@ -101,6 +111,9 @@ func DeploySpec(req router.Request, resp router.Response) (err error) {
 	if err := addPVCs(req, appInstance, resp); err != nil {
 		return err
 	}
+	if err := addVolumeBackups(req, appInstance, resp); err != nil {
+		return err
+	}
 	addAcorns(req, appInstance, tag, pullSecrets, resp)
 
 	resp.Objects(pullSecrets.Objects()...)
@@ -623,7 +636,7 @@ func toDeployment(req router.Request, appInstance *v1.AppInstance, tag name.Refe
 			Name:        name,
 			Namespace:   appInstance.Status.Namespace,
 			Labels:      deploymentLabels,
-			Annotations: typed.Concat(deploymentAnnotations, getDependencyAnnotations(appInstance, name, container.Dependencies), secretAnnotations),
+			Annotations: typed.Concat(deploymentAnnotations, getDependencyAnnotations(appInstance, name, withPreUpdateDependencies(appInstance, container.Dependencies)), secretAnnotations),
 		},
 		Spec: appsv1.DeploymentSpec{
 			Replicas: container.Scale,
@@ -639,6 +652,7 @@ func toDeployment(req router.Request, appInstance *v1.AppInstance, tag name.Refe
 					Affinity:                      appInstance.Status.Scheduling[name].Affinity,
 					Tolerations:                   appInstance.Status.Scheduling[name].Tolerations,
 					PriorityClassName:             appInstance.Status.Scheduling[name].PriorityClassName,
+					TopologySpreadConstraints:     appInstance.Status.Scheduling[name].TopologySpreadConstraints,
 					TerminationGracePeriodSeconds: &[]int64{5}[0],
 					ImagePullSecrets:              pullSecrets.ForContainer(name, append(containers, initContainers...)),
 					EnableServiceLinks:            new(bool),
@@ -655,6 +669,9 @@ func toDeployment(req router.Request, appInstance *v1.AppInstance, tag name.Refe
 		dep.Spec.Replicas = &[]int32{1}[0]
 		dep.Spec.Template.Spec.Hostname = dep.Name
 		dep.Spec.Strategy.Type = appsv1.RecreateDeploymentStrategyType
+	} else if isAutoscaled(appInstance, container) {
+		// The HorizontalPodAutoscaler owns the replica count
+		dep.Spec.Replicas = nil
 	} else if dep.Spec.Replicas == nil || *dep.Spec.Replicas == 1 {
 		dep.Spec.Template.Spec.Hostname = dep.Name
 	}
@@ -669,11 +686,25 @@ func toDeployment(req router.Request, appInstance *v1.AppInstance, tag name.Refe
 }
 
 func ToDeployments(req router.Request, appInstance *v1.AppInstance, tag name.Reference, pullSecrets *PullSecrets, secrets *secrets.Interpolator) (result []kclient.Object, _ error) {
+	rollout, err := newRolloutRenderer(req, appInstance)
+	if err != nil {
+		return nil, err
+	}
+
+	podMonitors, err := podMonitorsEnabled(req)
+	if err != nil {
+		return nil, err
+	}
+
 	for _, entry := range typed.Sorted(appInstance.Status.AppSpec.Containers) {
 		if ports.IsLinked(appInstance, entry.Key) {
 			continue
 		}
-		dep, err := toDeployment(req, appInstance, tag, entry.Key, entry.Value, pullSecrets, secrets)
+		if err := entry.Value.Autoscale.Validate(entry.Value.Scale); err != nil {
+			return nil, fmt.Errorf("container [%s]: %w: %w", entry.Key, appdefinition.ErrInvalidInput, err)
+		}
+		app, appTag, container := rollout.primary(tag, entry.Key, entry.Value)
+		dep, err := toDeployment(req, app, appTag, entry.Key, container, pullSecrets, secrets)
 		if err != nil {
 			return nil, err
 		}
@@ -684,7 +715,42 @@ func ToDeployments(req router.Request, appInstance *v1.AppInstance, tag name.Ref
 		if perms := v1.FindPermission(dep.GetName(), appInstance.Spec.Permissions); perms.HasRules() {
 			result = append(result, toPermissions(perms, dep.GetLabels(), dep.GetAnnotations(), appInstance)...)
 		}
-		result = append(result, sa, dep, pdb.ToPodDisruptionBudget(dep))
+		podDisruptionBudget := pdb.ToPodDisruptionBudget(dep, app.Status.Scheduling[entry.Key].Availability)
+		hpa := toHorizontalPodAutoscaler(app, dep, container)
+		rollout.annotate(dep, app)
+		result = append(result, sa, dep, podDisruptionBudget)
+		if hpa != nil {
+			result = append(result, hpa)
+		}
+		if podMonitor := toPodMonitor(dep, container); podMonitors && podMonitor != nil {
+			result = append(result, podMonitor)
+		}
+
+		if rollout.isRolloutContainer(entry.Key) {
+			next, err := toDeployment(req, appInstance, tag, entry.Key, entry.Value, pullSecrets, secrets)
+			if err != nil {
+				return nil, err
+			}
+			next = rollout.toNextDeployment(next, entry.Value)
+			result = append(result, next, pdb.ToPodDisruptionBudget(next, nil))
+		}
+	}
+
+	for _, entry := range rollout.removedContainers() {
+		// Containers that were removed keep running until the new revision is promoted
+		if ports.IsLinked(rollout.previous, entry.Key) {
+			continue
+		}
+		dep, err := toDeployment(req, rollout.previous, rollout.previousTag, entry.Key, entry.Value, pullSecrets, secrets)
+		if err != nil {
+			return nil, err
+		}
+		sa, err := toServiceAccount(req, dep.GetName(), dep.GetLabels(), dep.GetAnnotations(), appInstance)
+		if err != nil {
+			return nil, err
+		}
+		rollout.annotate(dep, rollout.previous)
+		result = append(result, sa, dep)
 	}
 	return result, nil
 }
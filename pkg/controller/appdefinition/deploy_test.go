@@ -64,6 +64,18 @@ func TestDeploySpecScale(t *testing.T) {
 	tester.DefaultTest(t, scheme.Scheme, "testdata/deployspec/scale", DeploySpec)
 }
 
+func TestDeploySpecAutoscale(t *testing.T) {
+	tester.DefaultTest(t, scheme.Scheme, "testdata/deployspec/autoscale", DeploySpec)
+}
+
+func TestDeploySpecPodMonitor(t *testing.T) {
+	tester.DefaultTest(t, scheme.Scheme, "testdata/deployspec/podmonitor", DeploySpec)
+}
+
+func TestDeploySpecAvailability(t *testing.T) {
+	tester.DefaultTest(t, scheme.Scheme, "testdata/deployspec/availability", DeploySpec)
+}
+
 func TestDeploySpecStop(t *testing.T) {
 	tester.DefaultTest(t, scheme.Scheme, "testdata/deployspec/stop", DeploySpec)
 }
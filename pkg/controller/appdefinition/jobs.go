@@ -1,6 +1,7 @@
 package appdefinition
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 
@@ -89,6 +90,8 @@ func toJob(req router.Request, appInstance *v1.AppInstance, pullSecrets *PullSec
 
 	jobStatus := appInstance.Status.AppStatus.Jobs[name]
 	jobStatus.Skipped = !jobs.ShouldRunForEvent(jobEventName, container)
+	jobStatus.Event = jobEventName
+	jobStatus.EventGeneration = appInstance.Generation
 	if appInstance.Status.AppStatus.Jobs == nil {
 		appInstance.Status.AppStatus.Jobs = make(map[string]v1.JobStatus, len(appInstance.Status.AppSpec.Jobs))
 	}
@@ -98,6 +101,19 @@ func toJob(req router.Request, appInstance *v1.AppInstance, pullSecrets *PullSec
 		return nil, nil
 	}
 
+	dependencies := container.Dependencies
+	if jobEventName == "post-update" {
+		dependencies = withPostUpdateDependencies(appInstance, dependencies)
+	}
+
+	if err := container.ValidateJobSettings(); err != nil {
+		return nil, fmt.Errorf("job [%s]: %w", name, err)
+	}
+	deadline, err := container.GetDeadline()
+	if err != nil {
+		return nil, fmt.Errorf("job [%s]: %w", name, err)
+	}
+
 	containers, initContainers := toContainers(appInstance, tag, name, container, interpolator)
 
 	containers = append(containers, corev1.Container{
@@ -124,6 +140,8 @@ func toJob(req router.Request, appInstance *v1.AppInstance, pullSecrets *PullSec
 	}
 
 	jobSpec := batchv1.JobSpec{
+		ActiveDeadlineSeconds: deadline,
+		BackoffLimit:          container.Retries,
 		Template: corev1.PodTemplateSpec{
 			ObjectMeta: metav1.ObjectMeta{
 				Labels: jobLabels(appInstance, container, name,
@@ -159,13 +177,15 @@ func toJob(req router.Request, appInstance *v1.AppInstance, pullSecrets *PullSec
 	interpolator.AddMissingAnnotations(appInstance.GetStopped(), baseAnnotations)
 
 	if container.Schedule == "" {
-		jobSpec.BackoffLimit = &[]int32{1000}[0]
+		if jobSpec.BackoffLimit == nil {
+			jobSpec.BackoffLimit = &[]int32{1000}[0]
+		}
 		job := &batchv1.Job{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:        name,
 				Namespace:   appInstance.Status.Namespace,
 				Labels:      jobSpec.Template.Labels,
-				Annotations: labels.Merge(getDependencyAnnotations(appInstance, name, container.Dependencies), baseAnnotations),
+				Annotations: labels.Merge(getDependencyAnnotations(appInstance, name, dependencies), baseAnnotations),
 			},
 			Spec: jobSpec,
 		}
@@ -185,12 +205,12 @@ func toJob(req router.Request, appInstance *v1.AppInstance, pullSecrets *PullSec
 			Name:        name,
 			Namespace:   appInstance.Status.Namespace,
 			Labels:      jobSpec.Template.Labels,
-			Annotations: labels.Merge(getDependencyAnnotations(appInstance, name, container.Dependencies), baseAnnotations),
+			Annotations: labels.Merge(getDependencyAnnotations(appInstance, name, dependencies), baseAnnotations),
 		},
 		Spec: batchv1.CronJobSpec{
 			FailedJobsHistoryLimit:     &[]int32{3}[0],
 			SuccessfulJobsHistoryLimit: &[]int32{1}[0],
-			ConcurrencyPolicy:          batchv1.ReplaceConcurrent,
+			ConcurrencyPolicy:          toConcurrencyPolicy(container.Concurrency),
 			Schedule:                   toCronJobSchedule(container.Schedule),
 			JobTemplate: batchv1.JobTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
@@ -200,10 +220,29 @@ func toJob(req router.Request, appInstance *v1.AppInstance, pullSecrets *PullSec
 			},
 		},
 	}
+	if container.History != nil {
+		if container.History.Successful != nil {
+			cronJob.Spec.SuccessfulJobsHistoryLimit = container.History.Successful
+		}
+		if container.History.Failed != nil {
+			cronJob.Spec.FailedJobsHistoryLimit = container.History.Failed
+		}
+	}
 	cronJob.Annotations[labels.AcornAppGeneration] = strconv.FormatInt(appInstance.Generation, 10)
 	return cronJob, nil
 }
 
+func toConcurrencyPolicy(policy v1.ConcurrencyPolicy) batchv1.ConcurrencyPolicy {
+	switch policy {
+	case v1.ConcurrencyAllow:
+		return batchv1.AllowConcurrent
+	case v1.ConcurrencyForbid:
+		return batchv1.ForbidConcurrent
+	default:
+		return batchv1.ReplaceConcurrent
+	}
+}
+
 func toCronJobSchedule(schedule string) string {
 	switch strings.TrimSpace(schedule) {
 	case "year":
@@ -16,6 +16,9 @@ func ParseAppImage(req router.Request, resp router.Response) error {
 		return nil
 	}
 
+	_, span := startSpan(req, "parse app image")
+	defer span.End()
+
 	appDef, err := appdefinition.FromAppImage(&appImage)
 	if err != nil {
 		status.Error(err)
@@ -0,0 +1,73 @@
+package appdefinition
+
+import (
+	"github.com/acorn-io/baaah/pkg/router"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/config"
+	appsv1 "k8s.io/api/apps/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const podMonitorCRDName = "podmonitors.monitoring.coreos.com"
+
+var podMonitorGVK = schema.GroupVersionKind{
+	Group:   "monitoring.coreos.com",
+	Version: "v1",
+	Kind:    "PodMonitor",
+}
+
+// podMonitorsEnabled returns true if PodMonitors were enabled in the config and the Prometheus Operator's
+// PodMonitor CRD is installed
+func podMonitorsEnabled(req router.Request) (bool, error) {
+	cfg, err := config.Get(req.Ctx, req.Client)
+	if err != nil {
+		return false, err
+	}
+	if !*cfg.PrometheusPodMonitors {
+		return false, nil
+	}
+
+	err = req.Client.Get(req.Ctx, router.Key("", podMonitorCRDName), &apiextensionsv1.CustomResourceDefinition{})
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// toPodMonitor returns a PodMonitor that scrapes the metrics endpoint the container declares from all the pods of
+// its deployment, or nil if the container doesn't declare one
+func toPodMonitor(dep *appsv1.Deployment, container v1.Container) kclient.Object {
+	if container.Metrics.Path == "" || container.Metrics.Port == 0 {
+		return nil
+	}
+
+	matchLabels := map[string]any{}
+	for k, v := range dep.Spec.Selector.MatchLabels {
+		matchLabels[k] = v
+	}
+
+	podMonitor := &unstructured.Unstructured{
+		Object: map[string]any{
+			"spec": map[string]any{
+				"selector": map[string]any{
+					"matchLabels": matchLabels,
+				},
+				"podMetricsEndpoints": []any{
+					map[string]any{
+						"targetPort": int64(container.Metrics.Port),
+						"path":       container.Metrics.Path,
+					},
+				},
+			},
+		},
+	}
+	podMonitor.SetGroupVersionKind(podMonitorGVK)
+	podMonitor.SetName(dep.Name)
+	podMonitor.SetNamespace(dep.Namespace)
+	podMonitor.SetLabels(dep.Labels)
+	return podMonitor
+}
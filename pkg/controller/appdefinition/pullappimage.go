@@ -13,9 +13,11 @@ import (
 	"github.com/acorn-io/runtime/pkg/event"
 	"github.com/acorn-io/runtime/pkg/images"
 	"github.com/acorn-io/runtime/pkg/tags"
+	"github.com/acorn-io/runtime/pkg/tracing"
 	imagename "github.com/google/go-containerregistry/pkg/name"
 	"github.com/google/go-containerregistry/pkg/v1/remote"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -101,7 +103,9 @@ func pullAppImage(transport http.RoundTripper, client pullClient) router.Handler
 			recordPullEvent(req.Ctx, client.recorder, client.now(), req.Object, autoUpgradeOn, err, previousImage, *targetImage)
 		}()
 
-		targetImage, err = client.pull(req.Ctx, req.Client, appInstance.Namespace, resolved, "", remote.WithTransport(transport))
+		ctx, span := startSpan(req, "pull app image", attribute.String("acorn.image", resolved))
+		targetImage, err = client.pull(ctx, req.Client, appInstance.Namespace, resolved, "", remote.WithTransport(transport))
+		tracing.End(span, err)
 		if err != nil {
 			cond.Error(err)
 			return nil
@@ -110,6 +114,7 @@ func pullAppImage(transport http.RoundTripper, client pullClient) router.Handler
 		appInstance.Status.AvailableAppImage = ""
 		appInstance.Status.ConfirmUpgradeAppImage = ""
 		appInstance.Status.AppImage = *targetImage
+		startRollout(appInstance, previousImage, metav1.NewTime(client.now().Time))
 
 		cond.Success()
 		return nil
@@ -13,6 +13,7 @@ import (
 
 type PullSecrets struct {
 	objects  []kclient.Object
+	images   map[string][]string
 	keychain authn.Keychain
 	app      *v1.AppInstance
 	errs     []error
@@ -64,13 +65,30 @@ func (p *PullSecrets) ForContainer(containerName string, containers []corev1.Con
 	}
 
 	secretName := name.SafeConcatName(containerName, "pull", p.app.ShortID())
+
+	// During a rollout two revisions of the same container share the pull secret, so it must cover the images of both
+	previousImages, exists := p.images[secretName]
+	images = append(previousImages, images...)
+
 	secret, err := pullsecret.ForImages(secretName, p.app.Status.Namespace, p.keychain, images...)
 	if err != nil {
 		p.errs = append(p.errs, err)
 		return nil
 	}
 
-	p.objects = append(p.objects, secret)
+	if p.images == nil {
+		p.images = map[string][]string{}
+	}
+	p.images[secretName] = images
+	if exists {
+		for i, obj := range p.objects {
+			if obj.GetName() == secretName {
+				p.objects[i] = secret
+			}
+		}
+	} else {
+		p.objects = append(p.objects, secret)
+	}
 	return []corev1.LocalObjectReference{
 		{
 			Name: secretName,
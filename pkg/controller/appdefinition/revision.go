@@ -0,0 +1,34 @@
+package appdefinition
+
+import (
+	"github.com/acorn-io/baaah/pkg/router"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RecordRevision appends the deployed image and configuration of the app to its revision history if it differs
+// from the latest revision. Only the last v1.DefaultRevisionHistoryLimit revisions are kept.
+func RecordRevision(req router.Request, resp router.Response) error {
+	app := req.Object.(*v1.AppInstance)
+	if app.Status.GetDevMode() || app.Status.AppImage.Digest == "" || !app.Status.Condition(v1.AppInstanceConditionPulled).Success {
+		// Wait until the requested image has been pulled so the revision doesn't pair the new configuration with the previous image
+		return nil
+	}
+
+	rev := v1.NewAppRevision(app)
+	latest, ok := app.Status.GetRevision(0)
+	if ok && latest.Equivalent(rev) {
+		return nil
+	}
+
+	rev.Revision = latest.Revision + 1
+	rev.UpdatedBy = app.Annotations[labels.AcornUpdatedBy]
+	rev.Created = metav1.Now()
+
+	app.Status.Revisions = append(app.Status.Revisions, rev)
+	if len(app.Status.Revisions) > v1.DefaultRevisionHistoryLimit {
+		app.Status.Revisions = app.Status.Revisions[len(app.Status.Revisions)-v1.DefaultRevisionHistoryLimit:]
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package appdefinition
+
+import (
+	"testing"
+
+	"github.com/acorn-io/baaah/pkg/router/tester"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRecordRevision(t *testing.T) {
+	app := &v1.AppInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "app-name",
+			Namespace:   "app-namespace",
+			Annotations: map[string]string{labels.AcornUpdatedBy: "alice"},
+		},
+		Spec: v1.AppInstanceSpec{
+			Image: "app:v1",
+		},
+		Status: v1.AppInstanceStatus{
+			AppImage: v1.AppImage{ID: "app:v1", Digest: "sha256:v1"},
+			Conditions: []v1.Condition{
+				{Type: v1.AppInstanceConditionPulled, Success: true},
+			},
+		},
+	}
+
+	record := func() {
+		t.Helper()
+		req := tester.NewRequest(t, scheme.Scheme, app)
+		if err := RecordRevision(req, &tester.Response{Client: req.Client.(*tester.Client)}); err != nil {
+			t.Fatal(err)
+		}
+		app = req.Object.(*v1.AppInstance)
+	}
+
+	record()
+	if assert.Len(t, app.Status.Revisions, 1) {
+		assert.Equal(t, int64(1), app.Status.Revisions[0].Revision)
+		assert.Equal(t, "sha256:v1", app.Status.Revisions[0].ImageDigest)
+		assert.Equal(t, "alice", app.Status.Revisions[0].UpdatedBy)
+	}
+
+	// Nothing changed, so no new revision
+	record()
+	assert.Len(t, app.Status.Revisions, 1)
+
+	// Changed configuration results in a new revision
+	app.Spec.DeployArgs = v1.GenericMap{"replicas": 2}
+	record()
+	if assert.Len(t, app.Status.Revisions, 2) {
+		assert.Equal(t, int64(2), app.Status.Revisions[1].Revision)
+	}
+
+	// Only the latest revisions are kept
+	for i := 0; i < v1.DefaultRevisionHistoryLimit; i++ {
+		app.Spec.DeployArgs = v1.GenericMap{"replicas": i + 3}
+		record()
+	}
+	assert.Len(t, app.Status.Revisions, v1.DefaultRevisionHistoryLimit)
+	assert.Equal(t, int64(v1.DefaultRevisionHistoryLimit+2), app.Status.Revisions[v1.DefaultRevisionHistoryLimit-1].Revision)
+
+	// An image that failed to pull is not recorded
+	app.Spec.Image = "app:v2"
+	app.Status.Conditions[0].Success = false
+	record()
+	assert.Equal(t, "app:v1", app.Status.Revisions[v1.DefaultRevisionHistoryLimit-1].Image)
+}
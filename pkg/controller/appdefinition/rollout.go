@@ -0,0 +1,422 @@
+package appdefinition
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/baaah/pkg/typed"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/condition"
+	"github.com/acorn-io/runtime/pkg/images"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/ports"
+	"github.com/acorn-io/runtime/pkg/publish"
+	"github.com/google/go-containerregistry/pkg/name"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierror "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	klabels "k8s.io/apimachinery/pkg/labels"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	rolloutNextSuffix = "-next"
+	// rolloutMaxRestarts is the number of restarts of a new revision container that is considered unhealthy
+	rolloutMaxRestarts = 3
+)
+
+// startRollout records the currently deployed revision so that it keeps serving traffic while a new app image
+// is rolled out with the blueGreen or canary strategy.
+func startRollout(appInstance *v1.AppInstance, previousImage v1.AppImage, now metav1.Time) {
+	strategy := appInstance.Spec.Rollout.GetType()
+	if strategy == v1.RolloutStrategyRolling ||
+		appInstance.Status.GetDevMode() ||
+		previousImage.ID == "" ||
+		previousImage.Digest == appInstance.Status.AppImage.Digest {
+		return
+	}
+
+	previous := &v1.RolloutRevision{
+		AppImage: previousImage,
+		AppSpec:  appInstance.Status.AppSpec,
+	}
+	if appInstance.Status.Rollout.IsActive() {
+		// The stable revision is still the one from before the interrupted rollout
+		previous = appInstance.Status.Rollout.Previous
+	}
+
+	if previous.AppImage.Digest == appInstance.Status.AppImage.Digest {
+		// Going back to the revision that is still serving traffic, so there is nothing to roll out
+		appInstance.Status.Rollout = nil
+		return
+	}
+
+	appInstance.Status.Rollout = &v1.RolloutStatus{
+		Strategy:      strategy,
+		Phase:         v1.RolloutPhaseProgressing,
+		Revision:      appInstance.Status.AppImage.Digest,
+		StepStartTime: now,
+		Previous:      previous,
+	}
+	if steps := appInstance.Spec.Rollout.GetSteps(); strategy == v1.RolloutStrategyCanary && len(steps) > 0 {
+		appInstance.Status.Rollout.Weight = steps[0].Weight
+	}
+}
+
+// UpdateRollout moves an active rollout through its steps. Each step waits for the workloads of the new revision
+// to be ready, and the rollout is rolled back if they don't become ready in time or keep restarting.
+func UpdateRollout(req router.Request, resp router.Response) error {
+	appInstance := req.Object.(*v1.AppInstance)
+	rollout := appInstance.Status.Rollout
+	if rollout == nil {
+		return nil
+	}
+
+	cond := condition.Setter(appInstance, resp, v1.AppInstanceConditionRollout)
+	if !rollout.IsActive() {
+		cond.Success()
+		return nil
+	}
+
+	if appInstance.Spec.Rollout.GetType() == v1.RolloutStrategyRolling || appInstance.GetStopped() {
+		completeRollout(rollout)
+		cond.Success()
+		return nil
+	}
+
+	switch rollout.Phase {
+	case v1.RolloutPhaseRolledBack:
+		cond.Error(errors.New(rollout.Message))
+		return nil
+	case v1.RolloutPhasePromoting:
+		ready, err := rolloutPromoted(req, appInstance)
+		if err != nil {
+			return err
+		}
+		if ready {
+			completeRollout(rollout)
+			cond.Success()
+			return nil
+		}
+		rollout.Message = fmt.Sprintf("promoting revision %s", shortDigest(rollout.Revision))
+		cond.Unknown(rollout.Message)
+		return nil
+	}
+
+	ready, unhealthy, err := rolloutNextReady(req, appInstance)
+	if err != nil {
+		return err
+	}
+
+	if unhealthy == "" && rollout.Strategy == v1.RolloutStrategyCanary {
+		// Without weighted routing the new revision gets no traffic, and its steps would pass without it being tested
+		weighted, err := publish.WeightedRoutingSupported(req.Ctx, req.Client)
+		if err != nil {
+			return err
+		}
+		if !weighted {
+			unhealthy = "the ingress controller can not split traffic by weight, use the blueGreen strategy instead of canary"
+		}
+	}
+
+	if unhealthy == "" && !ready && time.Since(rollout.StepStartTime.Time) > appInstance.Spec.Rollout.GetProgressDeadline() {
+		unhealthy = fmt.Sprintf("step did not become ready within %v", appInstance.Spec.Rollout.GetProgressDeadline())
+	}
+
+	if unhealthy != "" {
+		rollout.Phase = v1.RolloutPhaseRolledBack
+		rollout.Message = fmt.Sprintf("rolled back revision %s: %s", shortDigest(rollout.Revision), unhealthy)
+		rollout.Weight = 0
+		cond.Error(errors.New(rollout.Message))
+		return nil
+	}
+
+	if !ready {
+		rollout.Message = fmt.Sprintf("waiting for revision %s to be ready%s", shortDigest(rollout.Revision), stepDescription(appInstance))
+		cond.Unknown(rollout.Message)
+		resp.RetryAfter(time.Until(rollout.StepStartTime.Add(appInstance.Spec.Rollout.GetProgressDeadline())))
+		return nil
+	}
+
+	if rollout.StepReadyTime.IsZero() {
+		rollout.StepReadyTime = metav1.Now()
+	}
+
+	steps := appInstance.Spec.Rollout.GetSteps()
+	if int(rollout.Step) < len(steps) {
+		if remaining := steps[rollout.Step].GetPause() - time.Since(rollout.StepReadyTime.Time); remaining > 0 {
+			rollout.Message = fmt.Sprintf("revision %s is ready%s, pausing for %v", shortDigest(rollout.Revision), stepDescription(appInstance), remaining.Round(time.Second))
+			cond.Unknown(rollout.Message)
+			resp.RetryAfter(remaining)
+			return nil
+		}
+	}
+
+	rollout.Step++
+	rollout.StepStartTime = metav1.Now()
+	rollout.StepReadyTime = metav1.Time{}
+	if rollout.Strategy == v1.RolloutStrategyCanary && int(rollout.Step) < len(steps) && steps[rollout.Step].Weight < 100 {
+		rollout.Weight = steps[rollout.Step].Weight
+		rollout.Message = fmt.Sprintf("shifting traffic to revision %s%s", shortDigest(rollout.Revision), stepDescription(appInstance))
+	} else {
+		rollout.Phase = v1.RolloutPhasePromoting
+		rollout.Weight = 100
+		rollout.Message = fmt.Sprintf("promoting revision %s", shortDigest(rollout.Revision))
+	}
+	cond.Unknown(rollout.Message)
+	return nil
+}
+
+func completeRollout(rollout *v1.RolloutStatus) {
+	rollout.Phase = v1.RolloutPhaseCompleted
+	rollout.Previous = nil
+	rollout.Weight = 100
+	rollout.Message = ""
+}
+
+func stepDescription(appInstance *v1.AppInstance) string {
+	rollout := appInstance.Status.Rollout
+	if rollout.Strategy != v1.RolloutStrategyCanary {
+		return ""
+	}
+	return fmt.Sprintf(" (step %d/%d at %d%%)", rollout.Step+1, len(appInstance.Spec.Rollout.GetSteps()), rollout.Weight)
+}
+
+func shortDigest(digest string) string {
+	digest = strings.TrimPrefix(digest, "sha256:")
+	if len(digest) > 12 {
+		return digest[:12]
+	}
+	return digest
+}
+
+// rolloutContainers returns the containers that run both the previous and the new revision during a rollout
+func rolloutContainers(appInstance *v1.AppInstance) (result []string) {
+	rollout := appInstance.Status.Rollout
+	if !rollout.IsProgressing() {
+		return nil
+	}
+	for _, entry := range typed.Sorted(appInstance.Status.AppSpec.Containers) {
+		if _, ok := rollout.Previous.AppSpec.Containers[entry.Key]; !ok {
+			continue
+		}
+		if ports.IsLinked(appInstance, entry.Key) || isStateful(appInstance, entry.Value) {
+			continue
+		}
+		result = append(result, entry.Key)
+	}
+	return
+}
+
+func rolloutNextReady(req router.Request, appInstance *v1.AppInstance) (ready bool, unhealthy string, _ error) {
+	ready = true
+	for _, containerName := range rolloutContainers(appInstance) {
+		dep := &appsv1.Deployment{}
+		err := req.Get(dep, appInstance.Status.Namespace, containerName+rolloutNextSuffix)
+		if apierror.IsNotFound(err) {
+			ready = false
+			continue
+		} else if err != nil {
+			return false, "", err
+		}
+
+		for _, cond := range dep.Status.Conditions {
+			if cond.Type == appsv1.DeploymentProgressing && cond.Status == corev1.ConditionFalse {
+				return false, fmt.Sprintf("container %s: %s", containerName, cond.Message), nil
+			}
+		}
+
+		pods := &corev1.PodList{}
+		if err := req.List(pods, &kclient.ListOptions{
+			Namespace:     dep.Namespace,
+			LabelSelector: klabels.SelectorFromSet(dep.Spec.Selector.MatchLabels),
+		}); err != nil {
+			return false, "", err
+		}
+		for _, pod := range pods.Items {
+			for _, status := range pod.Status.ContainerStatuses {
+				if status.RestartCount >= rolloutMaxRestarts {
+					return false, fmt.Sprintf("container %s restarted %d times", containerName, status.RestartCount), nil
+				}
+			}
+		}
+
+		if !isRevisionReady(dep, appInstance.Status.Rollout.Revision) {
+			ready = false
+		}
+	}
+	return ready, "", nil
+}
+
+func rolloutPromoted(req router.Request, appInstance *v1.AppInstance) (bool, error) {
+	for _, containerName := range rolloutContainers(appInstance) {
+		dep := &appsv1.Deployment{}
+		if err := req.Get(dep, appInstance.Status.Namespace, containerName); apierror.IsNotFound(err) {
+			return false, nil
+		} else if err != nil {
+			return false, err
+		}
+		if !isRevisionReady(dep, appInstance.Status.Rollout.Revision) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func isRevisionReady(dep *appsv1.Deployment, revision string) bool {
+	replicas := dep.Status.Replicas
+	if dep.Spec.Replicas != nil {
+		replicas = *dep.Spec.Replicas
+	}
+	return dep.Annotations[labels.AcornRolloutRevision] == revision &&
+		dep.Status.ObservedGeneration >= dep.Generation &&
+		dep.Status.UpdatedReplicas == replicas &&
+		dep.Status.ReadyReplicas == replicas &&
+		dep.Status.Replicas == replicas
+}
+
+type rolloutRenderer struct {
+	app         *v1.AppInstance
+	previous    *v1.AppInstance
+	previousTag name.Reference
+}
+
+func newRolloutRenderer(req router.Request, appInstance *v1.AppInstance) (*rolloutRenderer, error) {
+	result := &rolloutRenderer{
+		app: appInstance,
+	}
+	rollout := appInstance.Status.Rollout
+	if !rollout.IsActive() {
+		return result, nil
+	}
+
+	tag, err := images.GetRuntimePullableImageReference(req.Ctx, req.Client, appInstance.Namespace, rollout.Previous.AppImage.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	result.previous = appInstance.DeepCopy()
+	result.previous.Status.AppImage = rollout.Previous.AppImage
+	result.previous.Status.AppSpec = rollout.Previous.AppSpec
+	result.previousTag = tag
+	return result, nil
+}
+
+// primary returns the app and container that should be rendered under the container's own name
+func (r *rolloutRenderer) primary(tag name.Reference, containerName string, container v1.Container) (*v1.AppInstance, name.Reference, v1.Container) {
+	if r.previous == nil || r.app.Status.Rollout.Phase == v1.RolloutPhasePromoting {
+		return r.app, tag, container
+	}
+	if previousContainer, ok := r.previous.Status.AppSpec.Containers[containerName]; ok && !isStateful(r.app, container) {
+		return r.previous, r.previousTag, previousContainer
+	}
+	return r.app, tag, container
+}
+
+// removedContainers returns the containers of the previous revision that no longer exist in the new revision
+func (r *rolloutRenderer) removedContainers() (result []typed.Entry[string, v1.Container]) {
+	if r.previous == nil || r.app.Status.Rollout.Phase == v1.RolloutPhasePromoting {
+		return nil
+	}
+	for _, entry := range typed.Sorted(r.previous.Status.AppSpec.Containers) {
+		if _, ok := r.app.Status.AppSpec.Containers[entry.Key]; !ok {
+			result = append(result, entry)
+		}
+	}
+	return
+}
+
+// annotate records the revision a deployment is running. The annotations are copied because they are shared with
+// the objects derived from the deployment.
+func (r *rolloutRenderer) annotate(dep *appsv1.Deployment, app *v1.AppInstance) {
+	if r.previous != nil {
+		dep.Annotations = typed.Concat(dep.Annotations, map[string]string{
+			labels.AcornRolloutRevision: app.Status.AppImage.Digest,
+		})
+	}
+}
+
+func (r *rolloutRenderer) isRolloutContainer(containerName string) bool {
+	for _, name := range rolloutContainers(r.app) {
+		if name == containerName {
+			return true
+		}
+	}
+	return false
+}
+
+// toNextDeployment turns the deployment of the new revision into one that runs next to the previous revision.
+// The pods run as a separate container so that they are not selected by the deployment, budget, or services of
+// the previous revision. With the canary strategy the container's service routes a share of the
+// published traffic to them, see canaryServices, and they run a matching share of the replicas.
+func (r *rolloutRenderer) toNextDeployment(dep *appsv1.Deployment, container v1.Container) *appsv1.Deployment {
+	rollout := r.app.Status.Rollout
+	containerName := dep.Name
+	dep.Name = containerName + rolloutNextSuffix
+	nextLabels := map[string]string{
+		labels.AcornContainerName: dep.Name,
+		labels.AcornRolloutNext:   "true",
+	}
+	dep.Labels = labels.Merge(dep.Labels, nextLabels)
+	dep.Spec.Selector.MatchLabels = labels.Merge(dep.Spec.Selector.MatchLabels, nextLabels)
+	dep.Spec.Template.Labels = labels.Merge(dep.Spec.Template.Labels, nextLabels)
+	for i, c := range dep.Spec.Template.Spec.Containers {
+		if c.Name == containerName {
+			dep.Spec.Template.Spec.Containers[i].Name = dep.Name
+		}
+	}
+	if dep.Spec.Template.Spec.Hostname != "" {
+		dep.Spec.Template.Spec.Hostname = dep.Name
+	}
+	dep.Annotations = typed.Concat(dep.Annotations, map[string]string{
+		labels.AcornRolloutRevision: rollout.Revision,
+	})
+
+	replicas := desiredReplicas(container)
+	if rollout.Strategy == v1.RolloutStrategyCanary && rollout.Phase == v1.RolloutPhaseProgressing {
+		replicas = (replicas*rollout.Weight + 99) / 100
+		if replicas < 1 {
+			replicas = 1
+		}
+	}
+	dep.Spec.Replicas = &replicas
+	return dep
+}
+
+// canaryServices points the services of the containers that are being rolled out with the canary strategy at the
+// pods of the new revision, with the weight of the current step.
+func canaryServices(appInstance *v1.AppInstance, objs []kclient.Object) {
+	rollout := appInstance.Status.Rollout
+	if rollout == nil || rollout.Strategy != v1.RolloutStrategyCanary || rollout.Phase != v1.RolloutPhaseProgressing {
+		return
+	}
+	containers := rolloutContainers(appInstance)
+	for _, obj := range objs {
+		svc, ok := obj.(*v1.ServiceInstance)
+		if !ok || svc.Spec.Container == "" {
+			continue
+		}
+		for _, containerName := range containers {
+			if svc.Spec.Container == containerName {
+				svc.Spec.Canary = &v1.ServiceCanary{
+					Container: containerName + rolloutNextSuffix,
+					Weight:    rollout.Weight,
+				}
+			}
+		}
+	}
+}
+
+func desiredReplicas(container v1.Container) int32 {
+	if container.Autoscale != nil {
+		return container.Autoscale.GetMinReplicas(container.Scale)
+	}
+	if container.Scale != nil && *container.Scale > 0 {
+		return *container.Scale
+	}
+	return 1
+}
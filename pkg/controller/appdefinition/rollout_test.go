@@ -0,0 +1,116 @@
+package appdefinition
+
+import (
+	"testing"
+
+	"github.com/acorn-io/baaah/pkg/router/tester"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestDeploySpecRolloutCanary(t *testing.T) {
+	tester.DefaultTest(t, scheme.Scheme, "testdata/deployspec/rollout-canary", DeploySpec)
+}
+
+func invokeUpdateRollout(t *testing.T, path string) *v1.AppInstance {
+	t.Helper()
+
+	harness, input, err := tester.FromDir(scheme.Scheme, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := tester.NewRequest(t, harness.Scheme, input, harness.Existing...)
+	resp := &tester.Response{Client: req.Client.(*tester.Client)}
+	if err := UpdateRollout(req, resp); err != nil {
+		t.Fatal(err)
+	}
+	return req.Object.(*v1.AppInstance)
+}
+
+func TestUpdateRolloutAdvance(t *testing.T) {
+	app := invokeUpdateRollout(t, "testdata/rollout/advance")
+
+	assert.Equal(t, v1.RolloutPhaseProgressing, app.Status.Rollout.Phase)
+	assert.Equal(t, int32(1), app.Status.Rollout.Step)
+	assert.Equal(t, int32(50), app.Status.Rollout.Weight)
+	assert.NotNil(t, app.Status.Rollout.Previous)
+}
+
+func TestUpdateRolloutRollback(t *testing.T) {
+	app := invokeUpdateRollout(t, "testdata/rollout/rollback")
+
+	assert.Equal(t, v1.RolloutPhaseRolledBack, app.Status.Rollout.Phase)
+	assert.Equal(t, int32(0), app.Status.Rollout.Weight)
+	assert.Contains(t, app.Status.Rollout.Message, "restarted 4 times")
+	assert.False(t, app.Status.Rollout.IsProgressing())
+}
+
+func TestUpdateRolloutCanaryUnsupported(t *testing.T) {
+	app := invokeUpdateRollout(t, "testdata/rollout/canary-unsupported")
+
+	assert.Equal(t, v1.RolloutPhaseRolledBack, app.Status.Rollout.Phase)
+	assert.Equal(t, int32(0), app.Status.Rollout.Weight)
+	assert.Contains(t, app.Status.Rollout.Message, "can not split traffic by weight")
+}
+
+func TestStartRollout(t *testing.T) {
+	previous := v1.AppImage{ID: "old", Digest: "sha256:old"}
+	app := &v1.AppInstance{
+		Spec: v1.AppInstanceSpec{
+			Rollout: &v1.RolloutStrategy{Type: v1.RolloutStrategyBlueGreen},
+		},
+		Status: v1.AppInstanceStatus{
+			AppImage: v1.AppImage{ID: "new", Digest: "sha256:new"},
+		},
+	}
+
+	startRollout(app, previous, metav1.Now())
+	if assert.NotNil(t, app.Status.Rollout) {
+		assert.Equal(t, v1.RolloutPhaseProgressing, app.Status.Rollout.Phase)
+		assert.Equal(t, "sha256:new", app.Status.Rollout.Revision)
+		assert.Equal(t, previous, app.Status.Rollout.Previous.AppImage)
+	}
+
+	// Going back to the revision that is still serving traffic ends the rollout
+	app.Status.AppImage = previous
+	startRollout(app, v1.AppImage{ID: "new", Digest: "sha256:new"}, metav1.Now())
+	assert.Nil(t, app.Status.Rollout)
+
+	// The default rolling strategy never starts a rollout
+	app.Spec.Rollout = nil
+	app.Status.AppImage = v1.AppImage{ID: "new", Digest: "sha256:new"}
+	startRollout(app, previous, metav1.Now())
+	assert.Nil(t, app.Status.Rollout)
+}
+
+func TestCanaryServices(t *testing.T) {
+	web := v1.Container{Image: "web"}
+	app := &v1.AppInstance{
+		Status: v1.AppInstanceStatus{
+			AppSpec: v1.AppSpec{
+				Containers: map[string]v1.Container{"web": web, "api": web},
+			},
+			Rollout: &v1.RolloutStatus{
+				Strategy: v1.RolloutStrategyCanary,
+				Phase:    v1.RolloutPhaseProgressing,
+				Weight:   20,
+				Previous: &v1.RolloutRevision{
+					AppSpec: v1.AppSpec{
+						Containers: map[string]v1.Container{"web": web},
+					},
+				},
+			},
+		},
+	}
+	webService := &v1.ServiceInstance{Spec: v1.ServiceInstanceSpec{Container: "web"}}
+	apiService := &v1.ServiceInstance{Spec: v1.ServiceInstanceSpec{Container: "api"}}
+
+	canaryServices(app, []kclient.Object{webService, apiService})
+	assert.Equal(t, &v1.ServiceCanary{Container: "web-next", Weight: 20}, webService.Spec.Canary)
+	// Containers that are new in this revision have no previous revision to split the traffic with
+	assert.Nil(t, apiService.Spec.Canary)
+}
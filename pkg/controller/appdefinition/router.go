@@ -159,7 +159,7 @@ func toRouter(appInstance *v1.AppInstance, routerName string, router v1.Router)
 				Annotations: deploymentAnnotations,
 			},
 		},
-		pdb.ToPodDisruptionBudget(dep),
+		pdb.ToPodDisruptionBudget(dep, nil),
 	}, nil
 }
 
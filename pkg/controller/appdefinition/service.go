@@ -12,6 +12,7 @@ func addServices(req router.Request, app *v1.AppInstance, interpolator *secrets.
 	if err != nil {
 		return err
 	}
+	canaryServices(app, objs)
 	resp.Objects(objs...)
 	return nil
 }
@@ -0,0 +1,150 @@
+package appdefinition
+
+import (
+	"fmt"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/volume"
+	name2 "github.com/rancher/wrangler/pkg/name"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// snapshotBindName is the name of the PVC of a volume that is provisioned from a snapshot. The PVC can't reuse the
+// name of the volume because the data source of an existing PVC can't be changed.
+func snapshotBindName(volume, snapshot string) string {
+	return name2.SafeConcatName(volume, "snapshot", snapshot)
+}
+
+// restoreFromSnapshot makes the snapshot the data source of the PVC. A PVC can only be provisioned from a snapshot in
+// its own namespace, so a snapshot of a volume of another app is copied into the namespace of this app and the
+// objects that make the copy are returned.
+func restoreFromSnapshot(req router.Request, appInstance *v1.AppInstance, pvc *corev1.PersistentVolumeClaim, snapshotName string) ([]kclient.Object, error) {
+	if exists, err := useExistingPVC(req, pvc); err != nil {
+		return nil, err
+	} else if exists {
+		// The volume was already provisioned from the snapshot, the snapshot isn't needed anymore and may be deleted
+		return nil, nil
+	}
+
+	snapshot, err := volume.GetSnapshot(req.Ctx, req.Client, appInstance.Namespace, snapshotName)
+	if apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("no volume snapshot found with name %q in project %q", snapshotName, appInstance.Namespace)
+	} else if err != nil {
+		return nil, err
+	}
+
+	if !snapshot.Ready() {
+		return nil, fmt.Errorf("volume snapshot %s is not ready to use", snapshotName)
+	}
+
+	// the new volume must be at least as big as the volume the snapshot was taken of
+	if restoreSize := snapshot.Status.RestoreSize; restoreSize != nil && pvc.Spec.Resources.Requests.Storage().Cmp(*restoreSize) < 0 {
+		pvc.Spec.Resources.Requests[corev1.ResourceStorage] = *restoreSize
+	}
+
+	var (
+		dataSource = snapshot.Name
+		result     []kclient.Object
+	)
+	if snapshot.Namespace != appInstance.Status.Namespace {
+		dataSource, result, err = copySnapshot(req, appInstance, snapshot)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	group := volume.SnapshotGroup
+	pvc.Spec.DataSource = &corev1.TypedLocalObjectReference{
+		APIGroup: &group,
+		Kind:     volume.SnapshotGVK.Kind,
+		Name:     dataSource,
+	}
+	return result, nil
+}
+
+// copySnapshot returns the name of a snapshot in the namespace of the app that refers to the same storage snapshot as
+// the given snapshot, and the pre-provisioned VolumeSnapshotContent and VolumeSnapshot that create it
+func copySnapshot(req router.Request, appInstance *v1.AppInstance, snapshot *volume.Snapshot) (string, []kclient.Object, error) {
+	if snapshot.Status.BoundVolumeSnapshotContentName == nil {
+		return "", nil, fmt.Errorf("volume snapshot %s is not bound to a VolumeSnapshotContent", snapshot.Name)
+	}
+
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(volume.SnapshotContentGVK)
+	if err := req.Client.Get(req.Ctx, router.Key("", *snapshot.Status.BoundVolumeSnapshotContentName), u); err != nil {
+		return "", nil, err
+	}
+
+	var content volume.SnapshotContent
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &content); err != nil {
+		return "", nil, err
+	}
+	if content.Status == nil || content.Status.SnapshotHandle == nil {
+		return "", nil, fmt.Errorf("volume snapshot %s is not ready to use", snapshot.Name)
+	}
+
+	copyLabels := map[string]string{
+		labels.AcornManaged:      "true",
+		labels.AcornAppName:      appInstance.Name,
+		labels.AcornAppNamespace: appInstance.Namespace,
+	}
+	name := name2.SafeConcatName(snapshot.Name, "restore")
+
+	contentCopy, err := volume.ToUnstructured(&volume.SnapshotContent{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: volume.SnapshotContentGVK.GroupVersion().String(),
+			Kind:       volume.SnapshotContentGVK.Kind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name2.SafeConcatName(appInstance.Status.Namespace, name),
+			Labels: copyLabels,
+		},
+		Spec: volume.SnapshotContentSpec{
+			VolumeSnapshotRef: corev1.ObjectReference{
+				Namespace: appInstance.Status.Namespace,
+				Name:      name,
+			},
+			// The storage snapshot belongs to the snapshot that is copied and is deleted with it
+			DeletionPolicy:          "Retain",
+			Driver:                  content.Spec.Driver,
+			VolumeSnapshotClassName: content.Spec.VolumeSnapshotClassName,
+			Source: volume.SnapshotContentSource{
+				SnapshotHandle: content.Status.SnapshotHandle,
+			},
+		},
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	contentName := contentCopy.GetName()
+	snapshotCopy, err := volume.ToUnstructured(&volume.Snapshot{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: volume.SnapshotGVK.GroupVersion().String(),
+			Kind:       volume.SnapshotGVK.Kind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: appInstance.Status.Namespace,
+			Labels:    copyLabels,
+		},
+		Spec: volume.SnapshotSpec{
+			Source: volume.SnapshotSource{
+				VolumeSnapshotContentName: &contentName,
+			},
+			VolumeSnapshotClassName: content.Spec.VolumeSnapshotClassName,
+		},
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	return name, []kclient.Object{contentCopy, snapshotCopy}, nil
+}
@@ -0,0 +1,22 @@
+package appdefinition
+
+import (
+	"context"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// startSpan starts a span in the trace of the last change to the app, which the api-server records on the app. Spans
+// are only started until the change is deployed and the app is ready, so that later reconciliations of the app don't
+// keep adding to the trace.
+func startSpan(req router.Request, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	appInstance := req.Object.(*v1.AppInstance)
+	if appInstance.Status.Ready && appInstance.Status.ObservedGeneration == appInstance.Generation {
+		return req.Ctx, trace.SpanFromContext(context.Background())
+	}
+	return tracing.StartFromObject(req.Ctx, appInstance, name, attrs...)
+}
@@ -11,12 +11,14 @@ import (
 	"github.com/acorn-io/baaah/pkg/typed"
 	"github.com/acorn-io/baaah/pkg/uncached"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	adminv1 "github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/labels"
 	"github.com/acorn-io/runtime/pkg/publicname"
 	"github.com/acorn-io/runtime/pkg/secrets"
 	"github.com/acorn-io/runtime/pkg/volume"
 	name2 "github.com/rancher/wrangler/pkg/name"
 	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	klabels "k8s.io/apimachinery/pkg/labels"
@@ -188,17 +190,42 @@ func toPVCs(req router.Request, appInstance *v1.AppInstance) (result []kclient.O
 					pvc.Labels[labels.AcornVolumeClass] = volClass.Name
 				}
 			}
-			pvName, err := lookupExistingPV(req, appInstance, vol)
-			if err != nil {
-				return nil, err
-			}
-			pvc.Spec.VolumeName = pvName
 
 			if volumeRequest.Size == "" {
 				pvc.Spec.Resources.Requests[corev1.ResourceStorage] = *v1.DefaultSize
 			} else {
 				pvc.Spec.Resources.Requests[corev1.ResourceStorage] = *v1.MustParseResourceQuantity(volumeRequest.Size)
 			}
+
+			if volumeBinding.Snapshot != "" {
+				pvc.Name = snapshotBindName(vol, volumeBinding.Snapshot)
+				snapshotObjects, err := restoreFromSnapshot(req, appInstance, &pvc, volumeBinding.Snapshot)
+				if err != nil {
+					return nil, err
+				}
+				result = append(result, snapshotObjects...)
+			} else if volumeRequest.From != "" {
+				pvc.Name = cloneBindName(vol, volumeRequest.From)
+				cloneObjects, cloned, err := cloneVolume(req, appInstance, &pvc, volumeRequest.From, volumeClasses)
+				if err != nil {
+					return nil, err
+				}
+				result = append(result, cloneObjects...)
+				if !cloned {
+					// The PVC is created once the copy of the volume is done, so that it isn't provisioned empty
+					continue
+				}
+			} else {
+				pvName, err := lookupExistingPV(req, appInstance, vol)
+				if err != nil {
+					return nil, err
+				}
+				pvc.Spec.VolumeName = pvName
+			}
+		}
+
+		if err := checkVolumeResize(req, &pvc, volumeClasses, !bind || volumeBinding.Size != ""); err != nil {
+			return nil, err
 		}
 
 		result = append(result, &pvc)
@@ -206,6 +233,87 @@ func toPVCs(req router.Request, appInstance *v1.AppInstance) (result []kclient.O
 	return
 }
 
+// checkVolumeResize keeps the size of an existing PVC unless the requested size is an expansion that the storage class
+// of the PVC supports and the volume class allows. When a requested resize is not applied, the reason is recorded on
+// the PVC so that it is shown in the status of the volume.
+func checkVolumeResize(req router.Request, pvc *corev1.PersistentVolumeClaim, volumeClasses map[string]adminv1.ProjectVolumeClassInstance, sizeRequested bool) error {
+	existing := new(corev1.PersistentVolumeClaim)
+	if err := req.Get(existing, pvc.Namespace, pvc.Name); apierrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	current, ok := existing.Spec.Resources.Requests[corev1.ResourceStorage]
+	if !ok {
+		return nil
+	}
+	requested := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+	if requested.Cmp(current) == 0 {
+		return nil
+	}
+
+	// Until the resize is validated, keep the size of the existing PVC.
+	pvc.Spec.Resources.Requests[corev1.ResourceStorage] = current
+	volumeName := pvc.Labels[labels.AcornVolumeName]
+
+	switch {
+	case !sizeRequested:
+		// A bound volume without a size keeps the size it already has.
+		return nil
+	case requested.Cmp(current) < 0:
+		pvc.Annotations[labels.AcornVolumeResizeError] = fmt.Sprintf("volume %s can not be shrunk from %s to %s", volumeName, current.String(), requested.String())
+		return nil
+	case existing.Status.Phase != corev1.ClaimBound:
+		// Only bound volumes can be expanded, the resize is picked up once the volume is bound.
+		return nil
+	}
+
+	if volClass, ok := volumeClasses[pvc.Labels[labels.AcornVolumeClass]]; ok && volClass.Size.Max != "" && requested.Cmp(*v1.MustParseResourceQuantity(volClass.Size.Max)) > 0 {
+		pvc.Annotations[labels.AcornVolumeResizeError] = fmt.Sprintf("volume %s can not be resized to %s, it is greater than volume class %s maximum of %v", volumeName, requested.String(), volClass.Name, volClass.Size.Max)
+		return nil
+	}
+
+	if existing.Spec.StorageClassName == nil || *existing.Spec.StorageClassName == "" {
+		pvc.Annotations[labels.AcornVolumeResizeError] = fmt.Sprintf("volume %s can not be resized, it has no storage class", volumeName)
+		return nil
+	}
+
+	storageClass := new(storagev1.StorageClass)
+	if err := req.Get(storageClass, "", *existing.Spec.StorageClassName); apierrors.IsNotFound(err) {
+		pvc.Annotations[labels.AcornVolumeResizeError] = fmt.Sprintf("volume %s can not be resized, storage class %s doesn't exist", volumeName, *existing.Spec.StorageClassName)
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if storageClass.AllowVolumeExpansion == nil || !*storageClass.AllowVolumeExpansion {
+		pvc.Annotations[labels.AcornVolumeResizeError] = fmt.Sprintf("volume %s can not be resized, storage class %s does not allow volume expansion", volumeName, storageClass.Name)
+		return nil
+	}
+
+	pvc.Spec.Resources.Requests[corev1.ResourceStorage] = requested
+	return nil
+}
+
+// useExistingPVC keeps the data source, volume and size of the PVC if it already exists, because they can't be changed
+// once the PVC is provisioned. It returns true if the PVC exists.
+func useExistingPVC(req router.Request, pvc *corev1.PersistentVolumeClaim) (bool, error) {
+	existing := &corev1.PersistentVolumeClaim{}
+	if err := req.Get(existing, pvc.Namespace, pvc.Name); apierrors.IsNotFound(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	pvc.Spec.DataSource = existing.Spec.DataSource
+	pvc.Spec.VolumeName = existing.Spec.VolumeName
+	if size := existing.Spec.Resources.Requests.Storage(); pvc.Spec.Resources.Requests.Storage().Cmp(*size) < 0 {
+		pvc.Spec.Resources.Requests[corev1.ResourceStorage] = *size
+	}
+	return true, nil
+}
+
 func getPVForVolumeBinding(req router.Request, appInstance *v1.AppInstance, binding v1.VolumeBinding) (*corev1.PersistentVolume, error) {
 	pv := new(corev1.PersistentVolume)
 	if err := req.Client.Get(req.Ctx, kclient.ObjectKey{Name: binding.Volume}, pv); err != nil && !apierrors.IsNotFound(err) {
@@ -264,8 +372,12 @@ func bindName(volume string) string {
 }
 
 func toVolumeName(appInstance *v1.AppInstance, volume string) (string, bool) {
-	if _, bind := isBind(appInstance, volume); bind {
+	if binding, bind := isBind(appInstance, volume); bind {
 		return bindName(volume), true
+	} else if binding.Snapshot != "" {
+		return snapshotBindName(volume, binding.Snapshot), false
+	} else if source := cloneSource(appInstance, volume, binding); source != "" {
+		return cloneBindName(volume, source), false
 	}
 	return volume, false
 }
@@ -1,18 +1,24 @@
 package appdefinition
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
 
+	"github.com/acorn-io/baaah/pkg/router"
 	"github.com/acorn-io/baaah/pkg/router/tester"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/labels"
 	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/acorn-io/runtime/pkg/volume"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -22,10 +28,59 @@ func TestVolumeController(t *testing.T) {
 		t.Fatal(err)
 	}
 	for _, dir := range dirs {
-		tester.DefaultTest(t, scheme.Scheme, filepath.Join("testdata/volumes", dir.Name()), DeploySpec)
+		tester.DefaultTest(t, snapshotScheme(t), filepath.Join("testdata/volumes", dir.Name()), router.HandlerFunc(func(req router.Request, resp router.Response) error {
+			req.Client = clusterClient{Client: req.Client.(*tester.Client)}
+			return DeploySpec(req, resp)
+		}))
 	}
 }
 
+// snapshotScheme returns a scheme that also reads the CSI snapshot types, which acorn only uses as unstructured objects
+func snapshotScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+	s := runtime.NewScheme()
+	if err := scheme.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	s.AddKnownTypeWithName(volume.SnapshotGVK, &unstructured.Unstructured{})
+	s.AddKnownTypeWithName(volume.SnapshotListGVK, &unstructured.UnstructuredList{})
+	s.AddKnownTypeWithName(volume.SnapshotContentGVK, &unstructured.Unstructured{})
+	return s
+}
+
+// clusterClient lists the unstructured objects, such as the snapshots, of all namespaces if no namespace is given, like
+// the client of a cluster does. The client of the tester only lists the objects without a namespace then.
+type clusterClient struct {
+	*tester.Client
+}
+
+func (c clusterClient) List(ctx context.Context, list kclient.ObjectList, opts ...kclient.ListOption) error {
+	listOpts := &kclient.ListOptions{}
+	listOpts.ApplyOptions(opts)
+	if _, ok := list.(*unstructured.UnstructuredList); !ok || listOpts.Namespace != "" {
+		return c.Client.List(ctx, list, opts...)
+	}
+
+	namespaces := map[string]bool{}
+	for _, obj := range append(append(c.Objects, c.Created...), c.Updated...) {
+		namespaces[obj.GetNamespace()] = true
+	}
+
+	var items []runtime.Object
+	for namespace := range namespaces {
+		nsList := list.DeepCopyObject().(kclient.ObjectList)
+		if err := c.Client.List(ctx, nsList, append(opts, kclient.InNamespace(namespace))...); err != nil {
+			return err
+		}
+		nsItems, err := meta.ExtractList(nsList)
+		if err != nil {
+			return err
+		}
+		items = append(items, nsItems...)
+	}
+	return meta.SetList(list, items)
+}
+
 func TestVolumeLabelsAnnotations(t *testing.T) {
 	h := tester.Harness{
 		Scheme: scheme.Scheme,
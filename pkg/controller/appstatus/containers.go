@@ -10,6 +10,7 @@ import (
 	"github.com/acorn-io/runtime/pkg/labels"
 	"github.com/acorn-io/runtime/pkg/ports"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 	apierror "k8s.io/apimachinery/pkg/api/errors"
 	klabels "k8s.io/apimachinery/pkg/labels"
@@ -64,6 +65,13 @@ func (a *appStatusRenderer) readContainers() error {
 			}
 		}
 
+		if a.app.Status.AppSpec.Containers[containerName].Autoscale != nil {
+			cs.Autoscale, err = a.getAutoscaleStatus(containerName)
+			if err != nil {
+				return err
+			}
+		}
+
 		if cs.LinkOverride != "" {
 			var err error
 			cs.UpToDate = true
@@ -111,6 +119,23 @@ func (a *appStatusRenderer) readContainers() error {
 	return nil
 }
 
+func (a *appStatusRenderer) getAutoscaleStatus(containerName string) (*v1.AutoscaleStatus, error) {
+	hpa := autoscalingv2.HorizontalPodAutoscaler{}
+	err := a.c.Get(a.ctx, router.Key(a.app.Status.Namespace, containerName), &hpa)
+	if apierror.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &v1.AutoscaleStatus{
+		MinReplicas:     replicas(hpa.Spec.MinReplicas),
+		MaxReplicas:     hpa.Spec.MaxReplicas,
+		CurrentReplicas: hpa.Status.CurrentReplicas,
+		DesiredReplicas: hpa.Status.DesiredReplicas,
+	}, nil
+}
+
 func (a *appStatusRenderer) isDepReady(dep *appsv1.Deployment) (bool, error) {
 	available := false
 	for _, cond := range dep.Status.Conditions {
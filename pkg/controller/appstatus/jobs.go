@@ -13,6 +13,9 @@ import (
 	apierror "k8s.io/apimachinery/pkg/api/errors"
 )
 
+// maxJobRuns is the number of runs of a job that are recorded in its status
+const maxJobRuns = 10
+
 func (a *appStatusRenderer) readJobs() error {
 	var (
 		existingStatus = a.app.Status.AppStatus.Jobs
@@ -32,6 +35,9 @@ func (a *appStatusRenderer) readJobs() error {
 			Skipped:              existingStatus[jobName].Skipped,
 			ExpressionErrors:     existingStatus[jobName].ExpressionErrors,
 			Dependencies:         existingStatus[jobName].Dependencies,
+			Event:                existingStatus[jobName].Event,
+			EventGeneration:      existingStatus[jobName].EventGeneration,
+			Runs:                 existingStatus[jobName].Runs,
 		}
 		summary := summary[jobName]
 
@@ -83,6 +89,14 @@ func (a *appStatusRenderer) readJobs() error {
 			} else if job.Status.Active > 0 && c.RunningCount == 0 {
 				c.RunningCount = int(job.Status.Active)
 			}
+			if c.UpToDate && c.Event != "" {
+				c.Runs = recordJobRun(c.Runs, v1.JobRun{
+					Event:          c.Event,
+					Generation:     c.EventGeneration,
+					Succeeded:      job.Status.Succeeded > 0,
+					FailedAttempts: int(job.Status.Failed),
+				})
+			}
 		}
 
 		if c.RunningCount > 0 {
@@ -123,6 +137,19 @@ func (a *appStatusRenderer) readJobs() error {
 	return nil
 }
 
+// recordJobRun adds or updates the run of a job for an event of a generation of the app, keeping only the most
+// recent runs.
+func recordJobRun(runs []v1.JobRun, run v1.JobRun) []v1.JobRun {
+	if len(runs) > 0 && runs[len(runs)-1].Event == run.Event && runs[len(runs)-1].Generation == run.Generation {
+		runs = runs[:len(runs)-1]
+	}
+	runs = append(runs, run)
+	if len(runs) > maxJobRuns {
+		runs = runs[len(runs)-maxJobRuns:]
+	}
+	return runs
+}
+
 func addExpressionErrors(status *v1.CommonStatus, expressionErrors []v1.ExpressionError) {
 	missing := map[string]v1.DependencyType{}
 	for _, ee := range expressionErrors {
@@ -0,0 +1,30 @@
+package appstatus
+
+import (
+	"testing"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordJobRun(t *testing.T) {
+	runs := recordJobRun(nil, v1.JobRun{Event: "pre-update", Generation: 2, FailedAttempts: 1})
+	assert.Equal(t, []v1.JobRun{{Event: "pre-update", Generation: 2, FailedAttempts: 1}}, runs)
+
+	// The same run is updated in place
+	runs = recordJobRun(runs, v1.JobRun{Event: "pre-update", Generation: 2, FailedAttempts: 1, Succeeded: true})
+	assert.Equal(t, []v1.JobRun{{Event: "pre-update", Generation: 2, FailedAttempts: 1, Succeeded: true}}, runs)
+
+	// A new generation is a new run
+	runs = recordJobRun(runs, v1.JobRun{Event: "pre-update", Generation: 3})
+	assert.Len(t, runs, 2)
+	assert.Equal(t, int64(3), runs[1].Generation)
+
+	// Only the most recent runs are kept
+	for i := int64(4); i < 20; i++ {
+		runs = recordJobRun(runs, v1.JobRun{Event: "update", Generation: i})
+	}
+	assert.Len(t, runs, maxJobRuns)
+	assert.Equal(t, int64(10), runs[0].Generation)
+	assert.Equal(t, int64(19), runs[maxJobRuns-1].Generation)
+}
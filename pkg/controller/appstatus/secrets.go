@@ -10,6 +10,7 @@ import (
 	"github.com/acorn-io/runtime/pkg/labels"
 	"github.com/acorn-io/runtime/pkg/publicname"
 	"github.com/acorn-io/runtime/pkg/ref"
+	"github.com/acorn-io/runtime/pkg/secrets"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 )
@@ -77,6 +78,13 @@ func (a *appStatusRenderer) readSecrets() (err error) {
 
 		s.Ready = s.Ready && s.JobReady
 		s.DataKeys = typed.SortedKeys(sourceSecret.Data)
+		if secretDef.Rotate != nil {
+			s.LastRotationTime = secrets.LastRotationTime(sourceSecret)
+		}
+		if secretDef.Type == "external" {
+			s.SourceVersion = sourceSecret.Annotations[labels.AcornSecretSourceVersion]
+			s.LastSyncTime = secrets.LastSyncTime(sourceSecret)
+		}
 
 		a.app.Status.AppStatus.Secrets[secretName] = s
 	}
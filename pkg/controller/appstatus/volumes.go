@@ -77,12 +77,33 @@ func (a *appStatusRenderer) readVolumes() error {
 			}
 		}
 
+		if msg := pvc.Annotations[labels.AcornVolumeResizeError]; msg != "" {
+			v.ErrorMessages = append(v.ErrorMessages, msg)
+		}
+
+		if requested := pvc.Spec.Resources.Requests[corev1.ResourceStorage]; !requested.IsZero() {
+			v.RequestedSize = v1.Quantity(requested.String())
+		}
+
 		switch pvc.Status.Phase {
 		case corev1.ClaimBound:
-			// No message if the PVC is in phase bound.
 			v.Ready = true
 			v.Bound = true
 			v.StorageClassFound = true
+
+			capacity, requested := pvc.Status.Capacity[corev1.ResourceStorage], pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+			if !capacity.IsZero() {
+				v.Size = v1.Quantity(capacity.String())
+			}
+			// No message if the PVC is in phase bound, unless it is being expanded.
+			if !capacity.IsZero() && requested.Cmp(capacity) > 0 {
+				v.Resizing = true
+				msg := fmt.Sprintf("resizing volume %s from %s to %s", volumeName, capacity.String(), requested.String())
+				if hasPVCCondition(pvc, corev1.PersistentVolumeClaimFileSystemResizePending) {
+					msg += ", waiting for the volume to be mounted to resize the file system"
+				}
+				v.TransitioningMessages = append(v.TransitioningMessages, msg)
+			}
 		default:
 			// ignore volumes not mounted because they will never be bound
 			if a.volumeIsUsed(volumeName) {
@@ -101,6 +122,15 @@ func (a *appStatusRenderer) readVolumes() error {
 	return nil
 }
 
+func hasPVCCondition(pvc corev1.PersistentVolumeClaim, conditionType corev1.PersistentVolumeClaimConditionType) bool {
+	for _, cond := range pvc.Status.Conditions {
+		if cond.Type == conditionType && cond.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
 func linkedVolume(app *v1.AppInstance, name string) string {
 	if name == "" {
 		return ""
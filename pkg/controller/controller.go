@@ -17,9 +17,13 @@ import (
 	"github.com/acorn-io/runtime/pkg/event"
 	"github.com/acorn-io/runtime/pkg/imagesystem"
 	"github.com/acorn-io/runtime/pkg/k8sclient"
+	"github.com/acorn-io/runtime/pkg/logsink"
+	"github.com/acorn-io/runtime/pkg/metrics"
 	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	// Enabled logrus logging in baaah
@@ -31,10 +35,11 @@ var (
 )
 
 type Controller struct {
-	Router *router.Router
-	client client.Client
-	Scheme *runtime.Scheme
-	apply  apply.Apply
+	Router     *router.Router
+	client     client.Client
+	restConfig *rest.Config
+	Scheme     *runtime.Scheme
+	apply      apply.Apply
 }
 
 func New() (*Controller, error) {
@@ -66,10 +71,11 @@ func New() (*Controller, error) {
 	}
 
 	return &Controller{
-		Router: router,
-		client: client,
-		Scheme: scheme.Scheme,
-		apply:  apply,
+		Router:     router,
+		client:     client,
+		restConfig: cfg,
+		Scheme:     scheme.Scheme,
+		apply:      apply,
 	}, nil
 }
 
@@ -98,10 +104,16 @@ func (c *Controller) Start(ctx context.Context) error {
 		if !success {
 			panic("couldn't initialize client cache")
 		}
+		metrics.Registry.MustRegister(metrics.NewAppCollector(c.Router.Backend()))
+
 		dnsInit := dns.NewDaemon(c.Router.Backend())
 		go wait.UntilWithContext(ctx, dnsInit.RenewAndSync, dnsRenewPeriodHours)
 
-		autoupgrade.StartSync(ctx, c.Router.Backend())
+		autoupgrade.StartSync(ctx, c.Router.Backend(), event.NewRecorder(c.client))
+
+		if err := logsink.StartForwarding(ctx, c.restConfig); err != nil {
+			logrus.Errorf("Failed to start log forwarding: %v", err)
+		}
 	}()
 
 	return c.Router.Start(ctx)
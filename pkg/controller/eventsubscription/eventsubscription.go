@@ -0,0 +1,219 @@
+package eventsubscription
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/egress"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// SignatureHeader holds the hex encoded HMAC-SHA256 of the body, keyed with the token of the signing secret,
+	// in the form sha256=<signature>
+	SignatureHeader = "X-Acorn-Signature"
+	// EventHeader holds the type of the delivered event
+	EventHeader = "X-Acorn-Event"
+	// DeliveryHeader holds the name of the delivered event, which identifies a delivery across retries
+	DeliveryHeader = "X-Acorn-Delivery"
+
+	signingSecretKey = "token"
+	deliveryTimeout  = 10 * time.Second
+	initialBackoff   = 5 * time.Second
+	maxBackoff       = 10 * time.Minute
+)
+
+// DeliverEvents POSTs the events in the namespace of the subscription that match its filters to its URL, one event
+// at a time in the order they were observed. A failed delivery is retried with exponential backoff before any later
+// event is delivered. Events are only delivered to public addresses, never to the cluster or the controller itself.
+func DeliverEvents() router.HandlerFunc {
+	return handler{
+		client: egress.NewHTTPClient(deliveryTimeout),
+		now:    time.Now,
+	}.deliverEvents
+}
+
+type handler struct {
+	client *http.Client
+	now    func() time.Time
+}
+
+func (h handler) deliverEvents(req router.Request, resp router.Response) error {
+	sub := req.Object.(*v1.EventSubscriptionInstance)
+	if !sub.DeletionTimestamp.IsZero() {
+		return nil
+	}
+
+	if sub.Status.ConsecutiveFailures > 0 && sub.Status.LastAttempt != nil {
+		if wait := sub.Status.LastAttempt.Add(backoff(sub.Status.ConsecutiveFailures)).Sub(h.now()); wait > 0 {
+			resp.RetryAfter(wait)
+			return nil
+		}
+	}
+
+	// Listing the events also triggers this handler again when an event in the namespace is recorded
+	events := &v1.EventInstanceList{}
+	if err := req.List(events, &kclient.ListOptions{
+		Namespace: sub.Namespace,
+	}); err != nil {
+		return err
+	}
+
+	pending := pendingEvents(sub, events.Items)
+	if len(pending) == 0 {
+		return nil
+	}
+
+	key, err := signingKey(req, sub)
+	if err != nil {
+		h.failed(resp, sub, err)
+		return nil
+	}
+
+	for i := range pending {
+		event := &pending[i]
+		if sub.Spec.Matches(event) {
+			if err := h.deliver(req.Ctx, sub, event, key); err != nil {
+				logrus.Debugf("Failed to deliver event %s to subscription %s/%s: %v", event.Name, sub.Namespace, sub.Name, err)
+				h.failed(resp, sub, deliveryError(event, err))
+				return nil
+			}
+			sub.Status.Delivered++
+			sub.Status.ConsecutiveFailures = 0
+			sub.Status.LastError = ""
+		}
+		observed := event.Observed
+		sub.Status.LastEvent = event.Name
+		sub.Status.LastEventObserved = &observed
+	}
+
+	return nil
+}
+
+func (h handler) failed(resp router.Response, sub *v1.EventSubscriptionInstance, err error) {
+	sub.Status.LastAttempt = &metav1.Time{Time: h.now()}
+	sub.Status.ConsecutiveFailures++
+	sub.Status.LastError = err.Error()
+	resp.RetryAfter(backoff(sub.Status.ConsecutiveFailures))
+}
+
+func (h handler) deliver(ctx context.Context, sub *v1.EventSubscriptionInstance, event *v1.EventInstance, key []byte) error {
+	payload := apiv1.Event(*event)
+	payload.APIVersion = apiv1.SchemeGroupVersion.String()
+	payload.Kind = "Event"
+	payload.ManagedFields = nil
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.Spec.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set(EventHeader, event.Type)
+	httpReq.Header.Set(DeliveryHeader, event.Name)
+	if len(key) > 0 {
+		httpReq.Header.Set(SignatureHeader, "sha256="+Sign(key, body))
+	}
+
+	sub.Status.LastAttempt = &metav1.Time{Time: h.now()}
+	httpResp, err := h.client.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer httpResp.Body.Close()
+	_, _ = io.Copy(io.Discard, httpResp.Body)
+
+	if httpResp.StatusCode < 200 || httpResp.StatusCode > 299 {
+		return fmt.Errorf("unexpected status %s", httpResp.Status)
+	}
+	return nil
+}
+
+// deliveryError returns the error shown in the status of the subscription. The responses and connection errors of the
+// destination are left out, so the status can't be used to probe the hosts and ports the controller can reach.
+func deliveryError(event *v1.EventInstance, err error) error {
+	if errors.Is(err, egress.ErrDestinationNotAllowed) {
+		return fmt.Errorf("delivering event %s: %w", event.Name, egress.ErrDestinationNotAllowed)
+	}
+	return fmt.Errorf("delivering event %s failed", event.Name)
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of the body
+func Sign(key, body []byte) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func signingKey(req router.Request, sub *v1.EventSubscriptionInstance) ([]byte, error) {
+	if sub.Spec.SigningSecretName == "" {
+		return nil, nil
+	}
+
+	secret := &corev1.Secret{}
+	if err := req.Get(secret, sub.Namespace, sub.Spec.SigningSecretName); err != nil {
+		return nil, fmt.Errorf("getting signing secret %s: %w", sub.Spec.SigningSecretName, err)
+	}
+	if len(secret.Data[signingSecretKey]) == 0 {
+		return nil, fmt.Errorf("signing secret %s has no %s", sub.Spec.SigningSecretName, signingSecretKey)
+	}
+	return secret.Data[signingSecretKey], nil
+}
+
+// pendingEvents returns the events observed after the last event processed for the subscription, oldest first
+func pendingEvents(sub *v1.EventSubscriptionInstance, events []v1.EventInstance) []v1.EventInstance {
+	var (
+		after     = sub.CreationTimestamp.Time
+		afterName string
+		pending   []v1.EventInstance
+	)
+	if sub.Status.LastEventObserved != nil {
+		after = sub.Status.LastEventObserved.Time
+		afterName = sub.Status.LastEvent
+	}
+
+	for _, event := range events {
+		if event.Observed.After(after) || (event.Observed.Equal(after) && event.Name > afterName) {
+			pending = append(pending, event)
+		}
+	}
+
+	sort.Slice(pending, func(i, j int) bool {
+		if pending[i].Observed.Equal(pending[j].Observed.Time) {
+			return pending[i].Name < pending[j].Name
+		}
+		return pending[i].Observed.Before(pending[j].Observed.Time)
+	})
+	return pending
+}
+
+func backoff(failures int) time.Duration {
+	d := initialBackoff
+	for i := 1; i < failures && d < maxBackoff; i++ {
+		d *= 2
+	}
+	if d > maxBackoff {
+		return maxBackoff
+	}
+	return d
+}
@@ -0,0 +1,174 @@
+package eventsubscription
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/acorn-io/baaah/pkg/router/tester"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/egress"
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestDeliverEvents(t *testing.T) {
+	created := time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC)
+	event := func(name, eventType string, severity v1.EventSeverity, offset time.Duration) kclient.Object {
+		return &v1.EventInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "acorn"},
+			Type:       eventType,
+			Severity:   severity,
+			Source:     v1.EventSource{Kind: "app", Name: "hello"},
+			Observed:   v1.MicroTime(metav1.NewMicroTime(created.Add(offset))),
+		}
+	}
+	existing := []kclient.Object{
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "hook-signing-key", Namespace: "acorn"},
+			Data:       map[string][]byte{"token": []byte("s3cr3t")},
+		},
+		// observed before the subscription was created
+		event("old", "AppSpecUpdate", v1.EventSeverityInfo, -time.Minute),
+		event("b-failed", "AppFailed", v1.EventSeverityCritical, 2*time.Minute),
+		event("a-updated", "AppSpecUpdate", v1.EventSeverityInfo, time.Minute),
+		event("c-failed", "AppFailed", v1.EventSeverityCritical, 3*time.Minute),
+		// in another project
+		&v1.EventInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "other"},
+			Type:       "AppFailed",
+			Severity:   v1.EventSeverityCritical,
+			Observed:   v1.MicroTime(metav1.NewMicroTime(created.Add(time.Minute))),
+		},
+	}
+
+	fail := true
+	var delivered []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, "sha256="+Sign([]byte("s3cr3t"), body), r.Header.Get(SignatureHeader))
+		assert.Equal(t, "AppFailed", r.Header.Get(EventHeader))
+
+		var payload map[string]any
+		require.NoError(t, json.Unmarshal(body, &payload))
+		assert.Equal(t, "Event", payload["kind"])
+		assert.Equal(t, r.Header.Get(DeliveryHeader), payload["metadata"].(map[string]any)["name"])
+
+		if fail {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		delivered = append(delivered, r.Header.Get(DeliveryHeader))
+	}))
+	defer server.Close()
+
+	now := created.Add(5 * time.Minute)
+	h := handler{
+		client: server.Client(),
+		now: func() time.Time {
+			return now
+		},
+	}
+
+	sub := &v1.EventSubscriptionInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "hook",
+			Namespace:         "acorn",
+			CreationTimestamp: metav1.NewTime(created),
+		},
+		Spec: v1.EventSubscriptionSpec{
+			URL:               server.URL,
+			SigningSecretName: "hook-signing-key",
+			Severities:        []v1.EventSeverity{v1.EventSeverityCritical},
+			SourceKinds:       []string{"app"},
+		},
+	}
+
+	deliver := func() *tester.Response {
+		t.Helper()
+		req := tester.NewRequest(t, scheme.Scheme, sub, existing...)
+		resp := &tester.Response{Client: req.Client.(*tester.Client)}
+		require.NoError(t, h.deliverEvents(req, resp))
+		sub = req.Object.(*v1.EventSubscriptionInstance)
+		return resp
+	}
+
+	// The endpoint fails, so the first matching event is retried later. The non-matching event before it is skipped.
+	resp := deliver()
+	assert.Equal(t, initialBackoff, resp.Delay)
+	assert.Equal(t, 1, sub.Status.ConsecutiveFailures)
+	assert.Equal(t, "delivering event b-failed failed", sub.Status.LastError)
+	assert.Equal(t, "a-updated", sub.Status.LastEvent)
+	assert.Equal(t, int64(0), sub.Status.Delivered)
+
+	// Nothing is attempted before the backoff expired
+	resp = deliver()
+	assert.Equal(t, initialBackoff, resp.Delay)
+	assert.Equal(t, 1, sub.Status.ConsecutiveFailures)
+
+	fail = false
+	now = now.Add(initialBackoff)
+	resp = deliver()
+	assert.Equal(t, time.Duration(0), resp.Delay)
+	assert.Equal(t, []string{"b-failed", "c-failed"}, delivered)
+	assert.Equal(t, int64(2), sub.Status.Delivered)
+	assert.Equal(t, 0, sub.Status.ConsecutiveFailures)
+	assert.Empty(t, sub.Status.LastError)
+	assert.Equal(t, "c-failed", sub.Status.LastEvent)
+
+	// Events are only delivered once
+	deliver()
+	assert.Equal(t, []string{"b-failed", "c-failed"}, delivered)
+}
+
+func TestDeliverEventsToLoopback(t *testing.T) {
+	created := time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC)
+	var delivered bool
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		delivered = true
+	}))
+	defer server.Close()
+
+	h := handler{
+		client: egress.NewHTTPClient(deliveryTimeout),
+		now: func() time.Time {
+			return created.Add(time.Minute)
+		},
+	}
+	sub := &v1.EventSubscriptionInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "hook",
+			Namespace:         "acorn",
+			CreationTimestamp: metav1.NewTime(created),
+		},
+		Spec: v1.EventSubscriptionSpec{
+			URL: server.URL,
+		},
+	}
+	req := tester.NewRequest(t, scheme.Scheme, sub, &v1.EventInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "failed", Namespace: "acorn"},
+		Type:       "AppFailed",
+		Observed:   v1.MicroTime(metav1.NewMicroTime(created.Add(time.Second))),
+	})
+	resp := &tester.Response{Client: req.Client.(*tester.Client)}
+	require.NoError(t, h.deliverEvents(req, resp))
+
+	sub = req.Object.(*v1.EventSubscriptionInstance)
+	assert.False(t, delivered)
+	assert.Equal(t, 1, sub.Status.ConsecutiveFailures)
+	assert.Equal(t, "delivering event failed: destination is not allowed", sub.Status.LastError)
+}
+
+func TestBackoff(t *testing.T) {
+	assert.Equal(t, 5*time.Second, backoff(1))
+	assert.Equal(t, 10*time.Second, backoff(2))
+	assert.Equal(t, 40*time.Second, backoff(4))
+	assert.Equal(t, maxBackoff, backoff(100))
+}
@@ -0,0 +1,116 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/event"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/sirupsen/logrus"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	JobSucceededEventType = "JobSucceeded"
+	JobFailedEventType    = "JobFailed"
+)
+
+// JobEventDetails captures additional info about a job run that completed.
+type JobEventDetails struct {
+	// Job is the name of the job in the app.
+	Job string `json:"job"`
+
+	// Execution is the name of the Kubernetes Job that ran it.
+	Execution string `json:"execution"`
+
+	// Attempts is the number of pods started to run the job.
+	Attempts int32 `json:"attempts"`
+
+	// Reason and Message describe why the job failed.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// RecordCompletion records a JobSucceeded or JobFailed event for the app of a job once it completed. The job is
+// annotated to only record the event once.
+func RecordCompletion(recorder event.Recorder) router.HandlerFunc {
+	return func(req router.Request, resp router.Response) error {
+		job := req.Object.(*batchv1.Job)
+		if job.Labels[labels.AcornJobName] == "" || job.Annotations[labels.AcornJobCompletionRecorded] == "true" {
+			return nil
+		}
+
+		var finished *batchv1.JobCondition
+		for i, cond := range job.Status.Conditions {
+			if cond.Status == corev1.ConditionTrue && (cond.Type == batchv1.JobComplete || cond.Type == batchv1.JobFailed) {
+				finished = &job.Status.Conditions[i]
+				break
+			}
+		}
+		if finished == nil {
+			return nil
+		}
+
+		app := &v1.AppInstance{}
+		if err := req.Get(app, job.Labels[labels.AcornAppNamespace], job.Labels[labels.AcornAppName]); apierrors.IsNotFound(err) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		if job.Annotations == nil {
+			job.Annotations = map[string]string{}
+		}
+		job.Annotations[labels.AcornJobCompletionRecorded] = "true"
+		if err := req.Client.Update(req.Ctx, job); err != nil {
+			return err
+		}
+
+		recordCompletionEvent(req.Ctx, recorder, app, job, finished)
+		return nil
+	}
+}
+
+func recordCompletionEvent(ctx context.Context, recorder event.Recorder, app *v1.AppInstance, job *batchv1.Job, finished *batchv1.JobCondition) {
+	jobName := job.Labels[labels.AcornJobName]
+	e := apiv1.Event{
+		Type:        JobSucceededEventType,
+		Actor:       "acorn-system",
+		Severity:    v1.EventSeverityInfo,
+		Description: fmt.Sprintf("Job %s succeeded", jobName),
+		Source:      event.ObjectSource(app),
+		Observed:    v1.MicroTime(metav1.NewMicroTime(finished.LastTransitionTime.Time)),
+	}
+	e.SetNamespace(app.GetNamespace())
+
+	details := JobEventDetails{
+		Job:       jobName,
+		Execution: job.Name,
+		Attempts:  job.Status.Active + job.Status.Succeeded + job.Status.Failed,
+	}
+
+	if finished.Type == batchv1.JobFailed {
+		e.Type = JobFailedEventType
+		e.Severity = v1.EventSeverityWarn
+		e.Description = fmt.Sprintf("Job %s failed", jobName)
+		details.Reason = finished.Reason
+		details.Message = finished.Message
+	}
+
+	var err error
+	if e.Details, err = v1.Mapify(details); err != nil {
+		logrus.Warnf("Failed to mapify event details: %s", err.Error())
+	}
+
+	if err := recorder.Record(ctx, &e); err != nil {
+		logrus.Warnf("Failed to record event: %s", err.Error())
+	}
+}
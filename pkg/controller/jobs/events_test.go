@@ -0,0 +1,111 @@
+package jobs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/baaah/pkg/router/tester"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/event"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRecordCompletion(t *testing.T) {
+	app := &v1.AppInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "acorn", UID: "app-uid"},
+	}
+	newJob := func(conditions ...batchv1.JobCondition) *batchv1.Job {
+		return &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "migrate",
+				Namespace: "app-namespace",
+				Labels: labels.ManagedByApp("acorn", "app",
+					labels.AcornJobName, "migrate"),
+			},
+			Status: batchv1.JobStatus{
+				Failed:     2,
+				Conditions: conditions,
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		job      *batchv1.Job
+		wantType string
+	}{
+		{
+			name: "running",
+			job:  newJob(),
+		},
+		{
+			name: "failed",
+			job: newJob(batchv1.JobCondition{
+				Type:    batchv1.JobFailed,
+				Status:  corev1.ConditionTrue,
+				Reason:  "BackoffLimitExceeded",
+				Message: "Job has reached the specified backoff limit",
+			}),
+			wantType: JobFailedEventType,
+		},
+		{
+			name: "succeeded",
+			job: newJob(batchv1.JobCondition{
+				Type:   batchv1.JobComplete,
+				Status: corev1.ConditionTrue,
+			}),
+			wantType: JobSucceededEventType,
+		},
+		{
+			name: "already recorded",
+			job: func() *batchv1.Job {
+				job := newJob(batchv1.JobCondition{
+					Type:   batchv1.JobComplete,
+					Status: corev1.ConditionTrue,
+				})
+				job.Annotations = map[string]string{labels.AcornJobCompletionRecorded: "true"}
+				return job
+			}(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var events []apiv1.Event
+			recorder := event.RecorderFunc(func(_ context.Context, e *apiv1.Event) error {
+				events = append(events, *e)
+				return nil
+			})
+
+			req := tester.NewRequest(t, scheme.Scheme, tt.job, app)
+			require.NoError(t, RecordCompletion(recorder)(req, &tester.Response{Client: req.Client.(*tester.Client)}))
+
+			if tt.wantType == "" {
+				assert.Empty(t, events)
+				return
+			}
+
+			require.Len(t, events, 1)
+			e := events[0]
+			assert.Equal(t, tt.wantType, e.Type)
+			assert.Equal(t, "acorn", e.Namespace)
+			assert.Equal(t, v1.EventSource{Kind: "app", Name: "app", UID: "app-uid"}, e.Source)
+			assert.Equal(t, "migrate", e.Details["job"])
+			if tt.wantType == JobFailedEventType {
+				assert.Equal(t, v1.EventSeverityWarn, e.Severity)
+				assert.Equal(t, "BackoffLimitExceeded", e.Details["reason"])
+			}
+
+			job := &batchv1.Job{}
+			require.NoError(t, req.Client.Get(context.Background(), router.Key(tt.job.Namespace, tt.job.Name), job))
+			assert.Equal(t, "true", job.Annotations[labels.AcornJobCompletionRecorded])
+		})
+	}
+}
@@ -1,11 +1,15 @@
 package quota
 
 import (
+	"context"
 	"fmt"
 
+	publicv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	adminv1 "github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/event"
 	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -16,10 +20,53 @@ import (
 	"github.com/acorn-io/baaah/pkg/router"
 )
 
+// QuotaDeniedEventType is the type of the event recorded when the quota requested by an app can not be allocated.
+const QuotaDeniedEventType = "QuotaDenied"
+
+// QuotaDeniedEventDetails captures additional info about a denied quota request.
+type QuotaDeniedEventDetails struct {
+	// Requested is the total of the resources requested by the app.
+	Requested adminv1.Resources `json:"requested"`
+
+	// Failed is the part of the requested resources that exceeds the quota of the project.
+	Failed adminv1.Resources `json:"failed"`
+}
+
+func recordDeniedEvent(ctx context.Context, recorder event.Recorder, appInstance *apiv1.AppInstance, quotaRequest *adminv1.QuotaRequestInstance) {
+	e := publicv1.Event{
+		Type:        QuotaDeniedEventType,
+		Actor:       "acorn-system",
+		Severity:    apiv1.EventSeverityWarn,
+		Description: fmt.Sprintf("Quota denied for %s", quotaRequest.Status.FailedResources.NonEmptyString()),
+		Source:      event.ObjectSource(appInstance),
+		Observed:    apiv1.MicroTime(metav1.NowMicro()),
+	}
+	e.SetNamespace(appInstance.GetNamespace())
+
+	var err error
+	if e.Details, err = apiv1.Mapify(QuotaDeniedEventDetails{
+		Requested: quotaRequest.Spec.Resources,
+		Failed:    *quotaRequest.Status.FailedResources,
+	}); err != nil {
+		logrus.Warnf("Failed to mapify event details: %s", err.Error())
+	}
+
+	if err := recorder.Record(ctx, &e); err != nil {
+		logrus.Warnf("Failed to record event: %s", err.Error())
+	}
+}
+
 // WaitForAllocation blocks the appInstance from being deployed until quota has been allocated on
-// an associated QuotaRequest object.
-func WaitForAllocation(req router.Request, resp router.Response) error {
+// an associated QuotaRequest object. A QuotaDenied event is recorded when the allocation fails.
+func WaitForAllocation(recorder event.Recorder) router.HandlerFunc {
+	return func(req router.Request, resp router.Response) error {
+		return waitForAllocation(req, resp, recorder)
+	}
+}
+
+func waitForAllocation(req router.Request, resp router.Response, recorder event.Recorder) error {
 	appInstance := req.Object.(*apiv1.AppInstance)
+	previous := appInstance.Status.Condition(apiv1.AppInstanceConditionQuotaAllocated)
 
 	// Create a condition setter for AppInstanceConditionQuotaAllocated, which blocks the appInstance from being deployed
 	// until quota has been allocated.
@@ -53,7 +100,11 @@ func WaitForAllocation(req router.Request, resp router.Response) error {
 		4. Exists and has successfully allocated the resources requested.
 	*/
 	if quotaRequest.Status.FailedResources != nil {
-		status.Error(fmt.Errorf("failed to provision the following resources: %v", quotaRequest.Status.FailedResources.NonEmptyString()))
+		err := fmt.Errorf("failed to provision the following resources: %v", quotaRequest.Status.FailedResources.NonEmptyString())
+		if !previous.Error || previous.Message != err.Error() {
+			recordDeniedEvent(req.Ctx, recorder, appInstance, quotaRequest)
+		}
+		status.Error(err)
 	} else if cond := quotaRequest.Status.Condition(adminv1.QuotaRequestCondition); cond.Error {
 		status.Error(fmt.Errorf("error occurred while trying to allocate quota: %v", cond.Message))
 	} else if err != nil || !quotaRequest.Spec.Resources.Equals(quotaRequest.Status.AllocatedResources) {
@@ -13,6 +13,7 @@ import (
 	"github.com/acorn-io/runtime/pkg/controller/defaults"
 	"github.com/acorn-io/runtime/pkg/controller/devsession"
 	"github.com/acorn-io/runtime/pkg/controller/eventinstance"
+	"github.com/acorn-io/runtime/pkg/controller/eventsubscription"
 	"github.com/acorn-io/runtime/pkg/controller/gc"
 	"github.com/acorn-io/runtime/pkg/controller/images"
 	"github.com/acorn-io/runtime/pkg/controller/ingress"
@@ -27,6 +28,7 @@ import (
 	"github.com/acorn-io/runtime/pkg/controller/tls"
 	"github.com/acorn-io/runtime/pkg/event"
 	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/metrics"
 	"github.com/acorn-io/runtime/pkg/system"
 	"github.com/acorn-io/runtime/pkg/volume"
 	appsv1 "k8s.io/api/apps/v1"
@@ -54,75 +56,81 @@ func routes(router *router.Router, cfg *rest.Config, registryTransport http.Roun
 
 	router.OnErrorHandler = appdefinition.OnError
 
+	// The metrics middleware is added to every route right before its handler. The middleware added last is the
+	// innermost, so it wraps the handler itself and can name the metrics after it instead of after other middleware.
 	appRouter := router.Type(&v1.AppInstance{}).Middleware(devsession.OverlayDevSession).IncludeFinalizing()
-	appRouter.HandlerFunc(appstatus.PrepareStatus)
-	appRouter.HandlerFunc(appdefinition.AssignNamespace)
-	appRouter.HandlerFunc(appdefinition.CheckImageAllowedHandler(registryTransport))
-	appRouter.HandlerFunc(appdefinition.PullAppImage(registryTransport, recorder))
-	appRouter.HandlerFunc(images.CreateImages)
-	appRouter.HandlerFunc(appdefinition.ParseAppImage)
-	appRouter.Middleware(appdefinition.FilterLabelsAndAnnotationsConfig).HandlerFunc(namespace.AddNamespace)
-	appRouter.Middleware(jobs.NeedsDestroyJobFinalization).FinalizeFunc(jobs.DestroyJobFinalizer, jobs.FinalizeDestroyJob)
+	appRouter.Middleware(metrics.HandlerMiddleware).HandlerFunc(appstatus.PrepareStatus)
+	appRouter.Middleware(metrics.HandlerMiddleware).HandlerFunc(appdefinition.AssignNamespace)
+	appRouter.Middleware(metrics.HandlerMiddleware).HandlerFunc(appdefinition.CheckImageAllowedHandler(registryTransport, recorder))
+	appRouter.Middleware(metrics.HandlerMiddleware).HandlerFunc(appdefinition.PullAppImage(registryTransport, recorder))
+	appRouter.Middleware(metrics.HandlerMiddleware).HandlerFunc(images.CreateImages)
+	appRouter.Middleware(metrics.HandlerMiddleware).HandlerFunc(appdefinition.ParseAppImage)
+	appRouter.Middleware(appdefinition.ImagePulled, metrics.HandlerMiddleware).HandlerFunc(appdefinition.RecordRevision)
+	appRouter.Middleware(appdefinition.FilterLabelsAndAnnotationsConfig, metrics.HandlerMiddleware).HandlerFunc(namespace.AddNamespace)
+	appRouter.Middleware(jobs.NeedsDestroyJobFinalization, metrics.HandlerMiddleware).FinalizeFunc(jobs.DestroyJobFinalizer, jobs.FinalizeDestroyJob)
 
 	// DeploySpec will create the namespace, so ensure it runs before anything that requires a namespace
 	appHasNamespace := appRouter.Middleware(appdefinition.RequireNamespace, appdefinition.IgnoreTerminatingNamespace, appdefinition.FilterLabelsAndAnnotationsConfig)
-	appHasNamespace.HandlerFunc(defaults.Calculate)
-	appHasNamespace.HandlerFunc(scheduling.Calculate)
-	appHasNamespace.HandlerFunc(quota.EnsureQuotaRequest)
-	appHasNamespace.HandlerFunc(quota.WaitForAllocation)
+	appHasNamespace.Middleware(metrics.HandlerMiddleware).HandlerFunc(defaults.Calculate)
+	appHasNamespace.Middleware(metrics.HandlerMiddleware).HandlerFunc(scheduling.Calculate)
+	appHasNamespace.Middleware(metrics.HandlerMiddleware).HandlerFunc(quota.EnsureQuotaRequest)
+	appHasNamespace.Middleware(metrics.HandlerMiddleware).HandlerFunc(quota.WaitForAllocation(recorder))
 
 	appMeetsPreconditions := appHasNamespace.Middleware(appstatus.CheckStatus)
-	appMeetsPreconditions.Middleware(appdefinition.ImagePulled).HandlerFunc(appdefinition.DeploySpec)
-	appMeetsPreconditions.Middleware(appdefinition.ImagePulled).HandlerFunc(secrets.CreateSecrets)
-	appMeetsPreconditions.HandlerFunc(appstatus.SetStatus)
-	appMeetsPreconditions.HandlerFunc(appstatus.ReadyStatus)
-	appMeetsPreconditions.HandlerFunc(networkpolicy.ForApp)
-	appMeetsPreconditions.HandlerFunc(appdefinition.AddAcornProjectLabel)
-	appMeetsPreconditions.HandlerFunc(appdefinition.UpdateObservedFields)
-
-	appRouter.HandlerFunc(appstatus.CLIStatus)
-
-	router.Type(&v1.DevSessionInstance{}).HandlerFunc(devsession.ExpireDevSession)
-
-	router.Type(&v1.ServiceInstance{}).HandlerFunc(service.RenderServices)
-
-	router.Type(&v1.BuilderInstance{}).HandlerFunc(builder.SetRegion)
-	router.Type(&v1.BuilderInstance{}).HandlerFunc(builder.DeployBuilder)
-
-	router.Type(&v1.AcornImageBuildInstance{}).HandlerFunc(acornimagebuildinstance.SetRegion)
-	router.Type(&v1.AcornImageBuildInstance{}).HandlerFunc(acornimagebuildinstance.MarkRecorded)
-
-	router.Type(&v1.ServiceInstance{}).HandlerFunc(gc.GCOrphans)
-
-	router.Type(&v1.EventInstance{}).HandlerFunc(eventinstance.GCExpired())
-
-	router.Type(&batchv1.Job{}).Selector(managedSelector).HandlerFunc(jobs.JobCleanup)
-	router.Type(&rbacv1.ClusterRole{}).Selector(managedSelector).HandlerFunc(gc.GCOrphans)
-	router.Type(&rbacv1.ClusterRoleBinding{}).Selector(managedSelector).HandlerFunc(gc.GCOrphans)
-	router.Type(&corev1.PersistentVolumeClaim{}).Selector(managedSelector).HandlerFunc(pvc.MarkAndSave)
-	router.Type(&corev1.PersistentVolume{}).Selector(managedSelector).HandlerFunc(appdefinition.ReleaseVolume)
-	router.Type(&corev1.Namespace{}).Selector(managedSelector).HandlerFunc(namespace.DeleteOrphaned)
-	router.Type(&appsv1.DaemonSet{}).Namespace(system.ImagesNamespace).HandlerFunc(gc.GCOrphans)
-	router.Type(&appsv1.Deployment{}).Namespace(system.ImagesNamespace).HandlerFunc(gc.GCOrphans)
-	router.Type(&corev1.Service{}).Selector(managedSelector).HandlerFunc(gc.GCOrphans)
-	router.Type(&policyv1.PodDisruptionBudget{}).Namespace(system.ImagesNamespace).HandlerFunc(gc.GCOrphans)
-	router.Type(&corev1.Pod{}).Selector(managedSelector).HandlerFunc(gc.GCOrphans)
-	router.Type(&corev1.Pod{}).Selector(managedSelector).HandlerFunc(jobs.JobPodOrphanCleanup)
-	router.Type(&corev1.Pod{}).Selector(managedSelector).HandlerFunc(jobsHandler.SaveJobOutput)
-	router.Type(&netv1.Ingress{}).Selector(managedSelector).Namespace(system.ImagesNamespace).HandlerFunc(gc.GCOrphans)
-	router.Type(&netv1.Ingress{}).Selector(managedSelector).Middleware(ingress.RequireLBs).Handler(ingress.NewDNSHandler())
-	router.Type(&corev1.Secret{}).Selector(managedSelector).Middleware(tls.RequireSecretTypeTLS).HandlerFunc(tls.RenewCert) // renew (expired) TLS certificates, including the oss-acorn.io wildcard cert
-	router.Type(&storagev1.StorageClass{}).HandlerFunc(volume.SyncVolumeClasses)
-	router.Type(&corev1.Service{}).Selector(managedSelector).HandlerFunc(networkpolicy.ForService)
-	router.Type(&netv1.Ingress{}).Selector(managedSelector).HandlerFunc(networkpolicy.ForIngress)
-	router.Type(&appsv1.Deployment{}).Namespace(system.ImagesNamespace).HandlerFunc(networkpolicy.ForBuilder)
-	router.Type(&netv1.NetworkPolicy{}).Selector(managedSelector).HandlerFunc(gc.GCOrphans)
+	appMeetsPreconditions.Middleware(appdefinition.ImagePulled, metrics.HandlerMiddleware).HandlerFunc(appdefinition.UpdateRollout)
+	appMeetsPreconditions.Middleware(appdefinition.ImagePulled, metrics.HandlerMiddleware).HandlerFunc(appdefinition.DeploySpec)
+	appMeetsPreconditions.Middleware(appdefinition.ImagePulled, metrics.HandlerMiddleware).HandlerFunc(secrets.CreateSecrets(recorder))
+	appMeetsPreconditions.Middleware(metrics.HandlerMiddleware).HandlerFunc(appstatus.SetStatus)
+	appMeetsPreconditions.Middleware(metrics.HandlerMiddleware).HandlerFunc(appstatus.ReadyStatus)
+	appMeetsPreconditions.Middleware(metrics.HandlerMiddleware).HandlerFunc(networkpolicy.ForApp)
+	appMeetsPreconditions.Middleware(metrics.HandlerMiddleware).HandlerFunc(appdefinition.AddAcornProjectLabel)
+	appMeetsPreconditions.Middleware(metrics.HandlerMiddleware).HandlerFunc(appdefinition.UpdateObservedFields)
+
+	appRouter.Middleware(metrics.HandlerMiddleware).HandlerFunc(appstatus.CLIStatus)
+
+	router.Type(&v1.DevSessionInstance{}).Middleware(metrics.HandlerMiddleware).HandlerFunc(devsession.ExpireDevSession)
+
+	router.Type(&v1.ServiceInstance{}).Middleware(metrics.HandlerMiddleware).HandlerFunc(service.RenderServices)
+
+	router.Type(&v1.BuilderInstance{}).Middleware(metrics.HandlerMiddleware).HandlerFunc(builder.SetRegion)
+	router.Type(&v1.BuilderInstance{}).Middleware(metrics.HandlerMiddleware).HandlerFunc(builder.DeployBuilder)
+
+	router.Type(&v1.AcornImageBuildInstance{}).Middleware(metrics.HandlerMiddleware).HandlerFunc(acornimagebuildinstance.SetRegion)
+	router.Type(&v1.AcornImageBuildInstance{}).Middleware(metrics.HandlerMiddleware).HandlerFunc(acornimagebuildinstance.MarkRecorded)
+
+	router.Type(&v1.ServiceInstance{}).Middleware(metrics.HandlerMiddleware).HandlerFunc(gc.GCOrphans)
+
+	router.Type(&v1.EventInstance{}).Middleware(metrics.HandlerMiddleware).HandlerFunc(eventinstance.GCExpired())
+	router.Type(&v1.EventSubscriptionInstance{}).Middleware(metrics.HandlerMiddleware).HandlerFunc(eventsubscription.DeliverEvents())
+
+	router.Type(&batchv1.Job{}).Selector(managedSelector).Middleware(metrics.HandlerMiddleware).HandlerFunc(jobs.JobCleanup)
+	router.Type(&batchv1.Job{}).Selector(managedSelector).Middleware(metrics.HandlerMiddleware).HandlerFunc(jobs.RecordCompletion(recorder))
+	router.Type(&rbacv1.ClusterRole{}).Selector(managedSelector).Middleware(metrics.HandlerMiddleware).HandlerFunc(gc.GCOrphans)
+	router.Type(&rbacv1.ClusterRoleBinding{}).Selector(managedSelector).Middleware(metrics.HandlerMiddleware).HandlerFunc(gc.GCOrphans)
+	router.Type(&corev1.PersistentVolumeClaim{}).Selector(managedSelector).Middleware(metrics.HandlerMiddleware).HandlerFunc(pvc.MarkAndSave)
+	router.Type(&corev1.PersistentVolume{}).Selector(managedSelector).Middleware(metrics.HandlerMiddleware).HandlerFunc(appdefinition.ReleaseVolume)
+	router.Type(&corev1.Namespace{}).Selector(managedSelector).Middleware(metrics.HandlerMiddleware).HandlerFunc(namespace.DeleteOrphaned)
+	router.Type(&appsv1.DaemonSet{}).Namespace(system.ImagesNamespace).Middleware(metrics.HandlerMiddleware).HandlerFunc(gc.GCOrphans)
+	router.Type(&appsv1.Deployment{}).Namespace(system.ImagesNamespace).Middleware(metrics.HandlerMiddleware).HandlerFunc(gc.GCOrphans)
+	router.Type(&corev1.Service{}).Selector(managedSelector).Middleware(metrics.HandlerMiddleware).HandlerFunc(gc.GCOrphans)
+	router.Type(&policyv1.PodDisruptionBudget{}).Namespace(system.ImagesNamespace).Middleware(metrics.HandlerMiddleware).HandlerFunc(gc.GCOrphans)
+	router.Type(&corev1.Pod{}).Selector(managedSelector).Middleware(metrics.HandlerMiddleware).HandlerFunc(gc.GCOrphans)
+	router.Type(&corev1.Pod{}).Selector(managedSelector).Middleware(metrics.HandlerMiddleware).HandlerFunc(jobs.JobPodOrphanCleanup)
+	router.Type(&corev1.Pod{}).Selector(managedSelector).Middleware(metrics.HandlerMiddleware).HandlerFunc(jobsHandler.SaveJobOutput)
+	router.Type(&netv1.Ingress{}).Selector(managedSelector).Namespace(system.ImagesNamespace).Middleware(metrics.HandlerMiddleware).HandlerFunc(gc.GCOrphans)
+	router.Type(&netv1.Ingress{}).Selector(managedSelector).Middleware(ingress.RequireLBs, metrics.HandlerMiddleware).Handler(ingress.NewDNSHandler())
+	router.Type(&corev1.Secret{}).Selector(managedSelector).Middleware(tls.RequireSecretTypeTLS, metrics.HandlerMiddleware).HandlerFunc(tls.RenewCert) // renew (expired) TLS certificates, including the oss-acorn.io wildcard cert
+	router.Type(&storagev1.StorageClass{}).Middleware(metrics.HandlerMiddleware).HandlerFunc(volume.SyncVolumeClasses)
+	router.Type(&corev1.Service{}).Selector(managedSelector).Middleware(metrics.HandlerMiddleware).HandlerFunc(networkpolicy.ForService)
+	router.Type(&netv1.Ingress{}).Selector(managedSelector).Middleware(metrics.HandlerMiddleware).HandlerFunc(networkpolicy.ForIngress)
+	router.Type(&appsv1.Deployment{}).Namespace(system.ImagesNamespace).Middleware(metrics.HandlerMiddleware).HandlerFunc(networkpolicy.ForBuilder)
+	router.Type(&netv1.NetworkPolicy{}).Selector(managedSelector).Middleware(metrics.HandlerMiddleware).HandlerFunc(gc.GCOrphans)
 
 	configRouter := router.Type(&corev1.ConfigMap{}).Namespace(system.Namespace).Name(system.ConfigName)
-	configRouter.Handler(config.NewDNSConfigHandler())
-	configRouter.HandlerFunc(builder.DeployRegistry)
-	configRouter.HandlerFunc(config.HandleAutoUpgradeInterval)
-	configRouter.HandlerFunc(volume.CreateEphemeralVolumeClass)
+	configRouter.Middleware(metrics.HandlerMiddleware).Handler(config.NewDNSConfigHandler())
+	configRouter.Middleware(metrics.HandlerMiddleware).HandlerFunc(builder.DeployRegistry)
+	configRouter.Middleware(metrics.HandlerMiddleware).HandlerFunc(config.HandleAutoUpgradeInterval)
+	configRouter.Middleware(metrics.HandlerMiddleware).HandlerFunc(volume.CreateEphemeralVolumeClass)
 
 	return nil
 }
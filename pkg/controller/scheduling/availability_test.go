@@ -0,0 +1,33 @@
+package scheduling
+
+import (
+	"testing"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/baaah/pkg/router/tester"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContainerAvailability(t *testing.T) {
+	tester.DefaultTest(t, scheme.Scheme, "testdata/availability/container", Calculate)
+}
+
+func TestAvailabilityContradictsScaleShouldError(t *testing.T) {
+	harness, input, err := tester.FromDir(scheme.Scheme, "testdata/availability/contradicts-scale-should-error")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := harness.Invoke(t, input, router.HandlerFunc(Calculate))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.True(t, resp.NoPrune, "NoPrune should be true when error occurs")
+
+	cond := input.(*v1.AppInstance).Status.Condition(v1.AppInstanceConditionScheduling)
+	assert.True(t, cond.Error)
+	assert.Contains(t, cond.Message, "container [oneimage]: invalid availability: minAvailable 3 is greater than the 2 replicas of the container")
+}
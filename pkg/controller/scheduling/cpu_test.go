@@ -0,0 +1,41 @@
+package scheduling
+
+import (
+	"testing"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/baaah/pkg/router/tester"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContainerCPU(t *testing.T) {
+	tester.DefaultTest(t, scheme.Scheme, "testdata/cpu/container", Calculate)
+}
+
+func TestComputeClassDefaultCPU(t *testing.T) {
+	tester.DefaultTest(t, scheme.Scheme, "testdata/cpu/computeclass-default", Calculate)
+}
+
+func TestCPUSetByUser(t *testing.T) {
+	tester.DefaultTest(t, scheme.Scheme, "testdata/cpu/set-by-user", Calculate)
+}
+
+func TestCPUExceedsComputeClassMaxShouldError(t *testing.T) {
+	harness, input, err := tester.FromDir(scheme.Scheme, "testdata/cpu/exceeds-computeclass-max-should-error")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := harness.Invoke(t, input, router.HandlerFunc(Calculate))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.True(t, resp.NoPrune, "NoPrune should be true when error occurs")
+
+	cond := input.(*v1.AppInstance).Status.Condition(v1.AppInstanceConditionScheduling)
+	assert.True(t, cond.Error)
+	assert.Contains(t, cond.Message, "invalid cpu from Acornfile")
+}
@@ -1,6 +1,10 @@
 package scheduling
 
 import (
+	"errors"
+	"fmt"
+
+	"github.com/acorn-io/baaah/pkg/typed"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/computeclasses"
 	"github.com/acorn-io/runtime/pkg/condition"
@@ -9,9 +13,11 @@ import (
 	"github.com/acorn-io/baaah/pkg/router"
 	adminv1 "github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/config"
+	"github.com/acorn-io/runtime/pkg/labels"
 	corev1 "k8s.io/api/core/v1"
 	schedulingv1 "k8s.io/api/scheduling/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // Calculate is a handler that sets the scheduling rules for an AppInstance to its
@@ -48,9 +54,59 @@ func calculate(req router.Request, appInstance *v1.AppInstance) error {
 	if err := addScheduling(req, appInstance, appInstance.Status.AppSpec.Jobs); err != nil {
 		return err
 	}
+	return addAvailability(appInstance, appInstance.Status.AppSpec.Containers)
+}
+
+// addAvailability adds the disruption budget and topology spread of containers to their scheduling rules.
+// Jobs and sidecars don't support either.
+func addAvailability(appInstance *v1.AppInstance, containers map[string]v1.Container) error {
+	for _, entry := range typed.Sorted(containers) {
+		name, container := entry.Key, entry.Value
+		if err := container.Availability.Validate(replicas(container)); err != nil {
+			return fmt.Errorf("container [%s]: %w", name, err)
+		}
+		if err := container.Spread.Validate(); err != nil {
+			return fmt.Errorf("container [%s]: %w", name, err)
+		}
+
+		scheduling := appInstance.Status.Scheduling[name]
+		scheduling.Availability = container.Availability
+		scheduling.TopologySpreadConstraints = TopologySpreadConstraints(appInstance, name, container.Spread)
+		appInstance.Status.Scheduling[name] = scheduling
+	}
 	return nil
 }
 
+// replicas returns the number of replicas a container is expected to run with
+func replicas(container v1.Container) int32 {
+	if container.Autoscale != nil {
+		return container.Autoscale.GetMinReplicas(container.Scale)
+	}
+	if container.Scale != nil {
+		return *container.Scale
+	}
+	return 1
+}
+
+// TopologySpreadConstraints converts the spread of a container to constraints that select the container's pods
+func TopologySpreadConstraints(appInstance *v1.AppInstance, containerName string, spreads v1.TopologySpreads) (result []corev1.TopologySpreadConstraint) {
+	for _, spread := range spreads {
+		whenUnsatisfiable := corev1.ScheduleAnyway
+		if spread.Required {
+			whenUnsatisfiable = corev1.DoNotSchedule
+		}
+		result = append(result, corev1.TopologySpreadConstraint{
+			MaxSkew:           spread.GetMaxSkew(),
+			TopologyKey:       spread.TopologyKey(),
+			WhenUnsatisfiable: whenUnsatisfiable,
+			LabelSelector: &metav1.LabelSelector{
+				MatchLabels: labels.Managed(appInstance, labels.AcornContainerName, containerName),
+			},
+		})
+	}
+	return
+}
+
 func addScheduling(req router.Request, appInstance *v1.AppInstance, workloads map[string]v1.Container) error {
 	for name, container := range workloads {
 		var (
@@ -163,13 +219,26 @@ func ResourceRequirements(req router.Request, app *v1.AppInstance, containerName
 		requirements.Limits[corev1.ResourceMemory] = memoryQuantity
 	}
 
+	cpu, cpuErrType := v1.GetCPU(app.Spec.CPU, containerName, container)
+	var cpuQuantity resource.Quantity
+	// A CPU that was set explicitly, by the workload or as the default of the compute class, is also the limit like
+	// the memory. A CPU scaled from the memory is only requested, as it was before CPU could be set.
+	cpuLimit := cpu != nil
 	if computeClass != nil {
-		cpuQuantity, err := computeclasses.CalculateCPU(*computeClass, memDefault, memoryQuantity)
-		if err != nil {
+		cpuLimit = cpuLimit || computeClass.CPU.Default != ""
+		cpuQuantity, err = computeclasses.CalculateCPU(*computeClass, memDefault, memoryQuantity, cpu)
+		if cpu != nil && errors.Is(err, computeclasses.ErrInvalidCPUForClass) {
+			return nil, fmt.Errorf("%w: workload \"%v\": %v", cpuErrType, containerName, err)
+		} else if err != nil {
 			return nil, err
 		}
-		if cpuQuantity.Value() != 0 {
-			requirements.Requests[corev1.ResourceCPU] = cpuQuantity
+	} else if cpu != nil {
+		cpuQuantity = cpu.Quantity()
+	}
+	if !cpuQuantity.IsZero() {
+		requirements.Requests[corev1.ResourceCPU] = cpuQuantity
+		if cpuLimit {
+			requirements.Limits[corev1.ResourceCPU] = cpuQuantity
 		}
 	}
 
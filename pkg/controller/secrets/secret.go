@@ -1,17 +1,22 @@
 package secrets
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/acorn-io/baaah/pkg/router"
 	"github.com/acorn-io/baaah/pkg/typed"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/event"
 	"github.com/acorn-io/runtime/pkg/jobs"
 	"github.com/acorn-io/runtime/pkg/labels"
 	"github.com/acorn-io/runtime/pkg/secrets"
+	"github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -47,7 +52,59 @@ func addSecretError(appInstance *v1.AppInstance, secretName string, err error) {
 	appInstance.Status.AppStatus.Secrets[secretName] = c
 }
 
-func CreateSecrets(req router.Request, resp router.Response) (err error) {
+const SecretRotatedEventType = "SecretRotated"
+
+// SecretRotatedEventDetails captures additional info about the rotation of a secret.
+type SecretRotatedEventDetails struct {
+	// Secret is the name of the secret in the app that was rotated.
+	Secret string `json:"secret"`
+
+	// GracePeriod is how long the previous values are kept under keys prefixed with "previous-".
+	// +optional
+	GracePeriod string `json:"gracePeriod,omitempty"`
+}
+
+func recordRotationEvent(ctx context.Context, recorder event.Recorder, appInstance *v1.AppInstance, secretName string, secretRef v1.Secret) {
+	e := apiv1.Event{
+		Type:        SecretRotatedEventType,
+		Actor:       "acorn-system",
+		Severity:    v1.EventSeverityInfo,
+		Description: fmt.Sprintf("Rotated secret %s", secretName),
+		Source:      event.ObjectSource(appInstance),
+		Observed:    v1.MicroTime(metav1.NowMicro()),
+	}
+	e.SetNamespace(appInstance.GetNamespace())
+
+	var err error
+	if e.Details, err = v1.Mapify(SecretRotatedEventDetails{
+		Secret:      secretName,
+		GracePeriod: secretRef.Rotate.GracePeriod,
+	}); err != nil {
+		logrus.Warnf("Failed to mapify event details: %s", err.Error())
+	}
+
+	if err := recorder.Record(ctx, &e); err != nil {
+		logrus.Warnf("Failed to record event: %s", err.Error())
+	}
+}
+
+// rotatedSince returns true if the secret in the app namespace was last rotated before lastRotation
+func rotatedSince(req router.Request, appInstance *v1.AppInstance, secretName, lastRotation string) bool {
+	existing := &corev1.Secret{}
+	if err := req.Get(existing, appInstance.Status.Namespace, secretName); err != nil {
+		return false
+	}
+	previous := existing.Annotations[labels.AcornSecretLastRotation]
+	return previous != "" && previous != lastRotation
+}
+
+func CreateSecrets(recorder event.Recorder) router.HandlerFunc {
+	return func(req router.Request, resp router.Response) error {
+		return createSecrets(req, resp, recorder)
+	}
+}
+
+func createSecrets(req router.Request, resp router.Response, recorder event.Recorder) (err error) {
 	var (
 		appInstance = req.Object.(*v1.AppInstance)
 		allSecrets  = map[string]*corev1.Secret{}
@@ -60,7 +117,15 @@ func CreateSecrets(req router.Request, resp router.Response) (err error) {
 	for _, entry := range secretsOrdered(appInstance) {
 		secretName := entry.name
 
+		if err := secrets.ValidateRotation(entry.secret); err != nil {
+			addSecretError(appInstance, secretName, err)
+			continue
+		}
+
 		secret, err := secrets.GetOrCreateSecret(allSecrets, req, appInstance, secretName)
+		if entry.secret.Type == "external" && err != nil {
+			resp.RetryAfter(secrets.ExternalRetryInterval)
+		}
 		if apierrors.IsNotFound(err) {
 			if status := (*apierrors.StatusError)(nil); errors.As(err, &status) && status.ErrStatus.Details != nil {
 				if status.ErrStatus.Details.Name != "" {
@@ -100,6 +165,20 @@ func CreateSecrets(req router.Request, resp router.Response) (err error) {
 
 		annotations[labels.AcornAppGeneration] = strconv.FormatInt(appInstance.Generation, 10)
 
+		if lastRotation := secret.Annotations[labels.AcornSecretLastRotation]; entry.secret.Rotate != nil && lastRotation != "" {
+			annotations[labels.AcornSecretLastRotation] = lastRotation
+			if rotatedSince(req, appInstance, secretName, lastRotation) {
+				recordRotationEvent(req.Ctx, recorder, appInstance, secretName, entry.secret)
+			}
+		}
+
+		if due, ok := secrets.NextRefresh(entry.secret, secret); ok {
+			if due < time.Second {
+				due = time.Second
+			}
+			resp.RetryAfter(due)
+		}
+
 		resp.Objects(&corev1.Secret{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:        secretName,
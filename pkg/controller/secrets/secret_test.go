@@ -1,25 +1,48 @@
 package secrets
 
 import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/acorn-io/baaah/pkg/router/tester"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/event"
 	"github.com/acorn-io/runtime/pkg/labels"
 	"github.com/acorn-io/runtime/pkg/scheme"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+var noopRecorder = event.RecorderFunc(func(context.Context, *apiv1.Event) error {
+	return nil
+})
+
+// invokeWithDelay invokes the handler without the harness, which requires an exact delay, for tests where the delay
+// depends on the current time
+func invokeWithDelay(t *testing.T, app *v1.AppInstance, recorder event.Recorder, existing ...kclient.Object) *tester.Response {
+	t.Helper()
+	req := tester.NewRequest(t, scheme.Scheme, app, existing...)
+	resp := &tester.Response{Client: req.Client.(*tester.Client)}
+	if err := CreateSecrets(recorder)(req, resp); err != nil {
+		t.Fatal(err)
+	}
+	return resp
+}
+
 func TestSecretImageReference(t *testing.T) {
-	tester.DefaultTest(t, scheme.Scheme, "testdata/secret-image", CreateSecrets)
+	tester.DefaultTest(t, scheme.Scheme, "testdata/secret-image", CreateSecrets(noopRecorder))
 }
 
 func TestSecretEncrypted(t *testing.T) {
-	resp := tester.DefaultTest(t, scheme.Scheme, "testdata/secret-encrypted", CreateSecrets)
+	resp := tester.DefaultTest(t, scheme.Scheme, "testdata/secret-encrypted", CreateSecrets(noopRecorder))
 	secret := resp.Client.Created[0].(*corev1.Secret)
 	assert.Equal(t, "foo-", secret.GenerateName)
 	assert.Equal(t, "app-namespace", secret.Namespace)
@@ -54,7 +77,7 @@ func TestOpaque_Gen(t *testing.T) {
 				},
 			},
 		},
-	}, CreateSecrets)
+	}, CreateSecrets(noopRecorder))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -103,7 +126,7 @@ func TestBasic_Gen(t *testing.T) {
 				},
 			},
 		},
-	}, CreateSecrets)
+	}, CreateSecrets(noopRecorder))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -154,7 +177,7 @@ func TestTemplateTokenMissing_Gen(t *testing.T) {
 		},
 	}
 
-	resp, err := h.InvokeFunc(t, app, CreateSecrets)
+	resp, err := h.InvokeFunc(t, app, CreateSecrets(noopRecorder))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -205,7 +228,7 @@ func TestTemplateToken_Gen(t *testing.T) {
 				},
 			},
 		},
-	}, CreateSecrets)
+	}, CreateSecrets(noopRecorder))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -321,7 +344,7 @@ func TestSecretLabelsAnnotations(t *testing.T) {
 				},
 			},
 		},
-	}, CreateSecrets)
+	}, CreateSecrets(noopRecorder))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -375,3 +398,196 @@ func TestSecretLabelsAnnotations(t *testing.T) {
 	assert.Contains(t, secret.Annotations, "globalfromacornfilea")
 	assert.NotContains(t, secret.Annotations, "sec1fromacornfilea")
 }
+
+func TestTokenRotation(t *testing.T) {
+	lastRotation := time.Now().Add(-2 * time.Hour).UTC().Format(time.RFC3339)
+	app := &v1.AppInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "app-name",
+			Namespace: "app-ns",
+		},
+		Status: v1.AppInstanceStatus{
+			Namespace: "app-target-ns",
+			AppImage: v1.AppImage{
+				ID: "test",
+			},
+			AppSpec: v1.AppSpec{
+				Secrets: map[string]v1.Secret{
+					"pass": {
+						Type: "token",
+						Params: map[string]any{
+							"characters": "abc",
+							"length":     int64(5),
+						},
+						Rotate: &v1.SecretRotation{
+							Interval:    "1h",
+							GracePeriod: "30m",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	var recorded []*apiv1.Event
+	resp := invokeWithDelay(t, app, event.RecorderFunc(func(_ context.Context, e *apiv1.Event) error {
+		recorded = append(recorded, e)
+		return nil
+	}),
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "pass-abcde",
+				Namespace: "app-ns",
+				Labels: map[string]string{
+					labels.AcornAppName:         "app-name",
+					labels.AcornManaged:         "true",
+					labels.AcornSecretName:      "pass",
+					labels.AcornSecretGenerated: "true",
+				},
+				Annotations: map[string]string{
+					labels.AcornSecretLastRotation: lastRotation,
+				},
+			},
+			Data: map[string][]byte{
+				"token": []byte("xxxxx"),
+			},
+			Type: v1.SecretTypeToken,
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "pass",
+				Namespace: "app-target-ns",
+				Annotations: map[string]string{
+					labels.AcornSecretLastRotation: lastRotation,
+				},
+			},
+		})
+
+	assert.Len(t, resp.Client.Updated, 1)
+	secret := resp.Client.Updated[0].(*corev1.Secret)
+	assert.Len(t, secret.Data["token"], 5)
+	assert.NotEqual(t, "xxxxx", string(secret.Data["token"]))
+	assert.Equal(t, "xxxxx", string(secret.Data["previous-token"]))
+	assert.NotEqual(t, lastRotation, secret.Annotations[labels.AcornSecretLastRotation])
+
+	if assert.Len(t, recorded, 1) {
+		assert.Equal(t, SecretRotatedEventType, recorded[0].Type)
+		assert.Equal(t, "app-ns", recorded[0].Namespace)
+	}
+
+	// The next rotation is due in an hour, but the previous value should be removed in 30 minutes
+	assert.LessOrEqual(t, resp.Delay, 30*time.Minute)
+	assert.Greater(t, resp.Delay, 29*time.Minute)
+}
+
+func TestRotationUnsupportedType(t *testing.T) {
+	h := tester.Harness{
+		Scheme: scheme.Scheme,
+	}
+	app := &v1.AppInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "app-name",
+			Namespace: "app-ns",
+		},
+		Status: v1.AppInstanceStatus{
+			Namespace: "app-target-ns",
+			AppSpec: v1.AppSpec{
+				Secrets: map[string]v1.Secret{
+					"pass": {
+						Type:   "opaque",
+						Rotate: &v1.SecretRotation{Interval: "1h"},
+					},
+				},
+			},
+		},
+	}
+	resp, err := h.InvokeFunc(t, app, CreateSecrets(noopRecorder))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Len(t, resp.Client.Created, 0)
+	assert.Equal(t, []string{"rotate is only supported for secrets of type token or basic, not [opaque]"},
+		app.Status.AppStatus.Secrets["pass"].LookupErrors)
+}
+
+func TestTLS_Gen(t *testing.T) {
+	resp := invokeWithDelay(t, &v1.AppInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "app-name",
+			Namespace: "app-ns",
+		},
+		Status: v1.AppInstanceStatus{
+			Namespace: "app-target-ns",
+			AppImage: v1.AppImage{
+				ID: "test",
+			},
+			AppSpec: v1.AppSpec{
+				Containers: map[string]v1.Container{
+					"web": {},
+				},
+				Secrets: map[string]v1.Secret{
+					"ca": {
+						Type: "tls",
+					},
+					"web-cert": {
+						Type: "tls",
+						Params: map[string]any{
+							"ca":   "ca",
+							"sans": []any{"web.example.com", "10.0.0.1"},
+						},
+					},
+				},
+			},
+		},
+	}, noopRecorder)
+
+	assert.Len(t, resp.Client.Created, 3)
+	// Renewal is scheduled before the certificates expire
+	assert.Greater(t, resp.Delay, 80*24*time.Hour)
+
+	ca := resp.Client.Created[0].(*corev1.Secret)
+	assert.Equal(t, "ca", ca.Labels[labels.AcornSecretName])
+	assert.Equal(t, v1.SecretTypeTLS, ca.Type)
+	assert.NotContains(t, ca.Data, "ca.key")
+	caCert := parseCertData(t, ca.Data["ca.crt"])
+	assert.True(t, caCert.IsCA)
+
+	// the CA key is kept in a secret that is not copied into the app namespace
+	caKey := resp.Client.Created[1].(*corev1.Secret)
+	assert.Equal(t, corev1.SecretTypeOpaque, caKey.Type)
+	assert.NotEmpty(t, caKey.Data["ca.key"])
+	for _, obj := range resp.Collected {
+		if secret, ok := obj.(*corev1.Secret); ok {
+			assert.NotContains(t, secret.Data, "ca.key")
+		}
+	}
+
+	cert := parseCertData(t, ca.Data["tls.crt"])
+	assert.Equal(t, []string{"web", "web.app-target-ns", "web.app-target-ns.svc", "web.app-target-ns.svc.cluster.local"}, cert.DNSNames)
+	assert.NoError(t, cert.CheckSignatureFrom(caCert))
+
+	web := resp.Client.Created[2].(*corev1.Secret)
+	assert.Equal(t, "web-cert", web.Labels[labels.AcornSecretName])
+	assert.Equal(t, ca.Data["ca.crt"], web.Data["ca.crt"])
+	assert.NotContains(t, web.Data, "ca.key")
+	assert.NotEmpty(t, web.Data["tls.key"])
+
+	cert = parseCertData(t, web.Data["tls.crt"])
+	assert.Equal(t, []string{"web.example.com"}, cert.DNSNames)
+	assert.Equal(t, "10.0.0.1", cert.IPAddresses[0].String())
+	assert.NoError(t, cert.CheckSignatureFrom(caCert))
+}
+
+func parseCertData(t *testing.T, data []byte) *x509.Certificate {
+	t.Helper()
+	block, _ := pem.Decode(data)
+	if block == nil {
+		t.Fatal("failed to decode certificate PEM")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert
+}
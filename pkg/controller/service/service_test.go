@@ -124,3 +124,7 @@ func TestCustomCertsShouldNotSetCertManager(t *testing.T) {
 func TestCustomCertsWithAnnonationsShouldNotSetCertManagerDefaultIssuer(t *testing.T) {
 	tester.DefaultTest(t, scheme.Scheme, "testdata/ingress/customdomainwithannotations", RenderServices)
 }
+
+func TestIngressCanary(t *testing.T) {
+	tester.DefaultTest(t, scheme.Scheme, "testdata/ingress/canary", RenderServices)
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/acorn-io/baaah/pkg/router"
 	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/metrics"
 	"github.com/acorn-io/runtime/pkg/system"
 	"github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
@@ -53,6 +54,10 @@ func RequireSecretTypeTLS(h router.Handler) router.Handler {
 func RenewCert(req router.Request, resp router.Response) error {
 	sec := req.Object.(*corev1.Secret)
 
+	if notAfter, err := time.Parse(time.RFC3339, sec.Annotations[labels.AcornCertNotValidAfter]); err == nil {
+		metrics.CertificateExpiry.WithLabelValues(sec.Namespace, sec.Name, sec.Annotations[labels.AcornDomain]).Set(float64(notAfter.Unix()))
+	}
+
 	leUser, err := ensureLEUser(req.Ctx, req.Client)
 	if err != nil {
 		logrus.Errorf("failed to get/create lets-encrypt account in RenewCert: %v", err)
@@ -48,24 +48,8 @@ func EnsureReferences(ctx context.Context, c client.Reader, img string, opts *Ve
 		opts = &VerifyOpts{}
 	}
 
-	if opts.ImageRef.Identifier() == "" {
-		// --- image name to digest hash
-		imgRef, err := name.ParseReference(img)
-		if err != nil {
-			return fmt.Errorf("failed to parse image %s: %w", img, err)
-		}
-
-		// in the best case, we have a digest ref already, so we don't need to do any external request
-		if imgDigest, ok := imgRef.(name.Digest); ok {
-			opts.ImageRef = imgDigest
-		} else {
-			imgDigest, err := crane.Digest(imgRef.Name(), opts.CraneOpts...) // this uses HEAD to determine the digest, but falls back to GET if HEAD fails
-			if err != nil {
-				return fmt.Errorf("failed to resolve image digest: %w", err)
-			}
-
-			opts.ImageRef = imgRef.Context().Digest(imgDigest)
-		}
+	if err := EnsureImageDigest(img, opts); err != nil {
+		return err
 	}
 
 	if opts.SignatureRef == nil || opts.SignatureRef.Identifier() == "" {
@@ -79,6 +63,33 @@ func EnsureReferences(ctx context.Context, c client.Reader, img string, opts *Ve
 	return nil
 }
 
+// EnsureImageDigest will enrich the VerifyOpts with the image digest only, for checks that don't need the signature
+func EnsureImageDigest(img string, opts *VerifyOpts) error {
+	if opts.ImageRef.Identifier() != "" {
+		return nil
+	}
+
+	// --- image name to digest hash
+	imgRef, err := name.ParseReference(img)
+	if err != nil {
+		return fmt.Errorf("failed to parse image %s: %w", img, err)
+	}
+
+	// in the best case, we have a digest ref already, so we don't need to do any external request
+	if imgDigest, ok := imgRef.(name.Digest); ok {
+		opts.ImageRef = imgDigest
+		return nil
+	}
+
+	imgDigest, err := crane.Digest(imgRef.Name(), opts.CraneOpts...) // this uses HEAD to determine the digest, but falls back to GET if HEAD fails
+	if err != nil {
+		return fmt.Errorf("failed to resolve image digest: %w", err)
+	}
+
+	opts.ImageRef = imgRef.Context().Digest(imgDigest)
+	return nil
+}
+
 func ensureSignatureArtifact(ctx context.Context, c client.Reader, namespace string, img name.Digest, noCache bool, ociRemoteOpts []ociremote.Option, craneOpts []crane.Option) (name.Reference, error) {
 	// -- signature hash
 	sigTag, err := ociremote.SignatureTag(img, ociRemoteOpts...) // we force imgRef to be a digest above, so this should *not* make a GET request to the registry
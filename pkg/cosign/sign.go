@@ -0,0 +1,158 @@
+package cosign
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/sigstore/cosign/v2/pkg/cosign"
+	cremote "github.com/sigstore/cosign/v2/pkg/cosign/remote"
+	"github.com/sigstore/cosign/v2/pkg/oci"
+	"github.com/sigstore/cosign/v2/pkg/oci/mutate"
+	ociremote "github.com/sigstore/cosign/v2/pkg/oci/remote"
+	"github.com/sigstore/cosign/v2/pkg/oci/static"
+	cosignature "github.com/sigstore/cosign/v2/pkg/signature"
+	"github.com/sigstore/sigstore/pkg/signature"
+	"github.com/sigstore/sigstore/pkg/signature/payload"
+	"github.com/sirupsen/logrus"
+)
+
+type SignOpts struct {
+	Key           string
+	PassFunc      cosign.PassFunc
+	Annotations   map[string]interface{}
+	OciRemoteOpts []ociremote.Option
+	CraneOpts     []crane.Option
+}
+
+// LoadSigner is the counterpart of LoadKey for private keys. Next to the key references supported by cosign, it
+// accepts an inline PEM encoded cosign private key.
+func LoadSigner(ctx context.Context, keyRef string, pf cosign.PassFunc) (signature.SignerVerifier, error) {
+	if strings.HasPrefix(strings.TrimSpace(keyRef), "-----BEGIN") {
+		// no scheme, inline PEM
+		var pass []byte
+		if pf != nil {
+			var err error
+			if pass, err = pf(false); err != nil {
+				return nil, fmt.Errorf("failed to get password for private key: %w", err)
+			}
+		}
+		sv, err := cosign.LoadPrivateKey([]byte(strings.TrimSpace(keyRef)), pass)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load private key from PEM: %w", err)
+		}
+		return sv, nil
+	}
+
+	// schemes: k8s://, pkcs11://, gitlab://, KMS providers or a path to a file
+	sv, err := cosignature.SignerVerifierFromKeyRef(ctx, keyRef, pf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load private key from %s: %w", keyRef, err)
+	}
+	return sv, nil
+}
+
+// SignImage signs the image and, if it is an index like an Acorn image, all images nested in it, so that every image
+// that is pulled to run the app can be verified. The digests of all signed images are returned.
+func SignImage(ctx context.Context, img string, opts SignOpts) ([]name.Digest, error) {
+	verifyOpts := &VerifyOpts{
+		CraneOpts: opts.CraneOpts,
+	}
+	if err := EnsureImageDigest(img, verifyOpts); err != nil {
+		return nil, err
+	}
+
+	sv, err := LoadSigner(ctx, opts.Key, opts.PassFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	se, err := ociremote.SignedEntity(verifyOpts.ImageRef, opts.OciRemoteOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get image %s: %w", verifyOpts.ImageRef, err)
+	}
+
+	var signed []name.Digest
+	err = walkSignedEntities(verifyOpts.ImageRef, se, func(digest name.Digest, se oci.SignedEntity) error {
+		if err := signEntity(digest, se, sv, opts); err != nil {
+			return fmt.Errorf("failed to sign %s: %w", digest, err)
+		}
+		logrus.Debugf("Signed image %s", digest)
+		signed = append(signed, digest)
+		return nil
+	})
+	return signed, err
+}
+
+// walkSignedEntities calls fn for the given entity and, if it is an index, recursively for all manifests in it
+func walkSignedEntities(digest name.Digest, se oci.SignedEntity, fn func(name.Digest, oci.SignedEntity) error) error {
+	if err := fn(digest, se); err != nil {
+		return err
+	}
+
+	index, ok := se.(oci.SignedImageIndex)
+	if !ok {
+		return nil
+	}
+
+	manifest, err := index.IndexManifest()
+	if err != nil {
+		return err
+	}
+
+	for _, desc := range manifest.Manifests {
+		var (
+			child oci.SignedEntity
+			err   error
+		)
+		switch {
+		case desc.MediaType.IsIndex():
+			child, err = index.SignedImageIndex(desc.Digest)
+		case desc.MediaType.IsImage():
+			child, err = index.SignedImage(desc.Digest)
+		default:
+			logrus.Debugf("Skipping signing of %s in %s with unsupported media type %s", desc.Digest, digest, desc.MediaType)
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get image %s nested in %s: %w", desc.Digest, digest, err)
+		}
+		if err := walkSignedEntities(digest.Context().Digest(desc.Digest.String()), child, fn); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func signEntity(digest name.Digest, se oci.SignedEntity, sv signature.SignerVerifier, opts SignOpts) error {
+	pld, err := (&payload.Cosign{
+		Image:       digest,
+		Annotations: opts.Annotations,
+	}).MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("failed to create payload: %w", err)
+	}
+
+	sig, err := sv.SignMessage(bytes.NewReader(pld))
+	if err != nil {
+		return fmt.Errorf("failed to sign payload: %w", err)
+	}
+
+	ociSig, err := static.NewSignature(pld, base64.StdEncoding.EncodeToString(sig))
+	if err != nil {
+		return err
+	}
+
+	// the dupe detector makes signing the same image with the same key and annotations again a no-op
+	newSE, err := mutate.AttachSignatureToEntity(se, ociSig, mutate.WithDupeDetector(cremote.NewDupeDetector(sv)))
+	if err != nil {
+		return err
+	}
+
+	return ociremote.WriteSignatures(digest.Repository, newSE, opts.OciRemoteOpts...)
+}
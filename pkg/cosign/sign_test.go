@@ -0,0 +1,84 @@
+package cosign
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"io"
+	"log"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+)
+
+//go:embed testdata/validkey1.key
+var VALIDKEY1PRIVATE string
+
+func TestSignImage(t *testing.T) {
+	// Set up a fake registry.
+	s := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	defer s.Close()
+	u, err := url.Parse(s.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// An index with nested images, like an Acorn image
+	index, err := random.Index(64, 1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	imgName := fmt.Sprintf("%s/library/app:latest", u.Host)
+	ref, err := name.ParseReference(imgName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := remote.WriteIndex(ref, index); err != nil {
+		t.Fatal(err)
+	}
+
+	signed, err := SignImage(context.Background(), imgName, SignOpts{
+		Key: VALIDKEY1PRIVATE,
+		Annotations: map[string]interface{}{
+			"tag": "ok",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(signed) != 3 {
+		t.Fatalf("expected the index and 2 nested images to be signed, got %d", len(signed))
+	}
+
+	for _, digest := range signed {
+		opts := VerifyOpts{
+			Key:                VALIDKEY1,
+			SignatureAlgorithm: "sha256",
+			NoCache:            true,
+			AnnotationRules: v1.SignatureAnnotations{
+				Match: map[string]string{
+					"tag": "ok",
+				},
+			},
+		}
+		if err := EnsureReferences(context.Background(), nil, digest.Name(), &opts); err != nil {
+			t.Fatal(err)
+		}
+		if err := VerifySignature(context.Background(), opts); err != nil {
+			t.Fatalf("failed to verify signature of %s: %v", digest, err)
+		}
+
+		opts.Key = INVALIDKEY1
+		if err := VerifySignature(context.Background(), opts); err == nil {
+			t.Fatalf("expected signature of %s to not be verified with another key", digest)
+		}
+	}
+}
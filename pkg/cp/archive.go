@@ -0,0 +1,274 @@
+package cp
+
+import (
+	"archive/tar"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// archiveEntry is a file, directory or symlink of a tarball with the header it is written with
+type archiveEntry struct {
+	path string
+	hdr  *tar.Header
+}
+
+// archiveEntries walks the file or directory src and returns the entries of a tarball of it, named name in the
+// tarball. Modes and modification times are kept, ownership is left to the user that extracts the tarball. The
+// headers, including the sizes of the files, are taken once so that the tarball has the same size when it is counted
+// and when it is written.
+func archiveEntries(src, name string) (result []archiveEntry, _ error) {
+	err := filepath.WalkDir(src, func(file string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		var link string
+		switch {
+		case info.Mode()&fs.ModeSymlink != 0:
+			if link, err = os.Readlink(file); err != nil {
+				return err
+			}
+		case !info.Mode().IsRegular() && !info.IsDir():
+			// sockets, devices and pipes can't be copied
+			return nil
+		}
+
+		rel, err := filepath.Rel(src, file)
+		if err != nil {
+			return err
+		}
+
+		hdr, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			return err
+		}
+		hdr.Name = path.Join(name, filepath.ToSlash(rel))
+		if info.IsDir() {
+			hdr.Name += "/"
+		}
+		hdr.Uid, hdr.Gid, hdr.Uname, hdr.Gname = 0, 0, "", ""
+		// whole seconds fit in the plain header that every tar can read
+		hdr.ModTime = hdr.ModTime.Truncate(time.Second)
+
+		result = append(result, archiveEntry{
+			path: file,
+			hdr:  hdr,
+		})
+		return nil
+	})
+	return result, err
+}
+
+// writeArchive writes the entries to w as a tarball. The contents of files are read with open. A file that can't be
+// read to the size in its header, because it shrank or was removed, is padded with zeros so that the tarball keeps
+// its size, and reported in the returned error once the whole tarball is written.
+func writeArchive(entries []archiveEntry, w io.Writer, open func(path string) (io.ReadCloser, error)) error {
+	tw := tar.NewWriter(w)
+
+	var changed []string
+	for _, entry := range entries {
+		if err := tw.WriteHeader(entry.hdr); err != nil {
+			return err
+		}
+		if entry.hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		n, err := copyFile(tw, entry, open)
+		if err != nil {
+			return err
+		}
+		if n < entry.hdr.Size {
+			changed = append(changed, entry.path)
+			if _, err := io.CopyN(tw, zeros{}, entry.hdr.Size-n); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	if len(changed) > 0 {
+		return fmt.Errorf("%s changed while it was copied", strings.Join(changed, ", "))
+	}
+	return nil
+}
+
+// copyFile copies up to the size in the header of the entry from the file to w and returns how much was copied. Only
+// errors writing to w are returned, a file that can't be read is copied as far as it could be read.
+func copyFile(w io.Writer, entry archiveEntry, open func(path string) (io.ReadCloser, error)) (int64, error) {
+	f, err := open(entry.path)
+	if err != nil {
+		return 0, nil
+	}
+	defer f.Close()
+
+	return io.Copy(w, io.LimitReader(readErrorsAsEOF{f}, entry.hdr.Size))
+}
+
+// readErrorsAsEOF ends the reader on the first error, so that io.Copy only returns the errors of the writer
+type readErrorsAsEOF struct {
+	io.Reader
+}
+
+func (r readErrorsAsEOF) Read(b []byte) (int, error) {
+	n, err := r.Reader.Read(b)
+	if err != nil {
+		err = io.EOF
+	}
+	return n, err
+}
+
+func openFile(path string) (io.ReadCloser, error) {
+	return os.Open(path)
+}
+
+// archiveSize returns the size of the tarball that writeArchive writes for the entries, without reading the files
+func archiveSize(entries []archiveEntry) (int64, error) {
+	counter := &countingWriter{}
+	err := writeArchive(entries, counter, func(string) (io.ReadCloser, error) {
+		return io.NopCloser(zeros{}), nil
+	})
+	return counter.n, err
+}
+
+type countingWriter struct {
+	n int64
+}
+
+func (c *countingWriter) Write(b []byte) (int, error) {
+	c.n += int64(len(b))
+	return len(b), nil
+}
+
+type zeros struct{}
+
+func (zeros) Read(b []byte) (int, error) {
+	for i := range b {
+		b[i] = 0
+	}
+	return len(b), nil
+}
+
+// extractArchive extracts the tarball into dir. If rename is set, the top level entry of the tarball is extracted
+// with that name.
+func extractArchive(r io.Reader, dir, rename string) error {
+	dir = filepath.Clean(dir)
+
+	var (
+		tr        = tar.NewReader(r)
+		dirModes  = map[string]*tar.Header{}
+		dirsOrder []string
+	)
+
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		name := path.Clean(hdr.Name)
+		if name == "." || path.IsAbs(name) || name == ".." || strings.HasPrefix(name, "../") {
+			return fmt.Errorf("invalid path %q in copy", hdr.Name)
+		}
+		if rename != "" {
+			_, rest, _ := strings.Cut(name, "/")
+			name = path.Join(rename, rest)
+		}
+		target := filepath.Join(dir, filepath.FromSlash(name))
+		// an entry must not be written through a symlink that points outside of dir
+		if err := checkInside(dir, filepath.Dir(target)); err != nil {
+			return err
+		}
+
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0700); err != nil {
+				return err
+			}
+			if _, ok := dirModes[target]; !ok {
+				dirsOrder = append(dirsOrder, target)
+			}
+			dirModes[target] = hdr
+		case tar.TypeReg:
+			if err := writeFile(target, hdr, tr); err != nil {
+				return err
+			}
+		case tar.TypeSymlink:
+			if err := os.Remove(target); err != nil && !errors.Is(err, fs.ErrNotExist) {
+				return err
+			}
+			if err := os.Symlink(hdr.Linkname, target); err != nil {
+				return err
+			}
+		}
+	}
+
+	// directories get their modes last, so that files can be created in read only directories
+	for i := len(dirsOrder) - 1; i >= 0; i-- {
+		hdr := dirModes[dirsOrder[i]]
+		if err := os.Chmod(dirsOrder[i], hdr.FileInfo().Mode().Perm()); err != nil {
+			return err
+		}
+		if err := os.Chtimes(dirsOrder[i], hdr.ModTime, hdr.ModTime); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkInside(dir, target string) error {
+	for p := target; ; p = filepath.Dir(p) {
+		if p == dir || len(p) < len(dir) {
+			return nil
+		}
+		if info, err := os.Lstat(p); err == nil && info.Mode()&fs.ModeSymlink != 0 {
+			return fmt.Errorf("can not copy into %s, it is a symlink", p)
+		}
+	}
+}
+
+func writeFile(target string, hdr *tar.Header, r io.Reader) error {
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return err
+	}
+
+	// a symlink in the way is replaced rather than followed
+	if info, err := os.Lstat(target); err == nil && info.Mode()&fs.ModeSymlink != 0 {
+		if err := os.Remove(target); err != nil {
+			return err
+		}
+	}
+
+	f, err := os.OpenFile(target, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Chmod(target, hdr.FileInfo().Mode().Perm()); err != nil {
+		return err
+	}
+	return os.Chtimes(target, hdr.ModTime, hdr.ModTime)
+}
@@ -0,0 +1,271 @@
+package cp
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/acorn-io/runtime/pkg/client"
+)
+
+// Location is a path on the local disk, or a path in a container replica if Replica is set
+type Location struct {
+	Replica string
+	Path    string
+}
+
+func (l Location) IsRemote() bool {
+	return l.Replica != ""
+}
+
+func (l Location) String() string {
+	if l.IsRemote() {
+		return l.Replica + ":" + l.Path
+	}
+	return l.Path
+}
+
+// ParseLocation parses REPLICA:PATH as a path in a container replica. Anything else, including paths with a slash
+// before the first colon and Windows drive letters, is a local path.
+func ParseLocation(arg string) Location {
+	replica, p, ok := strings.Cut(arg, ":")
+	if !ok || len(replica) < 2 || strings.ContainsAny(replica, `/\`) {
+		return Location{Path: arg}
+	}
+	return Location{
+		Replica: replica,
+		Path:    p,
+	}
+}
+
+// Copy copies a file or directory from the local disk into a container replica, or from a container replica to the
+// local disk. Files are streamed as a tarball over an exec session, so the container must have sh and tar. The
+// returned channel reports the progress of the copy and is closed when the copy is done.
+func Copy(ctx context.Context, c client.Client, src, dst Location) (<-chan client.ImageProgress, error) {
+	switch {
+	case src.IsRemote() == dst.IsRemote():
+		return nil, fmt.Errorf("one of %s and %s must be a path in a container, in the form REPLICA:PATH", src, dst)
+	case src.Path == "" || dst.Path == "":
+		return nil, fmt.Errorf("a path is required to copy from %s to %s", src, dst)
+	}
+
+	progress := make(chan client.ImageProgress, 1)
+	go func() {
+		defer close(progress)
+
+		var err error
+		if dst.IsRemote() {
+			err = upload(ctx, c, src.Path, dst, progress)
+		} else {
+			err = download(ctx, c, src, dst.Path, progress)
+		}
+		if err != nil {
+			progress <- client.ImageProgress{
+				Error: err.Error(),
+			}
+		}
+	}()
+
+	return progress, nil
+}
+
+// upload copies the local file or directory into the replica. Like cp, the source is copied into the destination if
+// it is an existing directory, otherwise the destination is the name of the copy.
+func upload(ctx context.Context, c client.Client, src string, dst Location, progress chan<- client.ImageProgress) error {
+	src, err := filepath.Abs(src)
+	if err != nil {
+		return err
+	}
+	if _, err := os.Lstat(src); err != nil {
+		return err
+	}
+
+	dir, name := path.Dir(dst.Path), path.Base(dst.Path)
+	if isDir, err := remoteIsDir(ctx, c, dst); err != nil {
+		return err
+	} else if isDir {
+		dir, name = dst.Path, filepath.Base(src)
+	}
+
+	entries, err := archiveEntries(src, name)
+	if err != nil {
+		return err
+	}
+
+	// The stdin of an exec session can't be closed without ending the session, so the size of the tarball is sent
+	// ahead for the container to know when it ends. The tarball is written with exactly that size, even if files
+	// change while they are copied, or the container would wait for the rest of it forever.
+	size, err := archiveSize(entries)
+	if err != nil {
+		return err
+	}
+
+	r, w := io.Pipe()
+	archiveErr := make(chan error, 1)
+	go func() {
+		err := writeArchive(entries, w, openFile)
+		archiveErr <- err
+		w.CloseWithError(err)
+	}()
+
+	err = exec(ctx, c, dst.Replica, []string{"sh", "-c", `head -c "$1" | tar -xf - -C "$2"`, "acorn-cp", strconv.FormatInt(size, 10), dir},
+		&progressReader{
+			Reader:   r,
+			total:    size,
+			progress: progress,
+		}, io.Discard)
+	// unblock the writing of the tarball if the container didn't read all of it
+	_ = r.Close()
+	if archiveErr := <-archiveErr; err == nil {
+		err = archiveErr
+	}
+	return err
+}
+
+// download copies the file or directory in the replica to the local disk. Like cp, the source is copied into the
+// destination if it is an existing directory, otherwise the destination is the name of the copy.
+func download(ctx context.Context, c client.Client, src Location, dst string, progress chan<- client.ImageProgress) error {
+	dir, rename := filepath.Dir(dst), filepath.Base(dst)
+	if info, err := os.Stat(dst); err == nil && info.IsDir() {
+		dir, rename = dst, ""
+	}
+
+	// The size is only used to show the progress of the download, so a container that can't tell it is fine
+	total, _ := remoteSize(ctx, c, src)
+
+	r, w := io.Pipe()
+	extracted := make(chan error, 1)
+	go func() {
+		err := extractArchive(&progressReader{
+			Reader:   r,
+			total:    total,
+			progress: progress,
+		}, dir, rename)
+		// drain the rest of the tarball so that the exec session isn't blocked
+		_, _ = io.Copy(io.Discard, r)
+		extracted <- err
+	}()
+
+	err := exec(ctx, c, src.Replica, []string{"tar", "-cf", "-", "-C", path.Dir(src.Path), path.Base(src.Path)}, nil, w)
+	_ = w.CloseWithError(err)
+	if extractErr := <-extracted; err == nil {
+		err = extractErr
+	}
+	if err == nil && total > 0 {
+		// the size is approximate, so the copy may be done before the progress says so
+		progress <- client.ImageProgress{
+			Total:    total,
+			Complete: total,
+		}
+	}
+	return err
+}
+
+// remoteIsDir returns true if the path is an existing directory in the replica
+func remoteIsDir(ctx context.Context, c client.Client, l Location) (bool, error) {
+	err := exec(ctx, c, l.Replica, []string{"sh", "-c", `test -d "$1"`, "acorn-cp", l.Path}, nil, io.Discard)
+	if exitErr := (*ExitError)(nil); errors.As(err, &exitErr) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// remoteSize returns the approximate size in bytes of the path in the replica
+func remoteSize(ctx context.Context, c client.Client, l Location) (int64, error) {
+	out := &bytes.Buffer{}
+	if err := exec(ctx, c, l.Replica, []string{"du", "-sk", l.Path}, nil, out); err != nil {
+		return 0, err
+	}
+	kb, err := strconv.ParseInt(strings.Fields(out.String() + " ")[0], 10, 64)
+	return kb * 1024, err
+}
+
+// ExitError is returned when a command in the container exits with a non-zero exit code
+type ExitError struct {
+	Code   int
+	Stderr string
+}
+
+func (e *ExitError) Error() string {
+	if e.Stderr != "" {
+		return e.Stderr
+	}
+	return fmt.Sprintf("command exited with code %d", e.Code)
+}
+
+// exec runs the command in the replica, writing stdin to it and its output to stdout. The command must end on its own,
+// the stdin of an exec session can't be closed.
+func exec(ctx context.Context, c client.Client, replica string, args []string, stdin io.Reader, stdout io.Writer) error {
+	cIO, err := c.ContainerReplicaExec(ctx, replica, args, false, nil)
+	if err != nil {
+		return err
+	}
+	defer cIO.Stdin.Close()
+
+	if stdin != nil {
+		go func() {
+			_, _ = io.Copy(cIO.Stdin, stdin)
+		}()
+	}
+
+	stderr := make(chan string, 1)
+	go func() {
+		data, _ := io.ReadAll(cIO.Stderr)
+		stderr <- strings.TrimSpace(string(data))
+	}()
+
+	if _, err := io.Copy(stdout, cIO.Stdout); err != nil {
+		return err
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case exit := <-cIO.ExitCode:
+		if exit.Err != nil {
+			return exit.Err
+		} else if exit.Code != 0 {
+			return &ExitError{
+				Code:   exit.Code,
+				Stderr: <-stderr,
+			}
+		}
+	}
+	return nil
+}
+
+// progressReader reports the number of bytes read from the reader
+type progressReader struct {
+	io.Reader
+	total    int64
+	complete int64
+	progress chan<- client.ImageProgress
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.Reader.Read(b)
+	if n > 0 {
+		p.complete += int64(n)
+		if p.total > 0 {
+			p.progress <- client.ImageProgress{
+				Total:    p.total,
+				Complete: min(p.complete, p.total),
+			}
+		}
+	}
+	return n, err
+}
+
+func min(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
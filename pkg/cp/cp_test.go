@@ -0,0 +1,235 @@
+package cp
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+	osexec "os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/acorn-io/runtime/pkg/client/term"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// localExecClient runs the commands of exec sessions on the local machine, like a container would
+type localExecClient struct {
+	client.Client
+}
+
+func (l *localExecClient) ContainerReplicaExec(_ context.Context, _ string, args []string, _ bool, _ *client.ContainerReplicaExecOptions) (*term.ExecIO, error) {
+	cmd := osexec.Command(args[0], args[1:]...)
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	stdoutR, stdoutW := io.Pipe()
+	stderrR, stderrW := io.Pipe()
+	cmd.Stdout, cmd.Stderr = stdoutW, stderrW
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+
+	exit := make(chan term.ExitCode, 1)
+	go func() {
+		err := cmd.Wait()
+		_ = stdoutW.Close()
+		_ = stderrW.Close()
+		var exitErr *osexec.ExitError
+		if errors.As(err, &exitErr) {
+			exit <- term.ExitCode{Code: exitErr.ExitCode()}
+		} else {
+			exit <- term.ExitCode{Err: err}
+		}
+	}()
+
+	return &term.ExecIO{
+		Stdin:    stdin,
+		Stdout:   stdoutR,
+		Stderr:   stderrR,
+		ExitCode: exit,
+	}, nil
+}
+
+func requireCommands(t *testing.T) {
+	for _, cmd := range []string{"sh", "head", "tar", "du"} {
+		if _, err := osexec.LookPath(cmd); err != nil {
+			t.Skipf("%s is required: %v", cmd, err)
+		}
+	}
+}
+
+func runCopy(t *testing.T, src, dst Location) error {
+	progress, err := Copy(context.Background(), &localExecClient{}, src, dst)
+	if err != nil {
+		return err
+	}
+
+	var last client.ImageProgress
+	for update := range progress {
+		if update.Error != "" {
+			return errors.New(update.Error)
+		}
+		assert.LessOrEqual(t, update.Complete, update.Total)
+		last = update
+	}
+	assert.Equal(t, last.Total, last.Complete)
+	return nil
+}
+
+func writeTree(t *testing.T, dir string, files map[string]string) {
+	for name, content := range files {
+		p := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0755))
+		require.NoError(t, os.WriteFile(p, []byte(content), 0644))
+	}
+}
+
+func readTree(t *testing.T, dir string) map[string]string {
+	result := map[string]string{}
+	require.NoError(t, filepath.Walk(dir, func(p string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, _ := filepath.Rel(dir, p)
+		if info.Mode()&os.ModeSymlink != 0 {
+			link, err := os.Readlink(p)
+			result[rel] = "-> " + link
+			return err
+		}
+		data, err := os.ReadFile(p)
+		result[rel] = string(data)
+		return err
+	}))
+	return result
+}
+
+func TestParseLocation(t *testing.T) {
+	for arg, expected := range map[string]Location{
+		"app.web-abc:/tmp/heap": {Replica: "app.web-abc", Path: "/tmp/heap"},
+		"my-app:config":         {Replica: "my-app", Path: "config"},
+		"./dir:with:colons":     {Path: "./dir:with:colons"},
+		"/tmp/file":             {Path: "/tmp/file"},
+		`C:\Users\file`:         {Path: `C:\Users\file`},
+		"file":                  {Path: "file"},
+	} {
+		assert.Equal(t, expected, ParseLocation(arg), arg)
+	}
+}
+
+func TestCopyRequiresOneRemote(t *testing.T) {
+	_, err := Copy(context.Background(), &localExecClient{}, ParseLocation("a"), ParseLocation("b"))
+	assert.Error(t, err)
+
+	_, err = Copy(context.Background(), &localExecClient{}, ParseLocation("app:a"), ParseLocation("app:b"))
+	assert.Error(t, err)
+
+	_, err = Copy(context.Background(), &localExecClient{}, ParseLocation("a"), ParseLocation("app:"))
+	assert.Error(t, err)
+}
+
+func TestUploadDirectory(t *testing.T) {
+	requireCommands(t)
+
+	src, remote := filepath.Join(t.TempDir(), "config"), t.TempDir()
+	writeTree(t, src, map[string]string{
+		"app.yaml":     "app",
+		"sub/db.yaml":  "db",
+		"sub/run.sh":   "#!/bin/sh",
+		"sub/deep/key": "key",
+	})
+	require.NoError(t, os.Chmod(filepath.Join(src, "sub/run.sh"), 0755))
+	require.NoError(t, os.Symlink("app.yaml", filepath.Join(src, "link")))
+
+	// an existing directory is copied into
+	require.NoError(t, runCopy(t, Location{Path: src}, Location{Replica: "replica", Path: remote}))
+	assert.Equal(t, readTree(t, src), readTree(t, filepath.Join(remote, "config")))
+
+	info, err := os.Stat(filepath.Join(remote, "config/sub/run.sh"))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0755), info.Mode().Perm())
+
+	// otherwise the destination is the name of the copy
+	require.NoError(t, runCopy(t, Location{Path: src}, Location{Replica: "replica", Path: filepath.Join(remote, "renamed")}))
+	assert.Equal(t, readTree(t, src), readTree(t, filepath.Join(remote, "renamed")))
+}
+
+func TestDownloadFile(t *testing.T) {
+	requireCommands(t)
+
+	remote, local := t.TempDir(), t.TempDir()
+	writeTree(t, remote, map[string]string{"heap.hprof": "heap dump"})
+	require.NoError(t, os.Chmod(filepath.Join(remote, "heap.hprof"), 0600))
+
+	require.NoError(t, runCopy(t, Location{Replica: "replica", Path: filepath.Join(remote, "heap.hprof")}, Location{Path: local}))
+	data, err := os.ReadFile(filepath.Join(local, "heap.hprof"))
+	require.NoError(t, err)
+	assert.Equal(t, "heap dump", string(data))
+
+	info, err := os.Stat(filepath.Join(local, "heap.hprof"))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	require.NoError(t, runCopy(t, Location{Replica: "replica", Path: filepath.Join(remote, "heap.hprof")}, Location{Path: filepath.Join(local, "dump")}))
+	data, err = os.ReadFile(filepath.Join(local, "dump"))
+	require.NoError(t, err)
+	assert.Equal(t, "heap dump", string(data))
+}
+
+func TestDownloadDirectory(t *testing.T) {
+	requireCommands(t)
+
+	remote, local := t.TempDir(), t.TempDir()
+	writeTree(t, filepath.Join(remote, "logs"), map[string]string{
+		"a.log":     "a",
+		"old/b.log": "b",
+	})
+
+	require.NoError(t, runCopy(t, Location{Replica: "replica", Path: filepath.Join(remote, "logs")}, Location{Path: filepath.Join(local, "copy")}))
+	assert.Equal(t, readTree(t, filepath.Join(remote, "logs")), readTree(t, filepath.Join(local, "copy")))
+}
+
+func TestDownloadMissingFile(t *testing.T) {
+	requireCommands(t)
+
+	err := runCopy(t, Location{Replica: "replica", Path: filepath.Join(t.TempDir(), "dne")}, Location{Path: t.TempDir()})
+	assert.ErrorContains(t, err, "dne")
+}
+
+func TestArchiveKeepsSizeWhenFilesChange(t *testing.T) {
+	src := filepath.Join(t.TempDir(), "data")
+	writeTree(t, src, map[string]string{
+		"shrinks": "0123456789",
+		"removed": "0123456789",
+		"grows":   "0123456789",
+	})
+
+	entries, err := archiveEntries(src, "data")
+	require.NoError(t, err)
+	size, err := archiveSize(entries)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(src, "shrinks"), []byte("01234"), 0644))
+	require.NoError(t, os.Remove(filepath.Join(src, "removed")))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "grows"), []byte("01234567890123456789"), 0644))
+
+	buf := &bytes.Buffer{}
+	err = writeArchive(entries, buf, openFile)
+	assert.ErrorContains(t, err, "changed while it was copied")
+	assert.ErrorContains(t, err, "shrinks")
+	assert.ErrorContains(t, err, "removed")
+	assert.NotContains(t, err.Error(), "grows")
+	assert.Equal(t, size, int64(buf.Len()))
+
+	dst := t.TempDir()
+	require.NoError(t, extractArchive(buf, dst, ""))
+	assert.Equal(t, map[string]string{
+		"data/shrinks": "01234\x00\x00\x00\x00\x00",
+		"data/removed": "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+		"data/grows":   "0123456789",
+	}, readTree(t, dst))
+}
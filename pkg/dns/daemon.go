@@ -8,6 +8,7 @@ import (
 	"github.com/acorn-io/baaah/pkg/router"
 	"github.com/acorn-io/runtime/pkg/config"
 	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/metrics"
 	"github.com/acorn-io/runtime/pkg/system"
 	"github.com/acorn-io/runtime/pkg/version"
 	"github.com/sirupsen/logrus"
@@ -105,6 +106,7 @@ func (d *Daemon) internal(ctx context.Context) (bool, error) {
 
 	dnsClient := NewClient()
 	response, err := dnsClient.Renew(*cfg.AcornDNSEndpoint, domain, token, RenewRequest{Records: recordRequests, Version: version.Get().Tag})
+	metrics.DNSRenewals.WithLabelValues(metrics.Result(err)).Inc()
 	if err != nil {
 		if IsDomainAuthError(err) {
 			if err := ClearDNSToken(ctx, d.client, dnsSecret); err != nil {
@@ -114,6 +116,7 @@ func (d *Daemon) internal(ctx context.Context) (bool, error) {
 		logrus.Errorf("Failed to complete DNS renew call with error: %v", err)
 		return false, nil
 	}
+	metrics.DNSLastRenewal.WithLabelValues(domain).SetToCurrentTime()
 
 	for _, outOfSync := range response.OutOfSyncRecords {
 		i, ok := ingressMap[outOfSync]
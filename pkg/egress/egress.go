@@ -0,0 +1,83 @@
+package egress
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+)
+
+// ErrDestinationNotAllowed is returned when connecting to an address that is not public
+var ErrDestinationNotAllowed = errors.New("destination is not allowed")
+
+// sharedAddressSpace is used for carrier-grade NAT, and by some clusters for the addresses of pods or services
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// Allowed returns whether the address is public. Loopback, link-local, private and other addresses that reach the
+// controller itself, the cluster or the network of its nodes, such as the kube API or the metadata endpoints of cloud
+// providers, are not.
+func Allowed(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	return ip.IsValid() &&
+		!ip.IsUnspecified() &&
+		!ip.IsLoopback() &&
+		!ip.IsLinkLocalUnicast() &&
+		!ip.IsMulticast() &&
+		!ip.IsPrivate() &&
+		!sharedAddressSpace.Contains(ip) &&
+		!(ip.Is4() && ip.As4()[0] == 0)
+}
+
+// ValidateHost returns an error if the host is an IP address or localhost that is not allowed. Other host names are
+// checked when they are resolved by the dialer.
+func ValidateHost(host string) error {
+	if host == "localhost" {
+		return fmt.Errorf("%w: %s", ErrDestinationNotAllowed, host)
+	}
+	if ip, err := netip.ParseAddr(host); err == nil && !Allowed(ip) {
+		return fmt.Errorf("%w: %s", ErrDestinationNotAllowed, host)
+	}
+	return nil
+}
+
+// NewDialer returns a dialer for destinations configured by users. The address is checked after the host name was
+// resolved, so a name that resolves to an address that is not allowed is refused as well.
+func NewDialer() *net.Dialer {
+	return &net.Dialer{
+		Timeout: 30 * time.Second,
+		Control: control,
+	}
+}
+
+// NewHTTPClient returns a client for destinations configured by users. Proxies are not used, as the proxy would connect
+// to the destination on behalf of the client, and redirects are dialed with the same restrictions.
+func NewHTTPClient(timeout time.Duration) *http.Client {
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			DialContext:         NewDialer().DialContext,
+			ForceAttemptHTTP2:   true,
+			MaxIdleConns:        100,
+			IdleConnTimeout:     90 * time.Second,
+			TLSHandshakeTimeout: 10 * time.Second,
+		},
+	}
+}
+
+func control(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip, err := netip.ParseAddr(host)
+	if err != nil {
+		return err
+	}
+	if !Allowed(ip) {
+		return fmt.Errorf("%w: %s", ErrDestinationNotAllowed, ip)
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package egress
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllowed(t *testing.T) {
+	for _, addr := range []string{"1.1.1.1", "8.8.8.8", "2606:4700:4700::1111"} {
+		assert.True(t, Allowed(netip.MustParseAddr(addr)), addr)
+	}
+	for _, addr := range []string{
+		"127.0.0.1",
+		"::1",
+		"0.0.0.0",
+		"::",
+		"169.254.169.254",
+		"fe80::1",
+		"10.43.0.1",
+		"172.16.0.1",
+		"192.168.1.1",
+		"100.64.0.1",
+		"fd00::1",
+		"::ffff:127.0.0.1",
+		"224.0.0.1",
+	} {
+		assert.False(t, Allowed(netip.MustParseAddr(addr)), addr)
+	}
+}
+
+func TestValidateHost(t *testing.T) {
+	assert.NoError(t, ValidateHost("example.com"))
+	assert.NoError(t, ValidateHost("1.1.1.1"))
+	assert.ErrorIs(t, ValidateHost("localhost"), ErrDestinationNotAllowed)
+	assert.ErrorIs(t, ValidateHost("169.254.169.254"), ErrDestinationNotAllowed)
+	assert.ErrorIs(t, ValidateHost("::1"), ErrDestinationNotAllowed)
+}
+
+func TestDialer(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+
+	_, err = NewDialer().DialContext(context.Background(), "tcp", l.Addr().String())
+	assert.ErrorIs(t, err, ErrDestinationNotAllowed)
+}
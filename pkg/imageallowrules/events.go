@@ -0,0 +1,53 @@
+package imageallowrules
+
+import (
+	"context"
+	"fmt"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/event"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ImageNotAllowedEventType is the type of the event recorded when an image of an app is rejected by the
+// ImageAllowRules of its project.
+const ImageNotAllowedEventType = "ImageNotAllowed"
+
+// ImageNotAllowedEventDetails captures additional info about an image rejected by the ImageAllowRules.
+type ImageNotAllowedEventDetails struct {
+	// Image is the name of the rejected image.
+	Image string `json:"image"`
+
+	// Digest is the digest of the rejected image, if known.
+	// +optional
+	Digest string `json:"digest,omitempty"`
+
+	// AutoUpgrade is true if the image was found by an auto-upgrade and the app keeps running its current image.
+	// +optional
+	AutoUpgrade bool `json:"autoUpgrade,omitempty"`
+}
+
+// RecordNotAllowedEvent records an ImageNotAllowed event for the app using the rejected image.
+func RecordNotAllowedEvent(ctx context.Context, recorder event.Recorder, obj kclient.Object, details ImageNotAllowedEventDetails) {
+	e := apiv1.Event{
+		Type:        ImageNotAllowedEventType,
+		Actor:       "acorn-system",
+		Severity:    v1.EventSeverityWarn,
+		Description: fmt.Sprintf("Image %s is %s", details.Image, ErrImageNotAllowedIdentifier),
+		Source:      event.ObjectSource(obj),
+		Observed:    v1.MicroTime(metav1.NowMicro()),
+	}
+	e.SetNamespace(obj.GetNamespace())
+
+	var err error
+	if e.Details, err = v1.Mapify(details); err != nil {
+		logrus.Warnf("Failed to mapify event details: %s", err.Error())
+	}
+
+	if err := recorder.Record(ctx, &e); err != nil {
+		logrus.Warnf("Failed to record event: %s", err.Error())
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/acorn-io/runtime/pkg/cosign"
 	"github.com/acorn-io/runtime/pkg/imagepattern"
 	"github.com/acorn-io/runtime/pkg/images"
+	"github.com/acorn-io/runtime/pkg/sbom"
 	"github.com/acorn-io/runtime/pkg/tags"
 	"github.com/google/go-containerregistry/pkg/authn"
 	"github.com/google/go-containerregistry/pkg/crane"
@@ -91,9 +92,58 @@ func CheckImageAgainstRules(ctx context.Context, c client.Reader, namespace stri
 		CraneOpts:          []crane.Option{crane.WithContext(ctx), crane.WithAuthFromKeychain(keychain)},
 	}
 
+	ref, digest, err := parseImage(image, digest)
+	if err != nil {
+		return err
+	}
+
+	for _, imageAllowRule := range imageAllowRules {
+		result, err := checkRule(ctx, c, namespace, image, ref, digest, imageAllowRule, &verifyOpts)
+		if err != nil {
+			return err
+		}
+		if result.Allowed {
+			return nil
+		}
+	}
+	return &ErrImageNotAllowed{Image: image}
+}
+
+// RuleResult is the outcome of checking an image against a single ImageAllowRule
+type RuleResult struct {
+	Rule v1.ImageAllowRuleInstance
+	// Covered is true if the image matches one of the image patterns of the rule
+	Covered bool
+	// Allowed is true if the image is covered by the rule and passes all of its signature rules
+	Allowed bool
+	// Reason describes why an image that is covered by the rule is not allowed by it
+	Reason string
+}
+
+// CheckImageAgainstEachRule checks the image against every one of the given ImageAllowRules instead of stopping at the
+// first one that allows it, so that the outcome can be reported per rule. Unlike CheckImageAgainstRules, it does not
+// check if the ImageAllowRules feature is enabled.
+func CheckImageAgainstEachRule(ctx context.Context, c client.Reader, namespace, image string, digest string, imageAllowRules []v1.ImageAllowRuleInstance, verifyOpts cosign.VerifyOpts) ([]RuleResult, error) {
+	ref, digest, err := parseImage(image, digest)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]RuleResult, 0, len(imageAllowRules))
+	for _, imageAllowRule := range imageAllowRules {
+		result, err := checkRule(ctx, c, namespace, image, ref, digest, imageAllowRule, &verifyOpts)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+func parseImage(image, digest string) (name.Reference, string, error) {
 	ref, err := name.ParseReference(image, name.WithDefaultRegistry(""), name.WithDefaultTag(""))
 	if err != nil {
-		return fmt.Errorf("error parsing image reference %s: %w", image, err)
+		return nil, "", fmt.Errorf("error parsing image reference %s: %w", image, err)
 	}
 
 	if ref.Identifier() == "" && tags.SHAPattern.MatchString(image) {
@@ -105,70 +155,123 @@ func CheckImageAgainstRules(ctx context.Context, c client.Reader, namespace stri
 		digest = ref.Context().Digest(digest).Name()
 	}
 
-iarLoop:
-	for _, imageAllowRule := range imageAllowRules {
-		// Check if the image is in scope of the ImageAllowRule
-		if !imageCovered(ref, digest, imageAllowRule) {
-			continue
+	return ref, digest, nil
+}
+
+// checkRule checks if the image is allowed by a single ImageAllowRule.
+// Any verification error or failed verification issue results in the image not being allowed by the rule.
+// An error is only returned if the image could not be checked at all.
+func checkRule(ctx context.Context, c client.Reader, namespace, image string, ref name.Reference, digest string, imageAllowRule v1.ImageAllowRuleInstance, verifyOpts *cosign.VerifyOpts) (RuleResult, error) {
+	result := RuleResult{
+		Rule: imageAllowRule,
+	}
+
+	// Check if the image is in scope of the ImageAllowRule
+	if !imageCovered(ref, digest, imageAllowRule) {
+		return result, nil
+	}
+	result.Covered = true
+
+	// > SBOM
+	if imageAllowRule.SBOM.Required {
+		sbomRef, err := sbomReference(ctx, c, namespace, image, verifyOpts)
+		if err != nil {
+			return result, fmt.Errorf("error resolving image digest for image %s: %w", image, err)
 		}
+		sboms, err := sbom.Get(sbomRef, verifyOpts.OciRemoteOpts...)
+		if err != nil {
+			return result, fmt.Errorf("error getting SBOM attestations for image %s: %w", image, err)
+		}
+		if len(sboms) == 0 {
+			logrus.Debugf("image %s not allowed as per %s/%s.sbom: no SBOM attestation found", image, imageAllowRule.Namespace, imageAllowRule.Name)
+			result.Reason = "sbom.required: no SBOM attestation found"
+			return result, nil
+		}
+	}
 
-		// > Signatures
-		// Any verification error or failed verification issue will skip on to the next IAR
-		for _, rule := range imageAllowRule.Signatures.Rules {
-			if err := cosign.EnsureReferences(ctx, c, image, &verifyOpts); err != nil {
-				return fmt.Errorf("error ensuring references for image %s: %w", image, err)
-			}
-			verifyOpts.AnnotationRules = rule.Annotations
-
-			// allOf: all signatures must pass verification
-			if len(rule.SignedBy.AllOf) != 0 {
-				for allOfRuleIndex, signer := range rule.SignedBy.AllOf {
-					logrus.Debugf("Checking image %s against %s/%s.signatures.allOf.%d", image, imageAllowRule.Namespace, imageAllowRule.Name, allOfRuleIndex)
-					verifyOpts.Key = signer
-					err := cosign.VerifySignature(ctx, verifyOpts)
-					if err != nil {
-						if _, ok := err.(*ocosign.VerificationError); !ok {
-							logrus.Errorf("error verifying image %s against %s/%s.signatures.allOf.%d: %v", image, imageAllowRule.Namespace, imageAllowRule.Name, allOfRuleIndex, err)
-						}
-						continue iarLoop // failed or errored in allOf, try next IAR
+	// > Signatures
+	for ruleIndex, rule := range imageAllowRule.Signatures.Rules {
+		if err := cosign.EnsureReferences(ctx, c, image, verifyOpts); err != nil {
+			return result, fmt.Errorf("error ensuring references for image %s: %w", image, err)
+		}
+		verifyOpts.AnnotationRules = rule.Annotations
+
+		// allOf: all signatures must pass verification
+		if len(rule.SignedBy.AllOf) != 0 {
+			for allOfRuleIndex, signer := range rule.SignedBy.AllOf {
+				logrus.Debugf("Checking image %s against %s/%s.signatures.allOf.%d", image, imageAllowRule.Namespace, imageAllowRule.Name, allOfRuleIndex)
+				verifyOpts.Key = signer
+				err := cosign.VerifySignature(ctx, *verifyOpts)
+				if err != nil {
+					if _, ok := err.(*ocosign.VerificationError); !ok {
+						logrus.Errorf("error verifying image %s against %s/%s.signatures.allOf.%d: %v", image, imageAllowRule.Namespace, imageAllowRule.Name, allOfRuleIndex, err)
 					}
+					result.Reason = fmt.Sprintf("signatures.rules.%d.signedBy.allOf.%d: %s", ruleIndex, allOfRuleIndex, verificationReason(err))
+					return result, nil // failed or errored in allOf, try next IAR
 				}
 			}
-			var anyOfErrs []error
-			// anyOf: only one signature must pass verification
-			if len(rule.SignedBy.AnyOf) != 0 {
-				anyOfOK := false
-				for anyOfRuleIndex, signer := range rule.SignedBy.AnyOf {
-					logrus.Debugf("Checking image %s against %s/%s.signatures.anyOf.%d", image, imageAllowRule.Namespace, imageAllowRule.Name, anyOfRuleIndex)
-					verifyOpts.Key = signer
-					err := cosign.VerifySignature(ctx, verifyOpts)
-					if err == nil {
-						anyOfOK = true
-						break
+		}
+		var anyOfErrs []error
+		// anyOf: only one signature must pass verification
+		if len(rule.SignedBy.AnyOf) != 0 {
+			anyOfOK := false
+			for anyOfRuleIndex, signer := range rule.SignedBy.AnyOf {
+				logrus.Debugf("Checking image %s against %s/%s.signatures.anyOf.%d", image, imageAllowRule.Namespace, imageAllowRule.Name, anyOfRuleIndex)
+				verifyOpts.Key = signer
+				err := cosign.VerifySignature(ctx, *verifyOpts)
+				if err == nil {
+					anyOfOK = true
+					break
+				} else {
+					if _, ok := err.(*ocosign.VerificationError); ok {
+						logrus.Debugf("image %s not allowed as per %s/%s.signatures.anyOf.%d: %v", image, imageAllowRule.Namespace, imageAllowRule.Name, anyOfRuleIndex, err)
 					} else {
-						if _, ok := err.(*ocosign.VerificationError); ok {
-							logrus.Debugf("image %s not allowed as per %s/%s.signatures.anyOf.%d: %v", image, imageAllowRule.Namespace, imageAllowRule.Name, anyOfRuleIndex, err)
-						} else {
-							e := fmt.Errorf("error verifying image %s against %s/%s.signatures.anyOf.%d: %w", image, imageAllowRule.Namespace, imageAllowRule.Name, anyOfRuleIndex, err)
-							anyOfErrs = append(anyOfErrs, e)
-							logrus.Errorln(e.Error())
-						}
-					}
-				}
-				if !anyOfOK {
-					if len(anyOfErrs) == len(rule.SignedBy.AnyOf) {
-						// we had errors for all anyOf rules (not failed verification, but actual errors)
-						e := fmt.Errorf("error verifying image %s against %s/%s.signatures.anyOf.*: %w", image, imageAllowRule.Namespace, imageAllowRule.Name, merr.NewErrors(anyOfErrs...))
+						e := fmt.Errorf("error verifying image %s against %s/%s.signatures.anyOf.%d: %w", image, imageAllowRule.Namespace, imageAllowRule.Name, anyOfRuleIndex, err)
+						anyOfErrs = append(anyOfErrs, e)
 						logrus.Errorln(e.Error())
 					}
-					continue iarLoop // failed or errored in all anyOf, try next IAR
 				}
 			}
+			if !anyOfOK {
+				if len(anyOfErrs) == len(rule.SignedBy.AnyOf) {
+					// we had errors for all anyOf rules (not failed verification, but actual errors)
+					e := fmt.Errorf("error verifying image %s against %s/%s.signatures.anyOf.*: %w", image, imageAllowRule.Namespace, imageAllowRule.Name, merr.NewErrors(anyOfErrs...))
+					logrus.Errorln(e.Error())
+				}
+				result.Reason = fmt.Sprintf("signatures.rules.%d.signedBy.anyOf: no signature passed verification", ruleIndex)
+				return result, nil // failed or errored in all anyOf, try next IAR
+			}
 		}
+	}
 
-		return nil
+	result.Allowed = true
+	return result, nil
+}
+
+// sbomReference returns the digest reference of the app image that SBOMs are attested for. Images that were built in
+// the cluster are referenced by their ID and live in the internal registry.
+func sbomReference(ctx context.Context, c client.Reader, namespace, image string, verifyOpts *cosign.VerifyOpts) (name.Digest, error) {
+	if c != nil && tags.SHAPattern.MatchString(image) {
+		ref, err := images.GetImageReference(ctx, c, namespace, image)
+		if err != nil {
+			return name.Digest{}, err
+		}
+		if d, ok := ref.(name.Digest); ok {
+			return d, nil
+		}
 	}
-	return &ErrImageNotAllowed{Image: image}
+
+	if err := cosign.EnsureImageDigest(image, verifyOpts); err != nil {
+		return name.Digest{}, err
+	}
+	return verifyOpts.ImageRef, nil
+}
+
+// verificationReason shortens a verification error to its first line, as the following lines list the details of
+// every checked signature
+func verificationReason(err error) string {
+	reason, _, _ := strings.Cut(err.Error(), "\n")
+	return strings.TrimSuffix(reason, ":")
 }
 
 func imageCovered(image name.Reference, digest string, iar v1.ImageAllowRuleInstance) bool {
@@ -7,17 +7,20 @@ import (
 
 	"github.com/acorn-io/baaah/pkg/router"
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/autoupgrade"
 	"github.com/acorn-io/runtime/pkg/images"
+	"github.com/acorn-io/runtime/pkg/sbom"
 	"github.com/acorn-io/runtime/pkg/tags"
 	imagename "github.com/google/go-containerregistry/pkg/name"
 	"github.com/google/go-containerregistry/pkg/v1/remote"
+	ociremote "github.com/sigstore/cosign/v2/pkg/oci/remote"
 	apierror "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func GetImageDetails(ctx context.Context, c kclient.Client, namespace, imageName string, profiles []string, deployArgs map[string]any, nested string, opts ...remote.Option) (*apiv1.ImageDetails, error) {
+func GetImageDetails(ctx context.Context, c kclient.Client, namespace, imageName string, profiles []string, deployArgs map[string]any, nested string, includeSBOM bool, opts ...remote.Option) (*apiv1.ImageDetails, error) {
 	imageName = strings.ReplaceAll(imageName, "+", "/")
 	name := strings.ReplaceAll(imageName, "/", "+")
 
@@ -55,6 +58,14 @@ func GetImageDetails(ctx context.Context, c kclient.Client, namespace, imageName
 		return nil, err
 	}
 
+	var sboms []v1.ImageSBOM
+	if includeSBOM {
+		sboms, err = getSBOMs(ctx, c, namespace, imageName, appImage.Digest, opts...)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	details, err := ParseDetails(appImage.Acornfile, deployArgs, profiles)
 	if err != nil {
 		return &apiv1.ImageDetails{
@@ -63,6 +74,7 @@ func GetImageDetails(ctx context.Context, c kclient.Client, namespace, imageName
 				Namespace: namespace,
 			},
 			ParseError: err.Error(),
+			SBOMs:      sboms,
 		}, nil
 	}
 
@@ -76,5 +88,20 @@ func GetImageDetails(ctx context.Context, c kclient.Client, namespace, imageName
 		Params:     details.Params,
 		AppSpec:    details.AppSpec,
 		AppImage:   *appImage,
+		SBOMs:      sboms,
 	}, nil
 }
+
+func getSBOMs(ctx context.Context, c kclient.Client, namespace, imageName, digest string, opts ...remote.Option) ([]v1.ImageSBOM, error) {
+	ref, err := images.GetImageReference(ctx, c, namespace, imageName)
+	if err != nil {
+		return nil, err
+	}
+
+	opts, err = images.GetAuthenticationRemoteOptions(ctx, c, namespace, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return sbom.Get(ref.Context().Digest(digest), ociremote.WithRemoteOptions(opts...))
+}
@@ -3,6 +3,7 @@ package imagesystem
 import (
 	"fmt"
 	"path/filepath"
+	"strconv"
 
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/labels"
@@ -40,6 +41,11 @@ func BuilderObjects(name, namespace, forNamespace, buildKitImage, pub, privKey,
 			Template: corev1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
 					Labels: labels.ManagedByApp(namespace, name, "app", name),
+					Annotations: map[string]string{
+						"prometheus.io/scrape": "true",
+						"prometheus.io/port":   strconv.Itoa(int(system.BuildServerMetricsPort)),
+						"prometheus.io/path":   "/metrics",
+					},
 				},
 				Spec: corev1.PodSpec{
 					PriorityClassName:  system.AcornPriorityClass,
@@ -138,6 +144,7 @@ func BuilderObjects(name, namespace, forNamespace, buildKitImage, pub, privKey,
 							},
 							Args: []string{
 								"build-server",
+								fmt.Sprintf("--metrics-port=%d", system.BuildServerMetricsPort),
 							},
 							Resources: system.BuildkitdServiceResources(),
 							ReadinessProbe: &corev1.Probe{
@@ -154,6 +161,10 @@ func BuilderObjects(name, namespace, forNamespace, buildKitImage, pub, privKey,
 								{
 									ContainerPort: system.BuildkitPort,
 								},
+								{
+									Name:          "metrics",
+									ContainerPort: system.BuildServerMetricsPort,
+								},
 							},
 							VolumeMounts: []corev1.VolumeMount{
 								{
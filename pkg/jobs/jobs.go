@@ -141,9 +141,35 @@ func GetEvent(jobName string, appInstance *v1.AppInstance) string {
 	if appInstance.Spec.Stop != nil && *appInstance.Spec.Stop {
 		return "stop"
 	}
-	if appInstance.Generation <= 1 || slices.Contains(appInstance.Status.AppSpec.Jobs[jobName].Events, "create") && !appInstance.Status.AppStatus.Jobs[jobName].CreateEventSucceeded {
+
+	jobStatus := appInstance.Status.AppStatus.Jobs[jobName]
+	if jobStatus.Event != "" && jobStatus.EventGeneration == appInstance.Generation {
+		// The event doesn't change for a generation of the app once the job has been run for it, even if the
+		// status of the app that the event was determined from has changed since.
+		return jobStatus.Event
+	}
+
+	events := appInstance.Status.AppSpec.Jobs[jobName].Events
+	if appInstance.Generation <= 1 || slices.Contains(events, "create") && !jobStatus.CreateEventSucceeded {
 		// Create event jobs run at least once. So, if it hasn't succeeded, run it.
 		return "create"
 	}
+	if appInstance.Status.AppStatus.Stopped && slices.Contains(events, "start") {
+		return "start"
+	}
+	// A job runs once per update of the app, so pre-update takes precedence over post-update and update
+	if slices.Contains(events, "pre-update") {
+		return "pre-update"
+	}
+	if slices.Contains(events, "post-update") {
+		return "post-update"
+	}
 	return "update"
 }
+
+// IsPreUpdate returns true if the job is running for the pre-update event in the current generation of the app,
+// in which case the containers of the app must not be rolled out until it succeeds.
+func IsPreUpdate(jobName string, appInstance *v1.AppInstance) bool {
+	job, ok := appInstance.Status.AppSpec.Jobs[jobName]
+	return ok && job.Schedule == "" && GetEvent(jobName, appInstance) == "pre-update"
+}
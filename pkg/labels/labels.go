@@ -26,12 +26,23 @@ const (
 	AcornAppUID                            = Prefix + "app-uid"
 	AcornVolumeName                        = Prefix + "volume-name"
 	AcornVolumeClass                       = Prefix + "volume-class"
+	AcornVolumeSnapshotSource              = Prefix + "volume-snapshot-source"
+	AcornVolumeBackupType                  = Prefix + "volume-backup-type"
+	AcornVolumeBackupSource                = Prefix + "volume-backup-source"
+	AcornVolumeBackupURL                   = Prefix + "volume-backup-url"
+	AcornVolumeBackupSecret                = Prefix + "volume-backup-secret"
+	AcornVolumeResizeError                 = Prefix + "volume-resize-error"
 	AcornSecretName                        = Prefix + "secret-name"
 	AcornSecretSourceName                  = Prefix + "secret-source-name"
 	AcornSecretGenerated                   = Prefix + "secret-generated"
+	AcornSecretSource                      = Prefix + "secret-source"
+	AcornSecretSourceVersion               = Prefix + "secret-source-version"
+	AcornSecretLastSync                    = Prefix + "secret-last-sync"
+	AcornSecretLastRotation                = Prefix + "secret-last-rotation"
 	AcornContainerName                     = Prefix + "container-name"
 	AcornRouterName                        = Prefix + "router-name"
 	AcornJobName                           = Prefix + "job-name"
+	AcornJobCompletionRecorded             = Prefix + "job-completion-recorded"
 	AcornAppImage                          = Prefix + "app-image"
 	AcornAppDevHash                        = Prefix + "app-dev-hash"
 	AcornManaged                           = Prefix + "managed"
@@ -57,8 +68,13 @@ const (
 	AcornProjectSupportedRegions           = Prefix + "project-supported-regions"
 	AcornCalculatedProjectDefaultRegion    = Prefix + "calculated-project-default-region"
 	AcornCalculatedProjectSupportedRegions = Prefix + "calculated-project-supported-regions"
+	AcornProjectLogSinks                   = Prefix + "project-log-sinks"
 	ProjectEnforcedQuotaAnnotation         = Prefix + "enforced-quota"
 	AcornPermissions                       = Prefix + "permissions"
+	AcornRolloutRevision                   = Prefix + "rollout-revision"
+	AcornRolloutNext                       = Prefix + "rollout-next"
+	AcornUpdatedBy                         = Prefix + "updated-by"
+	AcornTraceParent                       = Prefix + "traceparent"
 
 	PrometheusScrape = "prometheus.io/scrape"
 	PrometheusPath   = "prometheus.io/path"
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"regexp"
 	"strings"
 	"sync"
 	"time"
@@ -17,6 +18,7 @@ import (
 	internalv1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	hclient "github.com/acorn-io/runtime/pkg/k8sclient"
 	applabels "github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/namespace"
 	"github.com/acorn-io/runtime/pkg/publicname"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/sync/errgroup"
@@ -78,8 +80,16 @@ type Options struct {
 	Follow           bool
 	ContainerReplica string
 	Container        string
+	// Since, Until and Grep filter the lines before they are sent to the output, so that searching the logs of many
+	// containers doesn't require reading all of them
+	Since *metav1.Time
+	Until *metav1.Time
+	Grep  *regexp.Regexp
 }
 
+// errUntilReached is returned by pipe when a line after options.Until was read
+var errUntilReached = errors.New("until reached")
+
 func (o *Options) restConfig() (*rest.Config, error) {
 	if o.RestConfig != nil {
 		return o.RestConfig, nil
@@ -124,7 +134,7 @@ func (o *Options) Complete() (*Options, error) {
 	return o, nil
 }
 
-func pipe(input io.ReadCloser, output chan<- Message, pod *corev1.Pod, name string, after *metav1.Time) (*metav1.Time, error) {
+func pipe(input io.ReadCloser, output chan<- Message, pod *corev1.Pod, name string, after *metav1.Time, options *Options) (*metav1.Time, error) {
 	defer input.Close()
 
 	var lastTS *metav1.Time
@@ -147,6 +157,12 @@ func pipe(input io.ReadCloser, output chan<- Message, pod *corev1.Pod, name stri
 		if after != nil && !lastTS.After(after.Time) {
 			continue
 		}
+		if options.Until != nil && !pt.IsZero() && lastTS.After(options.Until.Time) {
+			return lastTS, errUntilReached
+		}
+		if options.Grep != nil && !options.Grep.MatchString(newLine) {
+			continue
+		}
 
 		output <- Message{
 			Line:          newLine,
@@ -167,7 +183,7 @@ func Container(ctx context.Context, pod *corev1.Pod, name string, output chan<-
 
 	var (
 		first = true
-		since *metav1.Time
+		since = options.Since
 		tail  = options.Tail
 	)
 
@@ -214,8 +230,10 @@ func Container(ctx context.Context, pod *corev1.Pod, name string, output chan<-
 			continue
 		}
 		// pipe will close the readCloser
-		lastTS, err := pipe(readCloser, output, pod, name, since)
-		if err != nil && !errors.Is(err, context.Canceled) {
+		lastTS, err := pipe(readCloser, output, pod, name, since, options)
+		if errors.Is(err, errUntilReached) {
+			return nil
+		} else if err != nil && !errors.Is(err, context.Canceled) {
 			output <- Message{
 				Time:          time.Now(),
 				Pod:           pod,
@@ -399,6 +417,16 @@ func appNoFollow(ctx context.Context, app *apiv1.App, output chan<- Message, opt
 	return nil
 }
 
+// ReplicaName returns the name of the container replica of the container in the pod, which is the name of the pod for
+// the main container of a container or job and includes the name of the sidecar otherwise
+func ReplicaName(pod *corev1.Pod, containerName string) string {
+	_, name := namespace.NormalizedName(pod.ObjectMeta)
+	if containerName != pod.Labels[applabels.AcornContainerName] && containerName != pod.Labels[applabels.AcornJobName] {
+		name += ":" + containerName
+	}
+	return name
+}
+
 func matchesPod(pod *corev1.Pod, options *Options) bool {
 	if options == nil || options.ContainerReplica == "" {
 		return true
@@ -1,7 +1,11 @@
 package log
 
 import (
+	"io"
+	"regexp"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/acorn-io/runtime/pkg/labels"
 	"github.com/stretchr/testify/assert"
@@ -198,3 +202,50 @@ func TestMatchesContainer(t *testing.T) {
 		})
 	}
 }
+
+func TestPipeFilters(t *testing.T) {
+	input := `2023-07-01T12:00:00Z starting
+2023-07-01T12:01:00Z error: connection refused
+2023-07-01T12:02:00Z ready
+2023-07-01T12:03:00Z error: timeout
+2023-07-01T12:04:00Z error: shutting down
+`
+	until, err := ParseTime("2023-07-01T12:03:30Z", time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	output := make(chan Message, 5)
+	lastTS, err := pipe(io.NopCloser(strings.NewReader(input)), output, appWithLinkerdProxy, "nginx", nil, &Options{
+		Until: until,
+		Grep:  regexp.MustCompile("^error"),
+	})
+	close(output)
+	assert.ErrorIs(t, err, errUntilReached)
+	assert.Equal(t, "2023-07-01T12:04:00Z", lastTS.UTC().Format(time.RFC3339))
+
+	var lines []string
+	for msg := range output {
+		lines = append(lines, msg.Line)
+	}
+	assert.Equal(t, []string{"error: connection refused", "error: timeout"}, lines)
+}
+
+func TestParseTime(t *testing.T) {
+	now := time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC)
+
+	ts, err := ParseTime("", now)
+	assert.NoError(t, err)
+	assert.Nil(t, ts)
+
+	ts, err = ParseTime("42m", now)
+	assert.NoError(t, err)
+	assert.Equal(t, now.Add(-42*time.Minute), ts.Time)
+
+	ts, err = ParseTime("2023-06-30T08:00:00Z", now)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2023, 6, 30, 8, 0, 0, 0, time.UTC), ts.UTC())
+
+	_, err = ParseTime("yesterday", now)
+	assert.Error(t, err)
+}
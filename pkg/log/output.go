@@ -2,6 +2,9 @@ package log
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"os"
 	"strings"
 	"time"
 
@@ -9,6 +12,7 @@ import (
 	"github.com/acorn-io/runtime/pkg/client"
 	"github.com/pterm/pterm"
 	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 var (
@@ -30,13 +34,34 @@ func nextColor() pterm.Color {
 }
 
 func Output(ctx context.Context, c client.Client, name string, opts *client.LogOptions) error {
+	containerColors := map[string]pterm.Color{}
+
+	return output(ctx, c, name, opts, func(msg v1.LogMessage) error {
+		color, ok := containerColors[msg.ContainerName]
+		if !ok {
+			color = nextColor()
+			containerColors[msg.ContainerName] = color
+		}
+
+		pterm.Printf("%s: %s\n", color.Sprint(msg.ContainerName), msg.Line)
+		return nil
+	})
+}
+
+// OutputJSON is like Output, but prints every message as a JSON object on its own line
+func OutputJSON(ctx context.Context, c client.Client, name string, opts *client.LogOptions) error {
+	enc := json.NewEncoder(os.Stdout)
+	return output(ctx, c, name, opts, func(msg v1.LogMessage) error {
+		return enc.Encode(msg)
+	})
+}
+
+func output(ctx context.Context, c client.Client, name string, opts *client.LogOptions, print func(v1.LogMessage) error) error {
 	msgs, err := c.AppLog(ctx, name, opts)
 	if err != nil {
 		return err
 	}
 
-	containerColors := map[string]pterm.Color{}
-
 	for msg := range msgs {
 		result, err := SinceLogCheck(opts.Since, msg)
 		if err != nil {
@@ -44,13 +69,9 @@ func Output(ctx context.Context, c client.Client, name string, opts *client.LogO
 		}
 		if result {
 			if msg.Error == "" {
-				color, ok := containerColors[msg.ContainerName]
-				if !ok {
-					color = nextColor()
-					containerColors[msg.ContainerName] = color
+				if err := print(msg); err != nil {
+					return err
 				}
-
-				pterm.Printf("%s: %s\n", color.Sprint(msg.ContainerName), msg.Line)
 			} else if !strings.Contains(msg.Error, "context canceled") {
 				logrus.Error(msg.Error)
 			}
@@ -64,9 +85,25 @@ func SinceLogCheck(since string, msg v1.LogMessage) (bool, error) {
 	if since == "" {
 		return true, nil
 	}
-	beforeTime, err := time.ParseDuration(since)
+	sinceTime, err := ParseTime(since, time.Now())
 	if err != nil {
 		return false, err
 	}
-	return msg.Time.After(time.Now().Add(-beforeTime)), nil
+	return msg.Time.After(sinceTime.Time), nil
+}
+
+// ParseTime parses the value of the since and until log options, which is either a duration relative to now (e.g. 42m
+// for 42 minutes ago) or an RFC3339 timestamp. An empty value results in a nil time.
+func ParseTime(value string, now time.Time) (*metav1.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	if d, err := time.ParseDuration(value); err == nil {
+		return &metav1.Time{Time: now.Add(-d)}, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time %q: must be a duration (e.g. 42m) or an RFC3339 timestamp", value)
+	}
+	return &metav1.Time{Time: t}, nil
 }
@@ -0,0 +1,341 @@
+package logsink
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/config"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/log"
+	"github.com/acorn-io/runtime/pkg/system"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	klabels "k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/rest"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	resyncInterval = 30 * time.Second
+	// cursorsName is the ConfigMap in the system namespace that holds how far the logs of each app were forwarded
+	cursorsName = "acorn-log-sink-cursors"
+)
+
+type daemon struct {
+	options *log.Options
+
+	lock     sync.Mutex
+	projects map[string]*project
+	// cursors is the time of the last line of each app that was sent to all sinks of its project, by cursorKey
+	cursors map[string]time.Time
+}
+
+// project is the forwarding of the logs of all apps in a project to its sinks
+type project struct {
+	name       string
+	sinks      string
+	directory  string
+	ctx        context.Context
+	cancel     context.CancelFunc
+	forwarders []*Forwarder
+	// apps are the apps whose logs are followed and the time they were followed from
+	apps map[string]time.Time
+}
+
+// StartForwarding ships the logs of the apps in all projects with log sinks to those sinks. The projects and apps are
+// resynced periodically, so logs of new apps are picked up with a delay of up to the resync interval. How far the logs
+// of each app were sent to the sinks is saved on every resync, so that forwarding resumes from there after a restart.
+func StartForwarding(ctx context.Context, cfg *rest.Config) error {
+	options, err := (&log.Options{RestConfig: cfg}).Complete()
+	if err != nil {
+		return err
+	}
+
+	d := &daemon{
+		options:  options,
+		projects: map[string]*project{},
+	}
+	go wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := d.sync(ctx); err != nil {
+			logrus.Errorf("Failed to sync log forwarding: %v", err)
+		}
+	}, resyncInterval)
+	return nil
+}
+
+func (d *daemon) sync(ctx context.Context) error {
+	if d.cursors == nil {
+		cursors, err := d.loadCursors(ctx)
+		if err != nil {
+			return err
+		}
+		d.cursors = cursors
+	}
+
+	namespaces := &corev1.NamespaceList{}
+	if err := d.options.Client.List(ctx, namespaces, &kclient.ListOptions{
+		LabelSelector: klabels.SelectorFromSet(klabels.Set{
+			labels.AcornProject: "true",
+		}),
+	}); err != nil {
+		return err
+	}
+
+	cfg, err := config.Get(ctx, d.options.Client)
+	if err != nil {
+		return err
+	}
+	directory := *cfg.LogSinkDirectory
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	seen := map[string]bool{}
+	for _, ns := range namespaces.Items {
+		sinks := ns.Annotations[labels.AcornProjectLogSinks]
+		if sinks == "" || !ns.DeletionTimestamp.IsZero() {
+			continue
+		}
+		seen[ns.Name] = true
+
+		if p, ok := d.projects[ns.Name]; ok {
+			if p.sinks == sinks && p.directory == directory {
+				continue
+			}
+			p.cancel()
+			delete(d.projects, ns.Name)
+		}
+
+		p, err := newProject(ctx, ns.Name, sinks, directory)
+		if err != nil {
+			logrus.Errorf("Failed to forward logs of project %s: %v", ns.Name, err)
+			continue
+		}
+		d.projects[ns.Name] = p
+	}
+
+	for name, p := range d.projects {
+		if !seen[name] {
+			p.cancel()
+			delete(d.projects, name)
+		}
+	}
+
+	synced := true
+	for _, p := range d.projects {
+		if err := d.syncApps(ctx, p); err != nil {
+			logrus.Errorf("Failed to sync log forwarding of apps in project %s: %v", p.name, err)
+			synced = false
+		}
+	}
+
+	// the cursors of the apps that aren't followed are removed, so don't save them if some apps might be missing
+	if !synced {
+		return nil
+	}
+	return d.saveCursors(ctx)
+}
+
+func cursorKey(project, app string) string {
+	return project + "." + app
+}
+
+func (d *daemon) loadCursors(ctx context.Context) (map[string]time.Time, error) {
+	cursors := map[string]time.Time{}
+	cm := &corev1.ConfigMap{}
+	if err := d.options.Client.Get(ctx, router.Key(system.Namespace, cursorsName), cm); apierrors.IsNotFound(err) {
+		return cursors, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	for key, value := range cm.Data {
+		t, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			logrus.Warnf("Ignoring invalid log forwarding cursor %s=%s: %v", key, value, err)
+			continue
+		}
+		cursors[key] = t
+	}
+	return cursors, nil
+}
+
+// saveCursors stores the cursors of the followed apps. d.lock must be held.
+func (d *daemon) saveCursors(ctx context.Context) error {
+	d.cursors = map[string]time.Time{}
+	data := map[string]string{}
+	for _, p := range d.projects {
+		for app := range p.apps {
+			key := cursorKey(p.name, app)
+			d.cursors[key] = p.cursor(app)
+			data[key] = d.cursors[key].UTC().Format(time.RFC3339Nano)
+		}
+	}
+
+	cm := &corev1.ConfigMap{}
+	if err := d.options.Client.Get(ctx, router.Key(system.Namespace, cursorsName), cm); apierrors.IsNotFound(err) {
+		return d.options.Client.Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      cursorsName,
+				Namespace: system.Namespace,
+			},
+			Data: data,
+		})
+	} else if err != nil {
+		return err
+	}
+
+	if equality.Semantic.DeepEqual(cm.Data, data) {
+		return nil
+	}
+	cm.Data = data
+	return d.options.Client.Update(ctx, cm)
+}
+
+func newProject(ctx context.Context, name, sinks, directory string) (*project, error) {
+	var logSinks []apiv1.LogSink
+	if err := json.Unmarshal([]byte(sinks), &logSinks); err != nil {
+		return nil, fmt.Errorf("invalid log sinks: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	p := &project{
+		name:      name,
+		sinks:     sinks,
+		directory: directory,
+		ctx:       ctx,
+		cancel:    cancel,
+		apps:      map[string]time.Time{},
+	}
+	for i, logSink := range logSinks {
+		sink, err := New(name, logSink, directory)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		f := NewForwarder(fmt.Sprintf("log sink %d of project %s", i, name), sink)
+		go f.Run(ctx)
+		p.forwarders = append(p.forwarders, f)
+	}
+
+	return p, nil
+}
+
+func (d *daemon) syncApps(ctx context.Context, p *project) error {
+	apps := &v1.AppInstanceList{}
+	if err := d.options.Client.List(ctx, apps, kclient.InNamespace(p.name)); err != nil {
+		return err
+	}
+
+	for _, app := range apps.Items {
+		// the logs of nested apps are followed with their parent
+		if app.Labels[labels.AcornParentAcornName] != "" || !app.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if _, ok := p.apps[app.Name]; ok {
+			continue
+		}
+
+		// forwarding starts from the last line that was sent to the sinks, or from when the app was first seen
+		since, ok := d.cursors[cursorKey(p.name, app.Name)]
+		if !ok {
+			since = time.Now()
+		}
+		p.apps[app.Name] = since
+		go func(name string, since time.Time) {
+			defer func() {
+				d.lock.Lock()
+				delete(p.apps, name)
+				d.lock.Unlock()
+			}()
+			// canceling the project, because it was deleted or its sinks changed, stops following the app
+			d.forwardApp(p.ctx, p, name, since)
+		}(app.Name, since)
+	}
+
+	return nil
+}
+
+// cursor returns the time of the last line of the app that was sent to all sinks of the project
+func (p *project) cursor(app string) time.Time {
+	cursor := p.apps[app]
+	for i, f := range p.forwarders {
+		delivered, ok := f.Delivered(cursorKey(p.name, app))
+		if !ok {
+			return p.apps[app]
+		}
+		if i == 0 || delivered.Before(cursor) {
+			cursor = delivered
+		}
+	}
+	return cursor
+}
+
+// forwardApp follows the logs of the app from since until it is deleted or the context is canceled
+func (d *daemon) forwardApp(ctx context.Context, p *project, name string, since time.Time) {
+	options := *d.options
+	options.Follow = true
+	options.Since = &metav1.Time{Time: since}
+
+	output := make(chan log.Message)
+	go func() {
+		defer close(output)
+		app := &apiv1.App{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: p.name,
+				Name:      name,
+			},
+		}
+		if err := log.App(ctx, app, output, &options); err != nil && ctx.Err() == nil {
+			logrus.Errorf("Failed to follow logs of app %s/%s: %v", p.name, name, err)
+		}
+	}()
+
+	for msg := range output {
+		if msg.Err != nil || msg.Pod == nil {
+			if msg.Err != nil && ctx.Err() == nil {
+				logrus.Debugf("Error following logs of app %s/%s: %v", p.name, name, msg.Err)
+			}
+			continue
+		}
+		record := toRecord(p.name, msg)
+		record.source = cursorKey(p.name, name)
+		for _, f := range p.forwarders {
+			f.Add(record)
+		}
+	}
+}
+
+func toRecord(project string, msg log.Message) Record {
+	app := msg.Pod.Labels[labels.AcornAppPublicName]
+	if app == "" {
+		app = msg.Pod.Labels[labels.AcornAppName]
+	}
+	container := msg.Pod.Labels[labels.AcornContainerName]
+	if container == "" {
+		container = msg.Pod.Labels[labels.AcornJobName]
+	}
+	if msg.ContainerName != container {
+		// sidecar
+		container = msg.ContainerName
+	}
+
+	return Record{
+		Time:      msg.Time,
+		Project:   project,
+		App:       app,
+		Container: container,
+		Replica:   log.ReplicaName(msg.Pod, msg.ContainerName),
+		Line:      msg.Line,
+	}
+}
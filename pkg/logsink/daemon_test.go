@@ -0,0 +1,61 @@
+package logsink
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/runtime/pkg/log"
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/acorn-io/runtime/pkg/system"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestCursors(t *testing.T) {
+	ctx := context.Background()
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+	d := &daemon{
+		options:  &log.Options{Client: c},
+		projects: map[string]*project{},
+	}
+
+	cursors, err := d.loadCursors(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, cursors)
+
+	start := time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC)
+	first, second := NewForwarder("first", nil), NewForwarder("second", nil)
+	d.projects["my-project"] = &project{
+		name:       "my-project",
+		forwarders: []*Forwarder{first, second},
+		apps: map[string]time.Time{
+			"app":  start,
+			"idle": start,
+		},
+	}
+
+	// the cursor of an app only moves once a line was sent to every sink
+	first.markDelivered([]Record{{Time: start.Add(2 * time.Second), source: "my-project.app"}})
+	require.NoError(t, d.saveCursors(ctx))
+
+	second.markDelivered([]Record{{Time: start.Add(time.Second), source: "my-project.app"}})
+	require.NoError(t, d.saveCursors(ctx))
+
+	cm := &corev1.ConfigMap{}
+	require.NoError(t, c.Get(ctx, router.Key(system.Namespace, cursorsName), cm))
+	assert.Equal(t, map[string]string{
+		"my-project.app":  "2023-07-01T12:00:01Z",
+		"my-project.idle": "2023-07-01T12:00:00Z",
+	}, cm.Data)
+
+	cursors, err = d.loadCursors(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]time.Time{
+		"my-project.app":  start.Add(time.Second),
+		"my-project.idle": start,
+	}, cursors)
+}
@@ -0,0 +1,100 @@
+package logsink
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+type File struct {
+	root string
+	path string
+
+	lock sync.Mutex
+	file *os.File
+}
+
+// NewFile returns a sink that appends the records as a JSON object per line to the file at path, which is relative
+// to root. Symlinks under root are not followed.
+func NewFile(root, path string) *File {
+	return &File{
+		root: root,
+		path: path,
+	}
+}
+
+func (f *File) Send(_ context.Context, records []Record) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if f.file == nil {
+		file, err := f.open()
+		if err != nil {
+			return err
+		}
+		f.file = file
+	}
+
+	enc := json.NewEncoder(f.file)
+	for _, record := range records {
+		if err := enc.Encode(record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// open creates the directories of the file under the root and opens the file for appending. Symlinks under the
+// root are rejected, so that a project can't write to a path outside of it.
+func (f *File) open() (*os.File, error) {
+	rel := filepath.FromSlash(f.path)
+	if !filepath.IsLocal(rel) {
+		return nil, fmt.Errorf("invalid log file path %q: must be within the log sink directory", f.path)
+	}
+
+	path, err := filepath.EvalSymlinks(f.root)
+	if err != nil {
+		return nil, err
+	}
+	for _, elem := range strings.Split(filepath.Dir(rel), string(filepath.Separator)) {
+		if elem == "." {
+			continue
+		}
+		path = filepath.Join(path, elem)
+		info, err := os.Lstat(path)
+		if errors.Is(err, fs.ErrNotExist) {
+			if err := os.Mkdir(path, 0755); err != nil && !errors.Is(err, fs.ErrExist) {
+				return nil, err
+			}
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			return nil, fmt.Errorf("invalid log file path %q: %s is not a directory", f.path, elem)
+		}
+	}
+
+	path = filepath.Join(path, filepath.Base(rel))
+	if info, err := os.Lstat(path); err == nil && !info.Mode().IsRegular() {
+		return nil, fmt.Errorf("invalid log file path %q: must be a regular file", f.path)
+	}
+	return os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+}
+
+func (f *File) Close() error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if f.file == nil {
+		return nil
+	}
+	err := f.file.Close()
+	f.file = nil
+	return err
+}
@@ -0,0 +1,127 @@
+package logsink
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	bufferSize    = 10_000
+	batchSize     = 100
+	flushInterval = time.Second
+	sendTimeout   = 30 * time.Second
+	maxBackoff    = time.Minute
+)
+
+// Forwarder buffers records and sends them to a sink in batches. Failed sends are retried with exponential backoff,
+// during which new records are buffered. Once the buffer is full, new records are dropped until the sink recovers.
+type Forwarder struct {
+	name           string
+	sink           Sink
+	records        chan Record
+	dropped        atomic.Int64
+	initialBackoff time.Duration
+
+	lock      sync.Mutex
+	delivered map[string]time.Time
+}
+
+func NewForwarder(name string, sink Sink) *Forwarder {
+	return &Forwarder{
+		name:           name,
+		sink:           sink,
+		records:        make(chan Record, bufferSize),
+		initialBackoff: time.Second,
+		delivered:      map[string]time.Time{},
+	}
+}
+
+// Add queues the record to be sent
+func (f *Forwarder) Add(r Record) {
+	select {
+	case f.records <- r:
+	default:
+		f.dropped.Add(1)
+	}
+}
+
+// Run sends the queued records until the context is canceled and closes the sink afterwards
+func (f *Forwarder) Run(ctx context.Context) {
+	defer f.sink.Close()
+
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+
+	var batch []Record
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case r := <-f.records:
+			batch = append(batch, r)
+			if len(batch) < batchSize {
+				continue
+			}
+		case <-ticker.C:
+			if len(batch) == 0 {
+				continue
+			}
+		}
+
+		if !f.send(ctx, batch) {
+			return
+		}
+		f.markDelivered(batch)
+		batch = nil
+	}
+}
+
+// Delivered returns the time of the newest record of the source that was sent to the sink
+func (f *Forwarder) Delivered(source string) (time.Time, bool) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	t, ok := f.delivered[source]
+	return t, ok
+}
+
+func (f *Forwarder) markDelivered(batch []Record) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	for _, r := range batch {
+		if r.source != "" && r.Time.After(f.delivered[r.source]) {
+			f.delivered[r.source] = r.Time
+		}
+	}
+}
+
+// send sends the batch until it succeeds or the context is canceled, which is reported by returning false
+func (f *Forwarder) send(ctx context.Context, batch []Record) bool {
+	backoff := f.initialBackoff
+	for {
+		sendCtx, cancel := context.WithTimeout(ctx, sendTimeout)
+		err := f.sink.Send(sendCtx, batch)
+		cancel()
+		if err == nil {
+			if dropped := f.dropped.Swap(0); dropped > 0 {
+				logrus.Warnf("Dropped %d log lines for %s while it was unavailable", dropped, f.name)
+			}
+			return true
+		}
+
+		logrus.Warnf("Failed to forward logs to %s, retrying in %s: %v", f.name, backoff, err)
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+	}
+}
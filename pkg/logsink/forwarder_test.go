@@ -0,0 +1,51 @@
+package logsink
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForwarderRetriesHTTP(t *testing.T) {
+	var requests atomic.Int32
+	received := make(chan []Record, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the first request fails, so the batch has to be sent again
+		if requests.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		var records []Record
+		if err := json.NewDecoder(r.Body).Decode(&records); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		received <- records
+	}))
+	defer server.Close()
+
+	f := NewForwarder("test", NewHTTP(server.URL, server.Client()))
+	f.initialBackoff = 10 * time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go f.Run(ctx)
+
+	f.Add(testRecord)
+
+	select {
+	case records := <-received:
+		require.Len(t, records, 1)
+		assert.Equal(t, testRecord, records[0])
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for logs")
+	}
+	assert.Equal(t, int32(2), requests.Load())
+}
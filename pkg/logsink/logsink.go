@@ -0,0 +1,140 @@
+package logsink
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/egress"
+)
+
+const (
+	ProtocolTCP = "tcp"
+	ProtocolUDP = "udp"
+)
+
+var ErrFileLogSinksDisabled = errors.New("file log sinks are disabled, an admin must set the log sink directory with acorn install --log-sink-directory")
+
+// Record is a line of a container log with the labels identifying where it came from
+type Record struct {
+	Time      time.Time `json:"time"`
+	Project   string    `json:"project"`
+	App       string    `json:"app"`
+	Container string    `json:"container"`
+	Replica   string    `json:"replica"`
+	Line      string    `json:"line"`
+
+	// source is the stream of logs the record was read from, which the forwarders track the delivery of
+	source string
+}
+
+// Sink ships log records to a destination outside the cluster
+type Sink interface {
+	// Send delivers the records. If an error is returned, none or only some of the records may have been delivered
+	// and the records are sent again.
+	Send(ctx context.Context, records []Record) error
+	Close() error
+}
+
+// New returns the sink for the configuration. Syslog and HTTP sinks only connect to public addresses, never to the
+// cluster or the controller itself. The files of file sinks are written under directory, which is set by the admin, and
+// never to a path outside of it.
+func New(project string, sink apiv1.LogSink, directory string) (Sink, error) {
+	if err := Validate(sink); err != nil {
+		return nil, err
+	}
+	switch {
+	case sink.Syslog != nil:
+		return NewSyslog(sink.Syslog.Protocol, sink.Syslog.Address, egress.NewDialer()), nil
+	case sink.HTTP != nil:
+		return NewHTTP(sink.HTTP.URL, egress.NewHTTPClient(sendTimeout)), nil
+	default:
+		if directory == "" || !filepath.IsAbs(directory) {
+			return nil, ErrFileLogSinksDisabled
+		}
+		return NewFile(directory, path.Join(sink.File.Path, project+".log")), nil
+	}
+}
+
+// Validate checks that exactly one kind of sink is configured and that its settings are valid
+func Validate(sink apiv1.LogSink) error {
+	set := 0
+	for _, isSet := range []bool{sink.Syslog != nil, sink.HTTP != nil, sink.File != nil} {
+		if isSet {
+			set++
+		}
+	}
+	if set != 1 {
+		return fmt.Errorf("exactly one of syslog, http or file must be set")
+	}
+
+	switch {
+	case sink.Syslog != nil:
+		if sink.Syslog.Protocol != "" && sink.Syslog.Protocol != ProtocolTCP && sink.Syslog.Protocol != ProtocolUDP {
+			return fmt.Errorf("invalid syslog protocol %q: must be %s or %s", sink.Syslog.Protocol, ProtocolTCP, ProtocolUDP)
+		}
+		host, _, err := net.SplitHostPort(sink.Syslog.Address)
+		if err != nil {
+			return fmt.Errorf("invalid syslog address %q: %w", sink.Syslog.Address, err)
+		}
+		if err := egress.ValidateHost(host); err != nil {
+			return fmt.Errorf("invalid syslog address %q: %w", sink.Syslog.Address, err)
+		}
+	case sink.HTTP != nil:
+		u, err := url.Parse(sink.HTTP.URL)
+		if err != nil {
+			return fmt.Errorf("invalid http url %q: %w", sink.HTTP.URL, err)
+		}
+		if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("invalid http url %q: must be an absolute http or https url", sink.HTTP.URL)
+		}
+		if err := egress.ValidateHost(u.Hostname()); err != nil {
+			return fmt.Errorf("invalid http url %q: %w", sink.HTTP.URL, err)
+		}
+	case sink.File != nil:
+		if path.IsAbs(sink.File.Path) {
+			return fmt.Errorf("invalid file path %q: must be relative to the log sink directory", sink.File.Path)
+		}
+		for _, elem := range strings.Split(sink.File.Path, "/") {
+			if elem == ".." {
+				return fmt.Errorf("invalid file path %q: can not contain ..", sink.File.Path)
+			}
+		}
+	}
+	return nil
+}
+
+// Parse parses the short form of a log sink used by the CLI: syslog+tcp://host:port, syslog+udp://host:port,
+// http(s)://host/path or file:///path, where the path of a file sink is relative to the log sink directory
+func Parse(s string) (apiv1.LogSink, error) {
+	u, err := url.Parse(s)
+	if err != nil {
+		return apiv1.LogSink{}, err
+	}
+
+	var sink apiv1.LogSink
+	switch u.Scheme {
+	case "syslog", "syslog+udp":
+		sink.Syslog = &apiv1.SyslogLogSink{Protocol: ProtocolUDP, Address: u.Host}
+	case "syslog+tcp":
+		sink.Syslog = &apiv1.SyslogLogSink{Protocol: ProtocolTCP, Address: u.Host}
+	case "http", "https":
+		sink.HTTP = &apiv1.HTTPLogSink{URL: s}
+	case "file":
+		if u.Host != "" {
+			return sink, fmt.Errorf("invalid log sink %q: must be of the form file:///path", s)
+		}
+		sink.File = &apiv1.FileLogSink{Path: strings.TrimPrefix(u.Path, "/")}
+	default:
+		return sink, fmt.Errorf("invalid log sink %q: must start with syslog+tcp://, syslog+udp://, http://, https:// or file://", s)
+	}
+
+	return sink, Validate(sink)
+}
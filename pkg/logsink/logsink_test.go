@@ -0,0 +1,133 @@
+package logsink
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		sink     string
+		expected apiv1.LogSink
+		err      string
+	}{
+		{
+			sink:     "syslog+tcp://logs.example.com:514",
+			expected: apiv1.LogSink{Syslog: &apiv1.SyslogLogSink{Protocol: ProtocolTCP, Address: "logs.example.com:514"}},
+		},
+		{
+			sink:     "syslog://203.0.113.10:514",
+			expected: apiv1.LogSink{Syslog: &apiv1.SyslogLogSink{Protocol: ProtocolUDP, Address: "203.0.113.10:514"}},
+		},
+		{
+			sink: "syslog+tcp://127.0.0.1:514",
+			err:  "destination is not allowed",
+		},
+		{
+			sink: "http://169.254.169.254/latest/meta-data",
+			err:  "destination is not allowed",
+		},
+		{
+			sink:     "https://logs.example.com/ingest",
+			expected: apiv1.LogSink{HTTP: &apiv1.HTTPLogSink{URL: "https://logs.example.com/ingest"}},
+		},
+		{
+			sink:     "file:///my-project",
+			expected: apiv1.LogSink{File: &apiv1.FileLogSink{Path: "my-project"}},
+		},
+		{
+			sink: "file://my-project",
+			err:  "must be of the form file:///path",
+		},
+		{
+			sink: "file:///../var/log",
+			err:  "can not contain ..",
+		},
+		{
+			sink: "syslog+tcp://logs.example.com",
+			err:  "invalid syslog address",
+		},
+		{
+			sink: "kafka://logs.example.com:9092",
+			err:  "must start with",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.sink, func(t *testing.T) {
+			sink, err := Parse(tt.sink)
+			if tt.err != "" {
+				assert.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, sink)
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	assert.ErrorContains(t, Validate(apiv1.LogSink{}), "exactly one")
+	assert.ErrorContains(t, Validate(apiv1.LogSink{
+		HTTP: &apiv1.HTTPLogSink{URL: "http://localhost"},
+		File: &apiv1.FileLogSink{Path: "logs"},
+	}), "exactly one")
+	assert.ErrorContains(t, Validate(apiv1.LogSink{Syslog: &apiv1.SyslogLogSink{Protocol: "sctp", Address: "localhost:514"}}), "invalid syslog protocol")
+	assert.ErrorContains(t, Validate(apiv1.LogSink{HTTP: &apiv1.HTTPLogSink{URL: "/ingest"}}), "must be an absolute")
+	assert.ErrorContains(t, Validate(apiv1.LogSink{File: &apiv1.FileLogSink{Path: "/var/log"}}), "must be relative")
+	assert.ErrorContains(t, Validate(apiv1.LogSink{File: &apiv1.FileLogSink{Path: "logs/../../var/log"}}), "can not contain ..")
+	assert.NoError(t, Validate(apiv1.LogSink{File: &apiv1.FileLogSink{}}))
+}
+
+func TestFile(t *testing.T) {
+	dir := t.TempDir()
+	sink, err := New("my-project", apiv1.LogSink{File: &apiv1.FileLogSink{Path: "logs"}}, dir)
+	require.NoError(t, err)
+
+	ts := time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC)
+	require.NoError(t, sink.Send(context.Background(), []Record{
+		{Time: ts, Project: "my-project", App: "app", Container: "web", Replica: "app.web-1", Line: "one"},
+	}))
+	require.NoError(t, sink.Send(context.Background(), []Record{
+		{Time: ts, Project: "my-project", App: "app", Container: "web", Replica: "app.web-1", Line: "two"},
+	}))
+	require.NoError(t, sink.Close())
+
+	data, err := os.ReadFile(filepath.Join(dir, "logs", "my-project.log"))
+	require.NoError(t, err)
+	assert.Equal(t, `{"time":"2023-07-01T12:00:00Z","project":"my-project","app":"app","container":"web","replica":"app.web-1","line":"one"}
+{"time":"2023-07-01T12:00:00Z","project":"my-project","app":"app","container":"web","replica":"app.web-1","line":"two"}
+`, string(data))
+}
+
+func TestFileDisabled(t *testing.T) {
+	_, err := New("my-project", apiv1.LogSink{File: &apiv1.FileLogSink{Path: "logs"}}, "")
+	assert.ErrorIs(t, err, ErrFileLogSinksDisabled)
+}
+
+func TestFileSymlinks(t *testing.T) {
+	dir, outside := t.TempDir(), t.TempDir()
+	require.NoError(t, os.Symlink(outside, filepath.Join(dir, "escape")))
+	require.NoError(t, os.Symlink(filepath.Join(outside, "my-project.log"), filepath.Join(dir, "my-project.log")))
+
+	records := []Record{{Project: "my-project", Line: "one"}}
+
+	sink, err := New("my-project", apiv1.LogSink{File: &apiv1.FileLogSink{Path: "escape"}}, dir)
+	require.NoError(t, err)
+	assert.ErrorContains(t, sink.Send(context.Background(), records), "is not a directory")
+
+	sink, err = New("my-project", apiv1.LogSink{File: &apiv1.FileLogSink{}}, dir)
+	require.NoError(t, err)
+	assert.ErrorContains(t, sink.Send(context.Background(), records), "must be a regular file")
+
+	entries, err := os.ReadDir(outside)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
@@ -0,0 +1,126 @@
+package logsink
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// priority is the facility user (1) and severity informational (6), see RFC 5424 section 6.2.1
+	priority = 1*8 + 6
+	// sdID is the ID of the structured data element with the labels of the record. 32473 is the private enterprise
+	// number reserved for documentation, see RFC 5612.
+	sdID = "acorn@32473"
+)
+
+var sdEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`)
+
+type Syslog struct {
+	protocol string
+	address  string
+	hostname string
+	dialer   *net.Dialer
+
+	lock sync.Mutex
+	conn net.Conn
+}
+
+// NewSyslog returns a sink that sends every record as an RFC 5424 message. Messages are framed with octet counting
+// over TCP (RFC 6587) and sent as a datagram each over UDP. If dialer is nil, a net.Dialer without restrictions is used.
+func NewSyslog(protocol, address string, dialer *net.Dialer) *Syslog {
+	if protocol == "" {
+		protocol = ProtocolUDP
+	}
+	if dialer == nil {
+		dialer = &net.Dialer{}
+	}
+	hostname, _ := os.Hostname()
+	return &Syslog{
+		protocol: protocol,
+		address:  address,
+		hostname: nilValue(hostname),
+		dialer:   dialer,
+	}
+}
+
+func (s *Syslog) Send(ctx context.Context, records []Record) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.conn == nil {
+		conn, err := s.dialer.DialContext(ctx, s.protocol, s.address)
+		if err != nil {
+			return err
+		}
+		s.conn = conn
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = s.conn.SetWriteDeadline(deadline)
+	} else {
+		_ = s.conn.SetWriteDeadline(time.Time{})
+	}
+
+	for _, record := range records {
+		msg := s.format(record)
+		if s.protocol == ProtocolTCP {
+			msg = fmt.Sprintf("%d %s", len(msg), msg)
+		}
+		if _, err := s.conn.Write([]byte(msg)); err != nil {
+			// the connection is reopened on the next send
+			_ = s.conn.Close()
+			s.conn = nil
+			return err
+		}
+	}
+	return nil
+}
+
+// format returns the RFC 5424 message of the record. The app is the APP-NAME, the replica the PROCID and the container
+// the MSGID, and all labels are also added as structured data.
+func (s *Syslog) format(r Record) string {
+	return fmt.Sprintf("<%d>1 %s %s %s %s %s [%s project=\"%s\" app=\"%s\" container=\"%s\" replica=\"%s\"] %s",
+		priority,
+		r.Time.UTC().Format(time.RFC3339Nano),
+		s.hostname,
+		nilValue(truncate(r.App, 48)),
+		nilValue(truncate(r.Replica, 128)),
+		nilValue(truncate(r.Container, 32)),
+		sdID,
+		sdEscaper.Replace(r.Project),
+		sdEscaper.Replace(r.App),
+		sdEscaper.Replace(r.Container),
+		sdEscaper.Replace(r.Replica),
+		r.Line)
+}
+
+func (s *Syslog) Close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.conn == nil {
+		return nil
+	}
+	err := s.conn.Close()
+	s.conn = nil
+	return err
+}
+
+// nilValue returns the value or the NILVALUE of RFC 5424 if it is empty
+func nilValue(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+func truncate(s string, length int) string {
+	if len(s) > length {
+		return s[:length]
+	}
+	return s
+}
@@ -0,0 +1,89 @@
+package logsink
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testRecord = Record{
+	Time:      time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC),
+	Project:   "my-project",
+	App:       "app",
+	Container: "web",
+	Replica:   "app.web-1",
+	Line:      `hello "world"`,
+}
+
+func TestSyslogUDP(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	sink := NewSyslog(ProtocolUDP, conn.LocalAddr().String(), nil)
+	sink.hostname = "controller"
+	defer sink.Close()
+
+	require.NoError(t, sink.Send(context.Background(), []Record{testRecord}))
+
+	buf := make([]byte, 1024)
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	n, _, err := conn.ReadFrom(buf)
+	require.NoError(t, err)
+	assert.Equal(t, `<14>1 2023-07-01T12:00:00Z controller app app.web-1 web [acorn@32473 project="my-project" app="app" container="web" replica="app.web-1"] hello "world"`, string(buf[:n]))
+}
+
+func TestSyslogTCP(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+
+	received := make(chan string, 2)
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		r := bufio.NewReader(conn)
+		for {
+			// octet counting framing: MSG-LEN SP SYSLOG-MSG
+			length, err := r.ReadString(' ')
+			if err != nil {
+				return
+			}
+			n, err := strconv.Atoi(strings.TrimSpace(length))
+			if err != nil {
+				return
+			}
+			msg := make([]byte, n)
+			if _, err := r.Read(msg); err != nil {
+				return
+			}
+			received <- string(msg)
+		}
+	}()
+
+	sink := NewSyslog(ProtocolTCP, l.Addr().String(), nil)
+	defer sink.Close()
+
+	second := testRecord
+	second.Line = "second"
+	require.NoError(t, sink.Send(context.Background(), []Record{testRecord, second}))
+
+	for _, line := range []string{`hello "world"`, "second"} {
+		select {
+		case msg := <-received:
+			assert.True(t, strings.HasSuffix(msg, "] "+line), msg)
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for syslog message")
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package metrics
+
+import (
+	"context"
+	"reflect"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// modulePkg is trimmed from the names of handlers to keep them short
+const modulePkg = "github.com/acorn-io/runtime/pkg/"
+
+var appsDesc = prometheus.NewDesc(
+	prometheus.BuildFQName(namespace, "", "apps"),
+	"Number of apps by namespace and state.",
+	[]string{"namespace", "state"}, nil,
+)
+
+// HandlerMiddleware records the executions, errors and duration of the handler it wraps. Handlers are named after
+// their function or type, so that the names stay the same when the code around the handler changes.
+func HandlerMiddleware(h router.Handler) router.Handler {
+	name := handlerName(h)
+	return router.HandlerFunc(func(req router.Request, resp router.Response) error {
+		start := time.Now()
+		err := h.Handle(req, resp)
+		HandlerExecutions.WithLabelValues(name, req.GVK.Kind, Result(err)).Inc()
+		HandlerDuration.WithLabelValues(name, req.GVK.Kind).Observe(time.Since(start).Seconds())
+		return err
+	})
+}
+
+// handlerName returns the package qualified name of the function of the handler, or of its type if it is not a
+// function. Packages of this module are relative to its pkg directory.
+func handlerName(h router.Handler) string {
+	var name string
+	switch v := h.(type) {
+	case router.FinalizerHandler:
+		return handlerName(v.Next)
+	case router.HandlerFunc:
+		name = runtime.FuncForPC(reflect.ValueOf(v).Pointer()).Name()
+		// methods that are used as handlers have a -fm suffix
+		name = strings.TrimSuffix(name, "-fm")
+	default:
+		t := reflect.TypeOf(h)
+		for t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+		name = t.PkgPath() + "." + t.Name()
+	}
+	return strings.TrimPrefix(name, modulePkg)
+}
+
+type appCollector struct {
+	client kclient.Reader
+}
+
+// NewAppCollector returns a collector that reports the number of apps in each state. The client should be backed by a
+// cache because the apps are listed every time metrics are scraped.
+func NewAppCollector(client kclient.Reader) prometheus.Collector {
+	return &appCollector{
+		client: client,
+	}
+}
+
+func (a *appCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- appsDesc
+}
+
+func (a *appCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var apps v1.AppInstanceList
+	if err := a.client.List(ctx, &apps); err != nil {
+		logrus.Errorf("Failed to list apps for metrics: %v", err)
+		return
+	}
+
+	counts := map[[2]string]int{}
+	for _, app := range apps.Items {
+		counts[[2]string{app.Namespace, appState(app)}]++
+	}
+
+	for key, count := range counts {
+		ch <- prometheus.MustNewConstMetric(appsDesc, prometheus.GaugeValue, float64(count), key[0], key[1])
+	}
+}
+
+func appState(app v1.AppInstance) string {
+	switch {
+	case !app.DeletionTimestamp.IsZero():
+		return "removing"
+	case app.GetStopped():
+		return "stopped"
+	case app.Status.Ready:
+		return "ready"
+	default:
+		return "not_ready"
+	}
+}
@@ -0,0 +1,157 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/acorn-io/baaah/pkg/backend"
+	"github.com/acorn-io/baaah/pkg/router"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/pointer"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestHandlerMiddleware(t *testing.T) {
+	var fail bool
+	handler := router.HandlerFunc(func(router.Request, router.Response) error {
+		if fail {
+			return errors.New("failed")
+		}
+		return nil
+	})
+
+	h := HandlerMiddleware(handler)
+	name := "metrics.TestHandlerMiddleware.func1"
+
+	req := router.Request{GVK: schema.GroupVersionKind{Kind: "AppInstance"}}
+	require.NoError(t, h.Handle(req, nil))
+	fail = true
+	require.Error(t, h.Handle(req, nil))
+	require.Error(t, h.Handle(req, nil))
+
+	assert.Equal(t, 1.0, testutil.ToFloat64(HandlerExecutions.WithLabelValues(name, "AppInstance", "success")))
+	assert.Equal(t, 2.0, testutil.ToFloat64(HandlerExecutions.WithLabelValues(name, "AppInstance", "error")))
+	assert.Equal(t, 1, testutil.CollectAndCount(HandlerDuration, "acorn_controller_handler_duration_seconds"))
+}
+
+type testHandler struct{}
+
+func (testHandler) Handle(router.Request, router.Response) error {
+	return nil
+}
+
+func testHandlerFunc(router.Request, router.Response) error {
+	return nil
+}
+
+func TestHandlerName(t *testing.T) {
+	assert.Equal(t, "metrics.testHandlerFunc", handlerName(router.HandlerFunc(testHandlerFunc)))
+	assert.Equal(t, "metrics.testHandler.Handle", handlerName(router.HandlerFunc(testHandler{}.Handle)))
+	assert.Equal(t, "metrics.testHandler", handlerName(&testHandler{}))
+	assert.Equal(t, "metrics.testHandlerFunc", handlerName(router.FinalizerHandler{
+		FinalizerID: "test",
+		Next:        router.HandlerFunc(testHandlerFunc),
+	}))
+}
+
+// fakeBackend is a router backend that is backed by a fake client and hands the watch callbacks to the test
+type fakeBackend struct {
+	kclient.Client
+	callbacks map[schema.GroupVersionKind]backend.Callback
+}
+
+func (f *fakeBackend) Trigger(schema.GroupVersionKind, string, time.Duration) error {
+	return nil
+}
+
+func (f *fakeBackend) GetInformerForKind(context.Context, schema.GroupVersionKind) (cache.SharedIndexInformer, error) {
+	return nil, nil
+}
+
+func (f *fakeBackend) Watch(_ context.Context, gvk schema.GroupVersionKind, _ string, cb backend.Callback) error {
+	f.callbacks[gvk] = cb
+	return nil
+}
+
+func (f *fakeBackend) Start(context.Context) error {
+	return nil
+}
+
+func (f *fakeBackend) GVKForObject(obj runtime.Object, scheme *runtime.Scheme) (schema.GroupVersionKind, error) {
+	return apiutil.GVKForObject(obj, scheme)
+}
+
+func TestHandlerMiddlewareRoutes(t *testing.T) {
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(corev1.SchemeGroupVersion.WithKind("ConfigMap"), meta.RESTScopeNamespace)
+	b := &fakeBackend{
+		Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRESTMapper(mapper).WithObjects(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "routed", Namespace: "acorn"},
+		}).Build(),
+		callbacks: map[schema.GroupVersionKind]backend.Callback{},
+	}
+	handlers := router.NewHandlerSet("test", scheme.Scheme, b)
+	r := router.New(handlers, nil, 0)
+
+	// The middleware of the route wraps the handlers in closures, the metrics must still be named after the handlers
+	passThrough := func(h router.Handler) router.Handler {
+		return router.HandlerFunc(func(req router.Request, resp router.Response) error {
+			return h.Handle(req, resp)
+		})
+	}
+	route := r.Type(&corev1.ConfigMap{}).Middleware(passThrough)
+	route.Middleware(HandlerMiddleware).HandlerFunc(testHandlerFunc)
+	route.Middleware(HandlerMiddleware).HandlerFunc(testHandler{}.Handle)
+
+	require.NoError(t, handlers.Start(context.Background()))
+	gvk := corev1.SchemeGroupVersion.WithKind("ConfigMap")
+	_, err := b.callbacks[gvk](gvk, "acorn/routed", nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1.0, testutil.ToFloat64(HandlerExecutions.WithLabelValues("metrics.testHandlerFunc", "ConfigMap", "success")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(HandlerExecutions.WithLabelValues("metrics.testHandler.Handle", "ConfigMap", "success")))
+}
+
+func TestAppCollector(t *testing.T) {
+	client := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(
+		&v1.AppInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: "ready", Namespace: "acorn"},
+			Status:     v1.AppInstanceStatus{Ready: true},
+		},
+		&v1.AppInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: "also-ready", Namespace: "acorn"},
+			Status:     v1.AppInstanceStatus{Ready: true},
+		},
+		&v1.AppInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: "stopped", Namespace: "acorn"},
+			Spec:       v1.AppInstanceSpec{Stop: pointer.Bool(true)},
+		},
+		&v1.AppInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: "deploying", Namespace: "other"},
+		},
+	).Build()
+
+	expected := `
+# HELP acorn_apps Number of apps by namespace and state.
+# TYPE acorn_apps gauge
+acorn_apps{namespace="acorn",state="ready"} 2
+acorn_apps{namespace="acorn",state="stopped"} 1
+acorn_apps{namespace="other",state="not_ready"} 1
+`
+	assert.NoError(t, testutil.CollectAndCompare(NewAppCollector(client), strings.NewReader(expected)))
+}
@@ -0,0 +1,136 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/sirupsen/logrus"
+	"k8s.io/component-base/metrics/legacyregistry"
+)
+
+const namespace = "acorn"
+
+var (
+	// Registry holds all acorn specific metrics. It is served alongside the Kubernetes legacy registry, which already
+	// provides the Go runtime and process collectors as well as the api-server's own request metrics.
+	Registry = prometheus.NewRegistry()
+
+	HandlerExecutions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "controller",
+		Name:      "handler_executions_total",
+		Help:      "Number of controller handler executions by handler, kind and result.",
+	}, []string{"handler", "kind", "result"})
+
+	HandlerDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "controller",
+		Name:      "handler_duration_seconds",
+		Help:      "Time spent executing controller handlers by handler and kind.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"handler", "kind"})
+
+	AutoUpgradeChecks = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "autoupgrade",
+		Name:      "checks_total",
+		Help:      "Number of auto-upgrade checks of an app's image by result.",
+	}, []string{"result"})
+
+	DNSRenewals = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "dns",
+		Name:      "renewals_total",
+		Help:      "Number of AcornDNS domain renewals by result.",
+	}, []string{"result"})
+
+	DNSLastRenewal = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "dns",
+		Name:      "last_renewal_timestamp_seconds",
+		Help:      "Unix time of the last successful AcornDNS domain renewal.",
+	}, []string{"domain"})
+
+	CertificateExpiry = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "letsencrypt",
+		Name:      "certificate_expiry_timestamp_seconds",
+		Help:      "Unix time after which a Let's Encrypt certificate is no longer valid.",
+	}, []string{"namespace", "name", "domain"})
+
+	Builds = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "build",
+		Name:      "builds_total",
+		Help:      "Number of image builds by result.",
+	}, []string{"result"})
+
+	BuildDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "build",
+		Name:      "duration_seconds",
+		Help:      "Time spent building images by result.",
+		Buckets:   prometheus.ExponentialBuckets(1, 2, 12),
+	}, []string{"result"})
+)
+
+func init() {
+	Registry.MustRegister(
+		HandlerExecutions,
+		HandlerDuration,
+		AutoUpgradeChecks,
+		DNSRenewals,
+		DNSLastRenewal,
+		CertificateExpiry,
+		Builds,
+		BuildDuration,
+	)
+}
+
+// Result returns the value used for the result label given the error of an operation.
+func Result(err error) string {
+	if err != nil {
+		return "error"
+	}
+	return "success"
+}
+
+// Handler returns an http.Handler exposing the acorn and Kubernetes metrics in the Prometheus text format.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(prometheus.Gatherers{legacyregistry.DefaultGatherer, Registry}, promhttp.HandlerOpts{
+		ErrorLog: logrus.StandardLogger(),
+	})
+}
+
+// Serve serves the metrics handler at /metrics on the given port until the context is done. A port of zero
+// disables serving metrics.
+func Serve(ctx context.Context, port int) {
+	if port == 0 {
+		return
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", Handler())
+	server := &http.Server{
+		Addr:              fmt.Sprintf(":%d", port),
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		<-ctx.Done()
+		_ = server.Close()
+	}()
+
+	go func() {
+		logrus.Infof("Serving metrics on :%d/metrics", port)
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logrus.Errorf("Failed to serve metrics: %v", err)
+		}
+	}()
+}
@@ -143,6 +143,21 @@ func (mr *MockClientMockRecorder) AppGet(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppGet", reflect.TypeOf((*MockClient)(nil).AppGet), arg0, arg1)
 }
 
+// AppHistory mocks base method.
+func (m *MockClient) AppHistory(arg0 context.Context, arg1 string) ([]v10.AppRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppHistory", arg0, arg1)
+	ret0, _ := ret[0].([]v10.AppRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AppHistory indicates an expected call of AppHistory.
+func (mr *MockClientMockRecorder) AppHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppHistory", reflect.TypeOf((*MockClient)(nil).AppHistory), arg0, arg1)
+}
+
 // AppIgnoreDeleteCleanup mocks base method.
 func (m *MockClient) AppIgnoreDeleteCleanup(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -201,6 +216,21 @@ func (mr *MockClientMockRecorder) AppPullImage(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppPullImage", reflect.TypeOf((*MockClient)(nil).AppPullImage), arg0, arg1)
 }
 
+// AppRollback mocks base method.
+func (m *MockClient) AppRollback(arg0 context.Context, arg1 string, arg2 int64) (*v1.App, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppRollback", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*v1.App)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AppRollback indicates an expected call of AppRollback.
+func (mr *MockClientMockRecorder) AppRollback(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppRollback", reflect.TypeOf((*MockClient)(nil).AppRollback), arg0, arg1, arg2)
+}
+
 // AppRun mocks base method.
 func (m *MockClient) AppRun(arg0 context.Context, arg1 string, arg2 *client.AppRunOptions) (*v1.App, error) {
 	m.ctrl.T.Helper()
@@ -364,6 +394,21 @@ func (mr *MockClientMockRecorder) ContainerReplicaPortForward(arg0, arg1, arg2 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContainerReplicaPortForward", reflect.TypeOf((*MockClient)(nil).ContainerReplicaPortForward), arg0, arg1, arg2)
 }
 
+// ContainerReplicaUsageList mocks base method.
+func (m *MockClient) ContainerReplicaUsageList(arg0 context.Context, arg1 *client.ContainerReplicaListOptions) ([]v1.ContainerReplicaUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContainerReplicaUsageList", arg0, arg1)
+	ret0, _ := ret[0].([]v1.ContainerReplicaUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContainerReplicaUsageList indicates an expected call of ContainerReplicaUsageList.
+func (mr *MockClientMockRecorder) ContainerReplicaUsageList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContainerReplicaUsageList", reflect.TypeOf((*MockClient)(nil).ContainerReplicaUsageList), arg0, arg1)
+}
+
 // CredentialCreate mocks base method.
 func (m *MockClient) CredentialCreate(arg0 context.Context, arg1, arg2, arg3 string, arg4 bool) (*v1.Credential, error) {
 	m.ctrl.T.Helper()
@@ -482,6 +527,66 @@ func (mr *MockClientMockRecorder) EventStream(arg0, arg1 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EventStream", reflect.TypeOf((*MockClient)(nil).EventStream), arg0, arg1)
 }
 
+// EventSubscriptionCreate mocks base method.
+func (m *MockClient) EventSubscriptionCreate(arg0 context.Context, arg1 string, arg2 v10.EventSubscriptionSpec) (*v1.EventSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EventSubscriptionCreate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*v1.EventSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EventSubscriptionCreate indicates an expected call of EventSubscriptionCreate.
+func (mr *MockClientMockRecorder) EventSubscriptionCreate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EventSubscriptionCreate", reflect.TypeOf((*MockClient)(nil).EventSubscriptionCreate), arg0, arg1, arg2)
+}
+
+// EventSubscriptionDelete mocks base method.
+func (m *MockClient) EventSubscriptionDelete(arg0 context.Context, arg1 string) (*v1.EventSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EventSubscriptionDelete", arg0, arg1)
+	ret0, _ := ret[0].(*v1.EventSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EventSubscriptionDelete indicates an expected call of EventSubscriptionDelete.
+func (mr *MockClientMockRecorder) EventSubscriptionDelete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EventSubscriptionDelete", reflect.TypeOf((*MockClient)(nil).EventSubscriptionDelete), arg0, arg1)
+}
+
+// EventSubscriptionGet mocks base method.
+func (m *MockClient) EventSubscriptionGet(arg0 context.Context, arg1 string) (*v1.EventSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EventSubscriptionGet", arg0, arg1)
+	ret0, _ := ret[0].(*v1.EventSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EventSubscriptionGet indicates an expected call of EventSubscriptionGet.
+func (mr *MockClientMockRecorder) EventSubscriptionGet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EventSubscriptionGet", reflect.TypeOf((*MockClient)(nil).EventSubscriptionGet), arg0, arg1)
+}
+
+// EventSubscriptionList mocks base method.
+func (m *MockClient) EventSubscriptionList(arg0 context.Context) ([]v1.EventSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EventSubscriptionList", arg0)
+	ret0, _ := ret[0].([]v1.EventSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EventSubscriptionList indicates an expected call of EventSubscriptionList.
+func (mr *MockClientMockRecorder) EventSubscriptionList(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EventSubscriptionList", reflect.TypeOf((*MockClient)(nil).EventSubscriptionList), arg0)
+}
+
 // GetClient mocks base method.
 func (m *MockClient) GetClient() (client0.WithWatch, error) {
 	m.ctrl.T.Helper()
@@ -645,6 +750,51 @@ func (mr *MockClientMockRecorder) Info(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Info", reflect.TypeOf((*MockClient)(nil).Info), arg0)
 }
 
+// JobExecutionGet mocks base method.
+func (m *MockClient) JobExecutionGet(arg0 context.Context, arg1 string) (*v1.JobExecution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JobExecutionGet", arg0, arg1)
+	ret0, _ := ret[0].(*v1.JobExecution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JobExecutionGet indicates an expected call of JobExecutionGet.
+func (mr *MockClientMockRecorder) JobExecutionGet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JobExecutionGet", reflect.TypeOf((*MockClient)(nil).JobExecutionGet), arg0, arg1)
+}
+
+// JobExecutionList mocks base method.
+func (m *MockClient) JobExecutionList(arg0 context.Context, arg1 *client.JobExecutionListOptions) ([]v1.JobExecution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JobExecutionList", arg0, arg1)
+	ret0, _ := ret[0].([]v1.JobExecution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JobExecutionList indicates an expected call of JobExecutionList.
+func (mr *MockClientMockRecorder) JobExecutionList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JobExecutionList", reflect.TypeOf((*MockClient)(nil).JobExecutionList), arg0, arg1)
+}
+
+// JobRun mocks base method.
+func (m *MockClient) JobRun(arg0 context.Context, arg1 string) (*v1.JobExecution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JobRun", arg0, arg1)
+	ret0, _ := ret[0].(*v1.JobExecution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JobRun indicates an expected call of JobRun.
+func (mr *MockClientMockRecorder) JobRun(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JobRun", reflect.TypeOf((*MockClient)(nil).JobRun), arg0, arg1)
+}
+
 // ProjectCreate mocks base method.
 func (m *MockClient) ProjectCreate(arg0 context.Context, arg1, arg2 string, arg3 []string) (*v1.Project, error) {
 	m.ctrl.T.Helper()
@@ -840,6 +990,51 @@ func (mr *MockClientMockRecorder) SecretUpdate(arg0, arg1, arg2 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecretUpdate", reflect.TypeOf((*MockClient)(nil).SecretUpdate), arg0, arg1, arg2)
 }
 
+// VolumeBackupCreate mocks base method.
+func (m *MockClient) VolumeBackupCreate(arg0 context.Context, arg1 string, arg2 v1.VolumeBackupSpec) (*v1.VolumeBackup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeBackupCreate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*v1.VolumeBackup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VolumeBackupCreate indicates an expected call of VolumeBackupCreate.
+func (mr *MockClientMockRecorder) VolumeBackupCreate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeBackupCreate", reflect.TypeOf((*MockClient)(nil).VolumeBackupCreate), arg0, arg1, arg2)
+}
+
+// VolumeBackupGet mocks base method.
+func (m *MockClient) VolumeBackupGet(arg0 context.Context, arg1 string) (*v1.VolumeBackup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeBackupGet", arg0, arg1)
+	ret0, _ := ret[0].(*v1.VolumeBackup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VolumeBackupGet indicates an expected call of VolumeBackupGet.
+func (mr *MockClientMockRecorder) VolumeBackupGet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeBackupGet", reflect.TypeOf((*MockClient)(nil).VolumeBackupGet), arg0, arg1)
+}
+
+// VolumeBackupList mocks base method.
+func (m *MockClient) VolumeBackupList(arg0 context.Context) ([]v1.VolumeBackup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeBackupList", arg0)
+	ret0, _ := ret[0].([]v1.VolumeBackup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VolumeBackupList indicates an expected call of VolumeBackupList.
+func (mr *MockClientMockRecorder) VolumeBackupList(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeBackupList", reflect.TypeOf((*MockClient)(nil).VolumeBackupList), arg0)
+}
+
 // VolumeClassGet mocks base method.
 func (m *MockClient) VolumeClassGet(arg0 context.Context, arg1 string) (*v1.VolumeClass, error) {
 	m.ctrl.T.Helper()
@@ -915,6 +1110,66 @@ func (mr *MockClientMockRecorder) VolumeList(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeList", reflect.TypeOf((*MockClient)(nil).VolumeList), arg0)
 }
 
+// VolumeSnapshotCreate mocks base method.
+func (m *MockClient) VolumeSnapshotCreate(arg0 context.Context, arg1, arg2, arg3 string) (*v1.VolumeSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeSnapshotCreate", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*v1.VolumeSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VolumeSnapshotCreate indicates an expected call of VolumeSnapshotCreate.
+func (mr *MockClientMockRecorder) VolumeSnapshotCreate(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeSnapshotCreate", reflect.TypeOf((*MockClient)(nil).VolumeSnapshotCreate), arg0, arg1, arg2, arg3)
+}
+
+// VolumeSnapshotDelete mocks base method.
+func (m *MockClient) VolumeSnapshotDelete(arg0 context.Context, arg1 string) (*v1.VolumeSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeSnapshotDelete", arg0, arg1)
+	ret0, _ := ret[0].(*v1.VolumeSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VolumeSnapshotDelete indicates an expected call of VolumeSnapshotDelete.
+func (mr *MockClientMockRecorder) VolumeSnapshotDelete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeSnapshotDelete", reflect.TypeOf((*MockClient)(nil).VolumeSnapshotDelete), arg0, arg1)
+}
+
+// VolumeSnapshotGet mocks base method.
+func (m *MockClient) VolumeSnapshotGet(arg0 context.Context, arg1 string) (*v1.VolumeSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeSnapshotGet", arg0, arg1)
+	ret0, _ := ret[0].(*v1.VolumeSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VolumeSnapshotGet indicates an expected call of VolumeSnapshotGet.
+func (mr *MockClientMockRecorder) VolumeSnapshotGet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeSnapshotGet", reflect.TypeOf((*MockClient)(nil).VolumeSnapshotGet), arg0, arg1)
+}
+
+// VolumeSnapshotList mocks base method.
+func (m *MockClient) VolumeSnapshotList(arg0 context.Context) ([]v1.VolumeSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeSnapshotList", arg0)
+	ret0, _ := ret[0].([]v1.VolumeSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VolumeSnapshotList indicates an expected call of VolumeSnapshotList.
+func (mr *MockClientMockRecorder) VolumeSnapshotList(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeSnapshotList", reflect.TypeOf((*MockClient)(nil).VolumeSnapshotList), arg0)
+}
+
 // MockProjectClientFactory is a mock of ProjectClientFactory interface.
 type MockProjectClientFactory struct {
 	ctrl     *gomock.Controller
@@ -1,14 +1,25 @@
 package openapi
 
 import (
+	"reflect"
+	"strings"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/openapi/generated"
+	"github.com/acorn-io/runtime/pkg/scheme"
 	"k8s.io/kube-openapi/pkg/common"
 	"k8s.io/kube-openapi/pkg/validation/spec"
 )
 
+var (
+	milliCPUType = reflect.TypeOf(v1.MilliCPU(0))
+	cpuMapType   = reflect.TypeOf(v1.CPUMap{})
+)
+
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	result := generated.GetOpenAPIDefinitions(ref)
-	for _, v := range result {
+	structs := structTypes()
+	for definitionName, v := range result {
 		for name := range v.Schema.SchemaProps.Properties {
 			if name == "deployArgs" || name == "buildArgs" || name == "params" {
 				v.Schema.SchemaProps.Properties[name] = spec.Schema{
@@ -20,6 +31,72 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 				}
 			}
 		}
+		if t, ok := structs[definitionName]; ok {
+			milliCPUProperties(t, v.Schema.SchemaProps.Properties)
+		}
 	}
 	return result
 }
+
+// structTypes returns the struct types that are reachable from the types of the scheme by their definition name
+func structTypes() map[string]reflect.Type {
+	result := map[string]reflect.Type{}
+	var walk func(t reflect.Type)
+	walk = func(t reflect.Type) {
+		for t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice || t.Kind() == reflect.Array || t.Kind() == reflect.Map {
+			t = t.Elem()
+		}
+		if t.Kind() != reflect.Struct {
+			return
+		}
+		if t.Name() != "" {
+			name := t.PkgPath() + "." + t.Name()
+			if _, ok := result[name]; ok {
+				return
+			}
+			result[name] = t
+		}
+		for i := 0; i < t.NumField(); i++ {
+			walk(t.Field(i).Type)
+		}
+	}
+	for _, t := range scheme.Scheme.AllKnownTypes() {
+		walk(t)
+	}
+	return result
+}
+
+// milliCPUProperties changes the properties of the struct that hold a v1.MilliCPU, which is an int64 that is
+// serialized as a quantity, to strings.
+func milliCPUProperties(t reflect.Type, properties map[string]spec.Schema) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "" && field.Anonymous && fieldType.Kind() == reflect.Struct {
+			milliCPUProperties(fieldType, properties)
+			continue
+		}
+
+		schema, ok := properties[name]
+		if !ok {
+			continue
+		}
+
+		switch fieldType {
+		case milliCPUType:
+			schema.Type, schema.Format = []string{"string"}, ""
+		case cpuMapType:
+			if schema.AdditionalProperties != nil && schema.AdditionalProperties.Schema != nil {
+				schema.AdditionalProperties.Schema.Type, schema.AdditionalProperties.Schema.Format = []string{"string"}, ""
+			}
+		default:
+			continue
+		}
+		properties[name] = schema
+	}
+}
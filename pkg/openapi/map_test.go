@@ -0,0 +1,30 @@
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/kube-openapi/pkg/validation/spec"
+)
+
+func TestMilliCPUProperties(t *testing.T) {
+	defs := GetOpenAPIDefinitions(func(path string) spec.Ref {
+		return spec.MustCreateRef(path)
+	})
+
+	get := func(definition, property string) spec.Schema {
+		t.Helper()
+		return defs[definition].Schema.Properties[property]
+	}
+
+	container := get("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Container", "cpu")
+	assert.Equal(t, spec.StringOrArray{"string"}, container.Type)
+	assert.Equal(t, "", container.Format)
+
+	appInstanceSpec := get("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppInstanceSpec", "cpu")
+	assert.Equal(t, spec.StringOrArray{"string"}, appInstanceSpec.AdditionalProperties.Schema.Type)
+
+	// The cpu of a compute class is not a MilliCPU and keeps its own schema
+	computeClass := get("github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ProjectComputeClassInstance", "cpu")
+	assert.Equal(t, "github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ComputeClassCPU", computeClass.Ref.String())
+}
@@ -1,12 +1,26 @@
 package pdb
 
 import (
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
-func ToPodDisruptionBudget(dep *appsv1.Deployment) *policyv1.PodDisruptionBudget {
+// ToPodDisruptionBudget returns the budget for the pods of the deployment. If availability is nil the budget is
+// derived from the number of replicas.
+func ToPodDisruptionBudget(dep *appsv1.Deployment, availability *v1.Availability) *policyv1.PodDisruptionBudget {
+	if availability != nil && (availability.MaxUnavailable != nil || availability.MinAvailable != nil) {
+		return &policyv1.PodDisruptionBudget{
+			ObjectMeta: dep.ObjectMeta,
+			Spec: policyv1.PodDisruptionBudgetSpec{
+				Selector:       dep.Spec.Selector,
+				MaxUnavailable: availability.MaxUnavailable,
+				MinAvailable:   availability.MinAvailable,
+			},
+		}
+	}
+
 	var maxUnavailable intstr.IntOrString
 	if dep.Spec.Replicas == nil {
 		maxUnavailable = intstr.FromString("25%")
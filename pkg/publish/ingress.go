@@ -15,6 +15,7 @@ import (
 
 	"github.com/acorn-io/baaah/pkg/router"
 	"github.com/acorn-io/baaah/pkg/typed"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/config"
 	"github.com/acorn-io/runtime/pkg/labels"
@@ -28,6 +29,10 @@ import (
 const (
 	customDomain  = "custom-domain"
 	clusterDomain = "cluster-domain"
+
+	nginxIngressController      = "k8s.io/ingress-nginx"
+	nginxCanaryAnnotation       = "nginx.ingress.kubernetes.io/canary"
+	nginxCanaryWeightAnnotation = "nginx.ingress.kubernetes.io/canary-weight"
 )
 
 var (
@@ -151,12 +156,9 @@ func Ingress(req router.Request, svc *v1.ServiceInstance) (result []kclient.Obje
 		return nil, err
 	}
 
-	ingressClassName := cfg.IngressClassName
-	if ingressClassName == nil {
-		ingressClassName, err = IngressClassNameIfNoDefault(req.Ctx, req.Client)
-		if err != nil {
-			return nil, err
-		}
+	ingressClassName, err := ingressClassNameForConfig(req.Ctx, req.Client, cfg)
+	if err != nil {
+		return nil, err
 	}
 
 	var (
@@ -256,12 +258,92 @@ func Ingress(req router.Request, svc *v1.ServiceInstance) (result []kclient.Obje
 		}
 		result = append(result, ingress)
 
+		if svc.Spec.Canary != nil && svc.Spec.Canary.Weight > 0 {
+			weighted, err := weightedRoutingSupported(req.Ctx, req.Client, ingressClassName)
+			if err != nil {
+				return nil, err
+			}
+			if weighted {
+				result = append(result, canaryIngress(ingress, svc.Name, svc.Spec.Canary))
+			}
+		}
+
 		result = append(result, secrets...)
 	}
 
 	return
 }
 
+// ingressClassNameForConfig returns the ingress class that published services are exposed with, nil means the
+// default ingress class of the cluster
+func ingressClassNameForConfig(ctx context.Context, client kclient.Client, cfg *apiv1.Config) (*string, error) {
+	if cfg.IngressClassName != nil {
+		return cfg.IngressClassName, nil
+	}
+	return IngressClassNameIfNoDefault(ctx, client)
+}
+
+// WeightedRoutingSupported returns true if the ingress controller that published services are exposed with can
+// split the traffic of a host by weight, which canary rollouts depend on.
+func WeightedRoutingSupported(ctx context.Context, client kclient.Client) (bool, error) {
+	cfg, err := config.Get(ctx, client)
+	if err != nil {
+		return false, err
+	}
+	ingressClassName, err := ingressClassNameForConfig(ctx, client, cfg)
+	if err != nil {
+		return false, err
+	}
+	return weightedRoutingSupported(ctx, client, ingressClassName)
+}
+
+// weightedRoutingSupported returns true if the controller of the ingress class can split the traffic of a host
+// between an ingress and its canary ingress.
+func weightedRoutingSupported(ctx context.Context, client kclient.Client, ingressClassName *string) (bool, error) {
+	var ingressClasses networkingv1.IngressClassList
+	if err := client.List(ctx, &ingressClasses); err != nil {
+		return false, err
+	}
+	for _, ic := range ingressClasses.Items {
+		if (ingressClassName != nil && ic.Name == *ingressClassName) ||
+			(ingressClassName == nil && ic.Annotations["ingressclass.kubernetes.io/is-default-class"] == "true") {
+			return ic.Spec.Controller == nginxIngressController, nil
+		}
+	}
+	return false, nil
+}
+
+// canaryIngress returns a copy of the ingress that sends the weight of the canary to the canary's service instead
+// of the service. It has no TLS settings of its own, the hosts keep being served with those of the ingress.
+func canaryIngress(ingress *networkingv1.Ingress, serviceName string, canary *v1.ServiceCanary) *networkingv1.Ingress {
+	result := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name.SafeConcatName(ingress.Name, "canary"),
+			Namespace: ingress.Namespace,
+			Labels:    ingress.Labels,
+			Annotations: map[string]string{
+				nginxCanaryAnnotation:       "true",
+				nginxCanaryWeightAnnotation: strconv.Itoa(int(canary.Weight)),
+			},
+		},
+		Spec: networkingv1.IngressSpec{
+			IngressClassName: ingress.Spec.IngressClassName,
+		},
+	}
+	for _, rule := range ingress.Spec.Rules {
+		rule = *rule.DeepCopy()
+		if rule.HTTP != nil {
+			for i, path := range rule.HTTP.Paths {
+				if path.Backend.Service != nil && path.Backend.Service.Name == serviceName {
+					rule.HTTP.Paths[i].Backend.Service.Name = canary.Container
+				}
+			}
+		}
+		result.Spec.Rules = append(result.Spec.Rules, rule)
+	}
+	return result
+}
+
 func setupCertManager(serviceName string, rules []networkingv1.IngressRule) []networkingv1.IngressTLS {
 	var result []networkingv1.IngressTLS
 	hostsSeen := map[string]bool{}
@@ -48,16 +48,21 @@ var (
 					"devsessions",
 					"images",
 					"volumes",
+					"volumesnapshots",
+					"volumebackups",
 					"containerreplicas",
 					"credentials",
 					"secrets",
 					"services",
 					"events",
+					"eventsubscriptions",
+					"jobexecutions",
 				},
 			},
 			{
 				Verbs: []string{"get", "list"},
 				Resources: []string{
+					"containerreplicausages",
 					"volumeclasses",
 					"computeclasses",
 					"regions",
@@ -93,6 +98,14 @@ var (
 					"devsessions",
 					"credentials",
 					"secrets",
+					"eventsubscriptions",
+				},
+			},
+			{
+				Verbs: []string{"create", "delete"},
+				Resources: []string{
+					"volumesnapshots",
+					"volumebackups",
 				},
 			},
 			{
@@ -108,6 +121,7 @@ var (
 					"apps/confirmupgrade",
 					"apps/pullimage",
 					"apps/ignorecleanup",
+					"jobexecutions",
 				},
 			},
 			{
@@ -116,6 +130,7 @@ var (
 					"services",
 					"volumes",
 					"containerreplicas",
+					"jobexecutions",
 				},
 			},
 			{
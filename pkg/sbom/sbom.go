@@ -0,0 +1,270 @@
+package sbom
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/google/go-containerregistry/pkg/name"
+	ggcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/sigstore/cosign/v2/pkg/oci"
+	"github.com/sigstore/cosign/v2/pkg/oci/mutate"
+	ociremote "github.com/sigstore/cosign/v2/pkg/oci/remote"
+	"github.com/sigstore/cosign/v2/pkg/oci/static"
+	"github.com/sigstore/cosign/v2/pkg/types"
+)
+
+const (
+	// PredicateTypeSPDX and PredicateTypeCycloneDX are the in-toto predicate types of SBOM attestations
+	PredicateTypeSPDX      = "https://spdx.dev/Document"
+	PredicateTypeCycloneDX = "https://cyclonedx.org/bom"
+
+	// Annotations buildkit sets on the attestation manifests in an image index and on the layers of those
+	referenceTypeAnnotation = "vnd.docker.reference.type"
+	predicateTypeAnnotation = "in-toto.io/predicate-type"
+	attestationManifestType = "attestation-manifest"
+
+	// predicateTypeManifestAnnotation is the annotation cosign sets on attestation layers
+	predicateTypeManifestAnnotation = "predicateType"
+)
+
+type statement struct {
+	Type          string          `json:"_type"`
+	PredicateType string          `json:"predicateType"`
+	Subject       []subject       `json:"subject"`
+	Predicate     json.RawMessage `json:"predicate"`
+}
+
+type subject struct {
+	Name   string            `json:"name"`
+	Digest map[string]string `json:"digest"`
+}
+
+// envelope is a DSSE envelope, which is how cosign stores attestations. The envelopes written here are not signed.
+type envelope struct {
+	PayloadType string `json:"payloadType"`
+	Payload     string `json:"payload"`
+	Signatures  []any  `json:"signatures"`
+}
+
+func isSBOM(predicateType string) bool {
+	return predicateType == PredicateTypeSPDX || predicateType == PredicateTypeCycloneDX
+}
+
+// Attest finds the SBOM attestations that buildkit attached to the images nested in the app image index and attaches
+// them to the app image index itself, next to its signatures, so they can be found from the app image alone. The
+// number of SBOMs found is returned.
+func Attest(index name.Digest, opts ...remote.Option) (int, error) {
+	statements, err := collect(index, opts)
+	if err != nil {
+		return 0, fmt.Errorf("failed to collect SBOMs of %s: %w", index, err)
+	}
+	if len(statements) == 0 {
+		return 0, nil
+	}
+
+	ociOpts := []ociremote.Option{ociremote.WithRemoteOptions(opts...)}
+	se, err := ociremote.SignedEntity(index, ociOpts...)
+	if err != nil {
+		return 0, err
+	}
+
+	existing, err := payloads(se)
+	if err != nil {
+		return 0, err
+	}
+
+	attached := 0
+	for _, stmt := range statements {
+		var s statement
+		if err := json.Unmarshal(stmt, &s); err != nil {
+			return 0, fmt.Errorf("invalid SBOM attestation: %w", err)
+		}
+
+		payload, err := json.Marshal(envelope{
+			PayloadType: types.IntotoPayloadType,
+			Payload:     base64.StdEncoding.EncodeToString(stmt),
+			Signatures:  []any{},
+		})
+		if err != nil {
+			return 0, err
+		}
+		if existing[string(payload)] {
+			// building the same image again yields the same app image index and SBOMs
+			continue
+		}
+
+		att, err := static.NewAttestation(payload,
+			static.WithLayerMediaType(types.DssePayloadType),
+			static.WithAnnotations(map[string]string{
+				predicateTypeManifestAnnotation: s.PredicateType,
+			}))
+		if err != nil {
+			return 0, err
+		}
+
+		se, err = mutate.AttachAttestationToEntity(se, att)
+		if err != nil {
+			return 0, err
+		}
+		attached++
+	}
+
+	if attached > 0 {
+		if err := ociremote.WriteAttestations(index.Repository, se, ociOpts...); err != nil {
+			return 0, fmt.Errorf("failed to write SBOM attestations for %s: %w", index, err)
+		}
+	}
+
+	return len(statements), nil
+}
+
+// collect returns the in-toto statements of the SBOM attestations in the index and all indexes nested in it
+func collect(d name.Digest, opts []remote.Option) (result [][]byte, _ error) {
+	index, err := remote.Index(d, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest, err := index.IndexManifest()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, desc := range manifest.Manifests {
+		switch {
+		case desc.MediaType.IsIndex():
+			nested, err := collect(d.Context().Digest(desc.Digest.String()), opts)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, nested...)
+		case desc.Annotations[referenceTypeAnnotation] == attestationManifestType:
+			img, err := index.Image(desc.Digest)
+			if err != nil {
+				return nil, err
+			}
+			statements, err := sbomLayers(img)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, statements...)
+		}
+	}
+
+	return result, nil
+}
+
+func sbomLayers(img ggcrv1.Image) (result [][]byte, _ error) {
+	manifest, err := img.Manifest()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, desc := range manifest.Layers {
+		if !isSBOM(desc.Annotations[predicateTypeAnnotation]) {
+			continue
+		}
+
+		layer, err := img.LayerByDigest(desc.Digest)
+		if err != nil {
+			return nil, err
+		}
+
+		// in-toto layers are not compressed, so the blob is the statement
+		rc, err := layer.Compressed()
+		if err != nil {
+			return nil, err
+		}
+		data, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, data)
+	}
+
+	return result, nil
+}
+
+// payloads returns the payloads of all attestations of the entity
+func payloads(se oci.SignedEntity) (map[string]bool, error) {
+	atts, err := se.Attestations()
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := atts.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]bool{}
+	for _, att := range list {
+		payload, err := att.Payload()
+		if err != nil {
+			return nil, err
+		}
+		result[string(payload)] = true
+	}
+	return result, nil
+}
+
+// Get returns the SBOMs attested for the app image
+func Get(d name.Digest, opts ...ociremote.Option) ([]v1.ImageSBOM, error) {
+	se, err := ociremote.SignedEntity(d, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := payloads(se)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []v1.ImageSBOM
+	for payload := range existing {
+		var env envelope
+		if err := json.Unmarshal([]byte(payload), &env); err != nil || env.PayloadType != types.IntotoPayloadType {
+			// not an in-toto attestation
+			continue
+		}
+
+		data, err := base64.StdEncoding.DecodeString(env.Payload)
+		if err != nil {
+			return nil, fmt.Errorf("invalid attestation payload: %w", err)
+		}
+
+		var s statement
+		if err := json.Unmarshal(data, &s); err != nil {
+			return nil, fmt.Errorf("invalid attestation statement: %w", err)
+		}
+		if !isSBOM(s.PredicateType) {
+			continue
+		}
+
+		sbom := v1.ImageSBOM{
+			PredicateType: s.PredicateType,
+		}
+		if len(s.Subject) > 0 && s.Subject[0].Digest["sha256"] != "" {
+			sbom.Image = "sha256:" + s.Subject[0].Digest["sha256"]
+		}
+		if err := json.Unmarshal(s.Predicate, &sbom.Document); err != nil {
+			return nil, fmt.Errorf("invalid SBOM document: %w", err)
+		}
+		result = append(result, sbom)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Image == result[j].Image {
+			return result[i].PredicateType < result[j].PredicateType
+		}
+		return result[i].Image < result[j].Image
+	})
+	return result, nil
+}
@@ -0,0 +1,109 @@
+package sbom
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	ggcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// buildkitImage returns an index like the one buildkit pushes for an image with an SBOM attestation
+func buildkitImage(t *testing.T) (ggcrv1.ImageIndex, ggcrv1.Hash) {
+	img, err := random.Image(64, 1)
+	require.NoError(t, err)
+	imgDigest, err := img.Digest()
+	require.NoError(t, err)
+
+	stmt, err := json.Marshal(statement{
+		Type:          "https://in-toto.io/Statement/v0.1",
+		PredicateType: PredicateTypeSPDX,
+		Subject: []subject{
+			{
+				Name:   "pkg:docker/test",
+				Digest: map[string]string{"sha256": imgDigest.Hex},
+			},
+		},
+		Predicate: json.RawMessage(`{"spdxVersion":"SPDX-2.3","packages":[{"name":"busybox"}]}`),
+	})
+	require.NoError(t, err)
+
+	att, err := mutate.Append(empty.Image, mutate.Addendum{
+		Layer: static.NewLayer(stmt, "application/vnd.in-toto+json"),
+		Annotations: map[string]string{
+			predicateTypeAnnotation: PredicateTypeSPDX,
+		},
+	})
+	require.NoError(t, err)
+
+	return mutate.AppendManifests(empty.Index,
+		mutate.IndexAddendum{
+			Add: img,
+		},
+		mutate.IndexAddendum{
+			Add: att,
+			Descriptor: ggcrv1.Descriptor{
+				Annotations: map[string]string{
+					referenceTypeAnnotation:       attestationManifestType,
+					"vnd.docker.reference.digest": imgDigest.String(),
+				},
+				Platform: &ggcrv1.Platform{
+					Architecture: "unknown",
+					OS:           "unknown",
+				},
+			},
+		}), imgDigest
+}
+
+func TestAttest(t *testing.T) {
+	s := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	defer s.Close()
+	u, err := url.Parse(s.URL)
+	require.NoError(t, err)
+
+	container, imgDigest := buildkitImage(t)
+	metadata, err := random.Image(64, 1)
+	require.NoError(t, err)
+
+	app := mutate.AppendManifests(empty.Index,
+		mutate.IndexAddendum{Add: metadata},
+		mutate.IndexAddendum{Add: container},
+	)
+	appDigest, err := app.Digest()
+	require.NoError(t, err)
+
+	ref, err := name.NewDigest(fmt.Sprintf("%s/acorn/app@%s", u.Host, appDigest))
+	require.NoError(t, err)
+	require.NoError(t, remote.WriteIndex(ref, app))
+
+	sboms, err := Get(ref)
+	require.NoError(t, err)
+	assert.Empty(t, sboms)
+
+	for i := 0; i < 2; i++ {
+		// attesting again must not add the same SBOM twice
+		found, err := Attest(ref)
+		require.NoError(t, err)
+		assert.Equal(t, 1, found)
+	}
+
+	sboms, err = Get(ref)
+	require.NoError(t, err)
+	require.Len(t, sboms, 1)
+	assert.Equal(t, imgDigest.String(), sboms[0].Image)
+	assert.Equal(t, PredicateTypeSPDX, sboms[0].PredicateType)
+	assert.Equal(t, "SPDX-2.3", sboms[0].Document["spdxVersion"])
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/rancher/wrangler/pkg/schemes"
 	appsv1 "k8s.io/api/apps/v1"
 	authv1 "k8s.io/api/authorization/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	discoveryv1 "k8s.io/api/discovery/v1"
@@ -51,6 +52,7 @@ func AddToScheme(scheme *runtime.Scheme) error {
 	errs = append(errs, apiextensionv1.AddToScheme(scheme))
 	errs = append(errs, discoveryv1.AddToScheme(scheme))
 	errs = append(errs, schedulingv1.AddToScheme(scheme))
+	errs = append(errs, autoscalingv2.AddToScheme(scheme))
 	return merr.NewErrors(errs...)
 }
 
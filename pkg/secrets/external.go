@@ -0,0 +1,122 @@
+package secrets
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/secrets/providers"
+	"github.com/rancher/wrangler/pkg/data/convert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	defaultExternalRefresh = 5 * time.Minute
+	minExternalRefresh     = 10 * time.Second
+	// ExternalRetryInterval is how long to wait before reading from an external secret store again after a failure
+	ExternalRetryInterval = 30 * time.Second
+)
+
+func externalRefresh(secretRef v1.Secret) (time.Duration, error) {
+	refresh := convert.ToString(secretRef.Params["refresh"])
+	if refresh == "" {
+		return defaultExternalRefresh, nil
+	}
+	d, err := time.ParseDuration(refresh)
+	if err != nil {
+		return 0, fmt.Errorf("invalid external secret refresh [%s]: %w", refresh, err)
+	}
+	if d < minExternalRefresh {
+		return 0, fmt.Errorf("invalid external secret refresh [%s], must be at least %s", refresh, minExternalRefresh)
+	}
+	return d, nil
+}
+
+func lastSync(secret *corev1.Secret) (time.Time, bool) {
+	if secret == nil {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339, secret.Annotations[labels.AcornSecretLastSync])
+	return t, err == nil
+}
+
+// externalSyncDue returns how long until the data of a secret of type external should be read again. False is
+// returned if secret was not read from an external secret store.
+func externalSyncDue(secretRef v1.Secret, secret *corev1.Secret) (time.Duration, bool) {
+	last, ok := lastSync(secret)
+	if !ok {
+		return 0, false
+	}
+	refresh, err := externalRefresh(secretRef)
+	if err != nil {
+		return 0, false
+	}
+	return time.Until(last.Add(refresh)), true
+}
+
+// LastSyncTime returns when the data of a secret of type external was last read, or nil if it never was
+func LastSyncTime(secret *corev1.Secret) *metav1.Time {
+	last, ok := lastSync(secret)
+	if !ok {
+		return nil
+	}
+	return &metav1.Time{Time: last}
+}
+
+func generateExternal(req router.Request, appInstance *v1.AppInstance, secretName string, secretRef v1.Secret, existing *corev1.Secret) (*corev1.Secret, error) {
+	var (
+		providerName = convert.ToString(secretRef.Params["provider"])
+		path         = convert.ToString(secretRef.Params["path"])
+		version      = convert.ToString(secretRef.Params["version"])
+		source       = providerName + ":" + path
+	)
+
+	if path == "" {
+		return nil, fmt.Errorf("external secret [%s] is missing the path param", secretName)
+	}
+
+	refresh, err := externalRefresh(secretRef)
+	if err != nil {
+		return nil, err
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: secretName + "-",
+			Namespace:    appInstance.Namespace,
+			Labels:       labelsForSecret(secretName, appInstance, secretRef),
+			Annotations:  annotationsForSecret(secretName, appInstance, secretRef),
+		},
+		Type: v1.SecretTypeExternal,
+	}
+
+	// Reuse the data that was last read until it is due to be refreshed, unless the source has changed
+	if last, ok := lastSync(existing); ok && time.Since(last) < refresh &&
+		existing.Annotations[labels.AcornSecretSource] == source &&
+		(version == "" || existing.Annotations[labels.AcornSecretSourceVersion] == version) {
+		secret.Data = existing.Data
+		secret.Annotations[labels.AcornSecretSource] = source
+		secret.Annotations[labels.AcornSecretSourceVersion] = existing.Annotations[labels.AcornSecretSourceVersion]
+		secret.Annotations[labels.AcornSecretLastSync] = existing.Annotations[labels.AcornSecretLastSync]
+		return updateOrCreate(req, existing, secret)
+	}
+
+	provider, err := providers.Get(req.Ctx, req.Client, providerName, appInstance.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := provider.Read(req.Ctx, path, version)
+	if err != nil {
+		return nil, err
+	}
+
+	secret.Data = value.Data
+	secret.Annotations[labels.AcornSecretSource] = source
+	secret.Annotations[labels.AcornSecretSourceVersion] = value.Version
+	secret.Annotations[labels.AcornSecretLastSync] = time.Now().UTC().Format(time.RFC3339)
+	return updateOrCreate(req, existing, secret)
+}
@@ -0,0 +1,111 @@
+package providers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/acorn-io/baaah/pkg/typed"
+)
+
+type file struct {
+	root string
+}
+
+// NewFile returns a Provider that reads from files under root. A path to a file returns one key named after the
+// file and a path to a directory returns a key for each file in the directory, which matches how Kubernetes
+// mounts secrets. Hidden files are skipped. The version is a hash of the data, so specific versions cannot be read.
+func NewFile(root string) Provider {
+	return &file{
+		root: root,
+	}
+}
+
+func (f *file) Read(_ context.Context, path, version string) (*Value, error) {
+	if version != "" {
+		return nil, fmt.Errorf("the %s secret provider does not support reading a specific version", File)
+	}
+	if !filepath.IsLocal(path) {
+		return nil, fmt.Errorf("invalid path [%s], must be relative and within the external secrets directory", path)
+	}
+
+	fullPath, err := f.resolve(path)
+	if err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(fullPath)
+	if err != nil {
+		return nil, err
+	}
+
+	data := map[string][]byte{}
+	if info.IsDir() {
+		entries, err := os.ReadDir(fullPath)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			if strings.HasPrefix(entry.Name(), ".") {
+				continue
+			}
+			// Follow the symlinks that Kubernetes uses for mounted secrets, as long as they stay under the root
+			entryPath, err := f.resolve(filepath.Join(path, entry.Name()))
+			if err != nil {
+				return nil, err
+			}
+			info, err := os.Stat(entryPath)
+			if err != nil {
+				return nil, err
+			}
+			if !info.Mode().IsRegular() {
+				continue
+			}
+			data[entry.Name()], err = os.ReadFile(entryPath)
+			if err != nil {
+				return nil, err
+			}
+		}
+	} else {
+		data[filepath.Base(path)], err = os.ReadFile(fullPath)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &Value{
+		Data:    data,
+		Version: hashData(data),
+	}, nil
+}
+
+// resolve evaluates the symlinks in path, which is relative to the root, and returns the result if it is still under
+// the root, so that a symlink can't be used to read files outside of the external secrets directory.
+func (f *file) resolve(path string) (string, error) {
+	root, err := filepath.EvalSymlinks(f.root)
+	if err != nil {
+		return "", err
+	}
+	resolved, err := filepath.EvalSymlinks(filepath.Join(root, path))
+	if err != nil {
+		return "", err
+	}
+	if rel, err := filepath.Rel(root, resolved); err != nil || !filepath.IsLocal(rel) {
+		return "", fmt.Errorf("invalid path [%s], must be within the external secrets directory", path)
+	}
+	return resolved, nil
+}
+
+func hashData(data map[string][]byte) string {
+	h := sha256.New()
+	for _, entry := range typed.Sorted(data) {
+		h.Write([]byte(entry.Key))
+		h.Write([]byte{0})
+		h.Write(entry.Value)
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))[:12]
+}
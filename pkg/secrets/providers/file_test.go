@@ -0,0 +1,78 @@
+package providers
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileRead(t *testing.T) {
+	root := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "db", ".hidden"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "db", "username"), []byte("admin"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "db", "password"), []byte("secret"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "db", ".version"), []byte("1"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "token"), []byte("abc"), 0600))
+
+	f := NewFile(root)
+
+	value, err := f.Read(context.Background(), "db", "")
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{
+		"username": []byte("admin"),
+		"password": []byte("secret"),
+	}, value.Data)
+	assert.NotEmpty(t, value.Version)
+
+	again, err := f.Read(context.Background(), "db", "")
+	require.NoError(t, err)
+	assert.Equal(t, value.Version, again.Version)
+
+	require.NoError(t, os.WriteFile(filepath.Join(root, "db", "password"), []byte("rotated"), 0600))
+	rotated, err := f.Read(context.Background(), "db", "")
+	require.NoError(t, err)
+	assert.NotEqual(t, value.Version, rotated.Version)
+
+	value, err = f.Read(context.Background(), "token", "")
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"token": []byte("abc")}, value.Data)
+
+	_, err = f.Read(context.Background(), "../etc/passwd", "")
+	assert.ErrorContains(t, err, "within the external secrets directory")
+
+	_, err = f.Read(context.Background(), "db", "1")
+	assert.ErrorContains(t, err, "does not support reading a specific version")
+}
+
+func TestFileReadSymlinks(t *testing.T) {
+	root, outside := t.TempDir(), t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(outside, "passwd"), []byte("root"), 0600))
+
+	// Kubernetes mounts the keys of a secret as symlinks into a hidden directory
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "db", "..data"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "db", "..data", "password"), []byte("secret"), 0600))
+	require.NoError(t, os.Symlink(filepath.Join("..data", "password"), filepath.Join(root, "db", "password")))
+
+	require.NoError(t, os.Symlink(outside, filepath.Join(root, "escape")))
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "mixed"), 0700))
+	require.NoError(t, os.Symlink(filepath.Join(outside, "passwd"), filepath.Join(root, "mixed", "passwd")))
+
+	f := NewFile(root)
+
+	value, err := f.Read(context.Background(), "db", "")
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"password": []byte("secret")}, value.Data)
+
+	_, err = f.Read(context.Background(), "escape", "")
+	assert.ErrorContains(t, err, "within the external secrets directory")
+
+	_, err = f.Read(context.Background(), "escape/passwd", "")
+	assert.ErrorContains(t, err, "within the external secrets directory")
+
+	_, err = f.Read(context.Background(), "mixed", "")
+	assert.ErrorContains(t, err, "within the external secrets directory")
+}
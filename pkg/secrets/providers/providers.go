@@ -0,0 +1,68 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/runtime/pkg/config"
+	"github.com/acorn-io/runtime/pkg/system"
+	corev1 "k8s.io/api/core/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	Vault = "vault"
+	File  = "file"
+
+	// VaultTokenKey is the key in the vault token secret that holds the token used to read from vault
+	VaultTokenKey = "token"
+)
+
+var (
+	ErrUnknownProvider = errors.New("unknown secret provider")
+	ErrNotConfigured   = errors.New("secret provider is not configured")
+)
+
+// Value is the data read from an external secret store and the version of the data that was read
+type Value struct {
+	Data    map[string][]byte
+	Version string
+}
+
+// Provider reads secret data from a store outside of the cluster
+type Provider interface {
+	// Read returns the data at path. If version is empty the latest version is returned.
+	Read(ctx context.Context, path, version string) (*Value, error)
+}
+
+// Get returns the named provider configured from the acorn config for the project. The provider can only read the
+// secrets of the project: the file provider reads from the directory of the project in the external secrets directory
+// and the vault provider reads from the path of the project in each mount.
+func Get(ctx context.Context, c kclient.Reader, name, project string) (Provider, error) {
+	cfg, err := config.Get(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	switch name {
+	case Vault:
+		if *cfg.VaultAddress == "" {
+			return nil, fmt.Errorf("%w: %s requires the vault address to be set in the acorn config", ErrNotConfigured, name)
+		}
+		tokenSecret := &corev1.Secret{}
+		if err := c.Get(ctx, router.Key(system.Namespace, system.VaultTokenSecretName), tokenSecret); err != nil {
+			return nil, fmt.Errorf("reading vault token from %s/%s: %w", system.Namespace, system.VaultTokenSecretName, err)
+		}
+		return NewVault(*cfg.VaultAddress, string(tokenSecret.Data[VaultTokenKey]), project, nil), nil
+	case File:
+		if *cfg.ExternalSecretsDirectory == "" {
+			return nil, fmt.Errorf("%w: %s requires the external secrets directory to be set in the acorn config", ErrNotConfigured, name)
+		}
+		return NewFile(filepath.Join(*cfg.ExternalSecretsDirectory, project)), nil
+	default:
+		return nil, fmt.Errorf("%w [%s], must be %s or %s", ErrUnknownProvider, name, Vault, File)
+	}
+}
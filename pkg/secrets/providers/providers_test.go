@@ -0,0 +1,42 @@
+package providers
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/acorn-io/runtime/pkg/system"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestGetFileScopedToProject(t *testing.T) {
+	root := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "acorn", "db"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "acorn", "db", "password"), []byte("mine"), 0600))
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "other", "db"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "other", "db", "password"), []byte("theirs"), 0600))
+
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: system.ConfigName, Namespace: system.Namespace},
+		Data:       map[string]string{"config": `{"externalSecretsDirectory": "` + root + `"}`},
+	}).Build()
+
+	f, err := Get(context.Background(), c, File, "acorn")
+	require.NoError(t, err)
+
+	value, err := f.Read(context.Background(), "db", "")
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"password": []byte("mine")}, value.Data)
+
+	_, err = f.Read(context.Background(), "../other/db", "")
+	assert.ErrorContains(t, err, "within the external secrets directory")
+
+	_, err = f.Read(context.Background(), "other/db", "")
+	assert.True(t, os.IsNotExist(err))
+}
@@ -0,0 +1,131 @@
+package providers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// vaultTimeout bounds a request to Vault when no client is given, so that an unresponsive server doesn't block the
+// secrets controller.
+const vaultTimeout = 30 * time.Second
+
+type vault struct {
+	address string
+	token   string
+	prefix  string
+	client  *http.Client
+}
+
+// NewVault returns a Provider that reads from the KV version 2 secrets engine of a HashiCorp Vault server. Paths are
+// of the form <mount>/<path>, the same as the vault kv CLI, and read <prefix>/<path> in the mount, so only the secrets
+// under the prefix can be read. If client is nil, a client that times out after vaultTimeout is used.
+func NewVault(address, token, prefix string, client *http.Client) Provider {
+	if client == nil {
+		client = &http.Client{
+			Timeout: vaultTimeout,
+		}
+	}
+	return &vault{
+		address: strings.TrimSuffix(address, "/"),
+		token:   token,
+		prefix:  prefix,
+		client:  client,
+	}
+}
+
+type vaultResponse struct {
+	Data struct {
+		Data     map[string]any `json:"data"`
+		Metadata struct {
+			Version int `json:"version"`
+		} `json:"metadata"`
+	} `json:"data"`
+	Errors []string `json:"errors"`
+}
+
+func (v *vault) Read(ctx context.Context, path, version string) (*Value, error) {
+	mount, secretPath, ok := strings.Cut(strings.Trim(path, "/"), "/")
+	if !ok || mount == "" || secretPath == "" {
+		return nil, fmt.Errorf("invalid vault path [%s], must be of the form <mount>/<path>", path)
+	}
+	for _, part := range strings.Split(mount+"/"+secretPath, "/") {
+		if part == "" || part == "." || part == ".." {
+			return nil, fmt.Errorf("invalid vault path [%s], can not contain empty, . or .. elements", path)
+		}
+	}
+	if v.prefix != "" {
+		secretPath = v.prefix + "/" + secretPath
+	}
+
+	u := fmt.Sprintf("%s/v1/%s/data/%s", v.address, url.PathEscape(mount), escapePath(secretPath))
+	if version != "" {
+		if _, err := strconv.Atoi(version); err != nil {
+			return nil, fmt.Errorf("invalid vault secret version [%s], must be a number", version)
+		}
+		u += "?version=" + url.QueryEscape(version)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Vault-Token", v.token)
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s from vault: %w", path, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s from vault: %w", path, err)
+	}
+
+	var data vaultResponse
+	if err := json.Unmarshal(body, &data); err != nil && resp.StatusCode == http.StatusOK {
+		return nil, fmt.Errorf("parsing %s from vault: %w", path, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		if len(data.Errors) > 0 {
+			return nil, fmt.Errorf("reading %s from vault: %s: %s", path, resp.Status, strings.Join(data.Errors, ", "))
+		}
+		return nil, fmt.Errorf("reading %s from vault: %s", path, resp.Status)
+	}
+	if data.Data.Data == nil {
+		return nil, fmt.Errorf("reading %s from vault: secret has no data, it may have been deleted", path)
+	}
+
+	result := &Value{
+		Data:    make(map[string][]byte, len(data.Data.Data)),
+		Version: strconv.Itoa(data.Data.Metadata.Version),
+	}
+	for k, v := range data.Data.Data {
+		if s, ok := v.(string); ok {
+			result.Data[k] = []byte(s)
+			continue
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		result.Data[k] = b
+	}
+
+	return result, nil
+}
+
+func escapePath(path string) string {
+	parts := strings.Split(path, "/")
+	for i, part := range parts {
+		parts[i] = url.PathEscape(part)
+	}
+	return strings.Join(parts, "/")
+}
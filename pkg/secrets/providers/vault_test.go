@@ -0,0 +1,77 @@
+package providers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVaultRead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "test-token" {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors":["permission denied"]}`))
+			return
+		}
+		switch r.URL.Path {
+		case "/v1/secret/data/acorn/app/db":
+			if r.URL.Query().Get("version") == "1" {
+				_, _ = w.Write([]byte(`{"data":{"data":{"password":"old"},"metadata":{"version":1}}}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"data":{"data":{"password":"new","port":5432},"metadata":{"version":2}}}`))
+		case "/v1/secret/data/other/app/db":
+			_, _ = w.Write([]byte(`{"data":{"data":{"password":"other"},"metadata":{"version":1}}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors":[]}`))
+		}
+	}))
+	defer server.Close()
+
+	v := NewVault(server.URL+"/", "test-token", "acorn", server.Client())
+
+	value, err := v.Read(context.Background(), "secret/app/db", "")
+	require.NoError(t, err)
+	assert.Equal(t, "2", value.Version)
+	assert.Equal(t, map[string][]byte{
+		"password": []byte("new"),
+		"port":     []byte("5432"),
+	}, value.Data)
+
+	value, err = v.Read(context.Background(), "secret/app/db", "1")
+	require.NoError(t, err)
+	assert.Equal(t, "1", value.Version)
+	assert.Equal(t, map[string][]byte{"password": []byte("old")}, value.Data)
+
+	_, err = v.Read(context.Background(), "secret/app/missing", "")
+	assert.ErrorContains(t, err, "404")
+
+	// The secrets of other projects can't be read
+	_, err = v.Read(context.Background(), "secret/other/app/db", "")
+	assert.ErrorContains(t, err, "404")
+
+	_, err = v.Read(context.Background(), "secret/../other/app/db", "")
+	assert.ErrorContains(t, err, "can not contain empty, . or .. elements")
+
+	_, err = v.Read(context.Background(), "secret/app/../../other/app/db", "")
+	assert.ErrorContains(t, err, "can not contain empty, . or .. elements")
+
+	_, err = v.Read(context.Background(), "secret", "")
+	assert.ErrorContains(t, err, "must be of the form <mount>/<path>")
+
+	_, err = v.Read(context.Background(), "secret/app/db", "latest")
+	assert.ErrorContains(t, err, "must be a number")
+
+	_, err = NewVault(server.URL, "wrong", "acorn", server.Client()).Read(context.Background(), "secret/app/db", "")
+	assert.ErrorContains(t, err, "permission denied")
+}
+
+func TestVaultDefaultClientTimeout(t *testing.T) {
+	v := NewVault("https://vault.example.com", "test-token", "acorn", nil).(*vault)
+	assert.Equal(t, vaultTimeout, v.client.Timeout)
+}
@@ -0,0 +1,180 @@
+package secrets
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/robfig/cron/v3"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// PreviousKeyPrefix is prepended to the keys that hold the values from before a rotation during the grace period
+	PreviousKeyPrefix = "previous-"
+
+	minRotationInterval = time.Minute
+)
+
+// ValidateRotation checks that the rotation policy of a secret can be used
+func ValidateRotation(secretRef v1.Secret) error {
+	if secretRef.Rotate == nil {
+		return nil
+	}
+	if secretRef.Type != "token" && secretRef.Type != "basic" {
+		return fmt.Errorf("rotate is only supported for secrets of type token or basic, not [%s]", secretRef.Type)
+	}
+	if _, err := nextRotation(secretRef.Rotate, time.Now()); err != nil {
+		return err
+	}
+	_, err := rotationGracePeriod(secretRef.Rotate)
+	return err
+}
+
+func nextRotation(rotate *v1.SecretRotation, last time.Time) (time.Time, error) {
+	switch {
+	case rotate.Interval != "" && rotate.Schedule != "":
+		return time.Time{}, fmt.Errorf("only one of rotate interval or schedule can be set")
+	case rotate.Interval != "":
+		d, err := time.ParseDuration(rotate.Interval)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid rotate interval [%s]: %w", rotate.Interval, err)
+		}
+		if d < minRotationInterval {
+			return time.Time{}, fmt.Errorf("invalid rotate interval [%s], must be at least %s", rotate.Interval, minRotationInterval)
+		}
+		return last.Add(d), nil
+	case rotate.Schedule != "":
+		schedule, err := cron.ParseStandard(rotate.Schedule)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid rotate schedule [%s]: %w", rotate.Schedule, err)
+		}
+		return schedule.Next(last), nil
+	default:
+		return time.Time{}, fmt.Errorf("rotate requires an interval or a schedule")
+	}
+}
+
+func rotationGracePeriod(rotate *v1.SecretRotation) (time.Duration, error) {
+	if rotate.GracePeriod == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(rotate.GracePeriod)
+	if err != nil {
+		return 0, fmt.Errorf("invalid rotate grace period [%s]: %w", rotate.GracePeriod, err)
+	}
+	if d < 0 {
+		return 0, fmt.Errorf("invalid rotate grace period [%s], must not be negative", rotate.GracePeriod)
+	}
+	return d, nil
+}
+
+func lastRotation(secret *corev1.Secret) (time.Time, bool) {
+	if secret == nil {
+		return time.Time{}, false
+	}
+	if t, err := time.Parse(time.RFC3339, secret.Annotations[labels.AcornSecretLastRotation]); err == nil {
+		return t, true
+	}
+	return secret.CreationTimestamp.Time, !secret.CreationTimestamp.IsZero()
+}
+
+// LastRotationTime returns when the values of a secret with a rotation policy were last generated, or nil if they
+// have not been
+func LastRotationTime(secret *corev1.Secret) *metav1.Time {
+	if secret == nil || secret.Annotations[labels.AcornSecretLastRotation] == "" {
+		return nil
+	}
+	last, ok := lastRotation(secret)
+	if !ok {
+		return nil
+	}
+	return &metav1.Time{Time: last}
+}
+
+// rotationDue returns how long until the values of a secret with a rotation policy should be generated again, or
+// until the previous values should be removed, whichever is first. False is returned if nothing is scheduled.
+func rotationDue(secretRef v1.Secret, secret *corev1.Secret) (time.Duration, bool) {
+	if secretRef.Rotate == nil {
+		return 0, false
+	}
+	last, ok := lastRotation(secret)
+	if !ok {
+		return 0, false
+	}
+	next, err := nextRotation(secretRef.Rotate, last)
+	if err != nil {
+		return 0, false
+	}
+	due := time.Until(next)
+	if grace, err := rotationGracePeriod(secretRef.Rotate); err == nil && hasPreviousKeys(secret) {
+		if expire := time.Until(last.Add(grace)); expire < due {
+			due = expire
+		}
+	}
+	return due, true
+}
+
+func hasPreviousKeys(secret *corev1.Secret) bool {
+	for key := range secret.Data {
+		if strings.HasPrefix(key, PreviousKeyPrefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// rotate clears the values of keys in secret that are generated, rather than set in the Acornfile, when the rotation
+// policy of secretRef is due so that they are generated again. The previous values are kept under keys prefixed with
+// PreviousKeyPrefix until the grace period has passed.
+func rotate(secretRef v1.Secret, existing, secret *corev1.Secret, now time.Time, keys ...string) error {
+	if secretRef.Rotate == nil {
+		return nil
+	}
+	if err := ValidateRotation(secretRef); err != nil {
+		return err
+	}
+
+	last, ok := lastRotation(existing)
+	if !ok {
+		secret.Annotations[labels.AcornSecretLastRotation] = now.UTC().Format(time.RFC3339)
+		return nil
+	}
+
+	grace, err := rotationGracePeriod(secretRef.Rotate)
+	if err != nil {
+		return err
+	}
+	if now.Before(last.Add(grace)) {
+		for _, key := range keys {
+			if v, ok := existing.Data[PreviousKeyPrefix+key]; ok {
+				secret.Data[PreviousKeyPrefix+key] = v
+			}
+		}
+	}
+
+	next, err := nextRotation(secretRef.Rotate, last)
+	if err != nil {
+		return err
+	}
+	if now.Before(next) {
+		secret.Annotations[labels.AcornSecretLastRotation] = last.UTC().Format(time.RFC3339)
+		return nil
+	}
+
+	for _, key := range keys {
+		if secretRef.Data[key] != "" {
+			// Values set in the Acornfile are not generated so they are never rotated
+			continue
+		}
+		if grace > 0 && len(secret.Data[key]) > 0 {
+			secret.Data[PreviousKeyPrefix+key] = secret.Data[key]
+		}
+		delete(secret.Data, key)
+	}
+	secret.Annotations[labels.AcornSecretLastRotation] = now.UTC().Format(time.RFC3339)
+	return nil
+}
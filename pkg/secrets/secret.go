@@ -8,6 +8,7 @@ import (
 	"regexp"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/acorn-io/baaah/pkg/router"
 	"github.com/acorn-io/baaah/pkg/typed"
@@ -207,6 +208,10 @@ func generateToken(req router.Request, appInstance *v1.AppInstance, secretName s
 		Type: v1.SecretTypeToken,
 	}
 
+	if err := rotate(secretRef, existing, secret, time.Now(), "token"); err != nil {
+		return nil, err
+	}
+
 	if len(secret.Data["token"]) == 0 {
 		length, err := convert.ToNumber(secretRef.Params["length"])
 		if err != nil {
@@ -250,6 +255,10 @@ func generateBasic(req router.Request, appInstance *v1.AppInstance, secretName s
 		Type: v1.SecretTypeBasic,
 	}
 
+	if err := rotate(secretRef, existing, secret, time.Now(), corev1.BasicAuthUsernameKey, corev1.BasicAuthPasswordKey); err != nil {
+		return nil, err
+	}
+
 	for i, key := range []string{corev1.BasicAuthUsernameKey, corev1.BasicAuthPasswordKey} {
 		if len(secret.Data[key]) == 0 {
 			// TODO: Improve with more characters (special, upper/lowercase, etc)
@@ -367,11 +376,29 @@ func generateSecret(secrets map[string]*corev1.Secret, req router.Request, appIn
 		return generateToken(req, appInstance, secretName, secretRef, existing)
 	case "template":
 		return generateTemplate(secrets, req, appInstance, secretName, secretRef, existing)
+	case "external":
+		return generateExternal(req, appInstance, secretName, secretRef, existing)
+	case "tls":
+		return generateTLS(secrets, req, appInstance, secretName, secretRef, existing)
 	default:
 		return nil, err
 	}
 }
 
+// NextRefresh returns how long until the data of a secret should be generated or read again. False is returned if
+// the data of the secret is never refreshed.
+func NextRefresh(secretRef v1.Secret, secret *corev1.Secret) (time.Duration, bool) {
+	switch {
+	case secretRef.Type == "external":
+		return externalSyncDue(secretRef, secret)
+	case secretRef.Type == "tls":
+		return tlsRenewalDue(secretRef, secret)
+	case secretRef.Rotate != nil:
+		return rotationDue(secretRef, secret)
+	}
+	return 0, false
+}
+
 func GetOrCreateSecret(secrets map[string]*corev1.Secret, req router.Request, appInstance *v1.AppInstance, secretName string) (*corev1.Secret, error) {
 	if sec, ok := secrets[secretName]; ok {
 		return sec, nil
@@ -0,0 +1,429 @@
+package secrets
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"sort"
+	"time"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/baaah/pkg/typed"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/config"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/rancher/wrangler/pkg/data/convert"
+	name2 "github.com/rancher/wrangler/pkg/name"
+	"golang.org/x/exp/slices"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	CACertKey = "ca.crt"
+	CAKeyKey  = "ca.key"
+
+	defaultCertDuration    = 90 * 24 * time.Hour
+	defaultCertRenewBefore = 7 * 24 * time.Hour
+	defaultCACertDuration  = 10 * 365 * 24 * time.Hour
+	minCertDuration        = time.Hour
+)
+
+type tlsParams struct {
+	ca          string
+	sans        []string
+	duration    time.Duration
+	renewBefore time.Duration
+}
+
+func getTLSParams(secretRef v1.Secret) (result tlsParams, err error) {
+	result.ca = convert.ToString(secretRef.Params["ca"])
+	result.sans = convert.ToStringSlice(secretRef.Params["sans"])
+	result.duration = defaultCertDuration
+	result.renewBefore = defaultCertRenewBefore
+
+	if v := convert.ToString(secretRef.Params["duration"]); v != "" {
+		if result.duration, err = time.ParseDuration(v); err != nil {
+			return result, fmt.Errorf("invalid tls secret duration [%s]: %w", v, err)
+		}
+		if result.duration < minCertDuration {
+			return result, fmt.Errorf("invalid tls secret duration [%s], must be at least %s", v, minCertDuration)
+		}
+	}
+	if v := convert.ToString(secretRef.Params["renewBefore"]); v != "" {
+		if result.renewBefore, err = time.ParseDuration(v); err != nil {
+			return result, fmt.Errorf("invalid tls secret renewBefore [%s]: %w", v, err)
+		}
+	}
+	if result.renewBefore >= result.duration {
+		// Renew when two thirds of the duration has passed if renewBefore is longer than the certificate is valid
+		result.renewBefore = result.duration / 3
+	}
+	return result, nil
+}
+
+// defaultSANs returns the internal DNS names of the services named by the service param of the secret, or of all the
+// containers in the app if the param is not set.
+func defaultSANs(req router.Request, appInstance *v1.AppInstance, secretRef v1.Secret) ([]string, error) {
+	services := convert.ToStringSlice(secretRef.Params["service"])
+	if len(services) == 0 {
+		services = typed.SortedKeys(appInstance.Status.AppSpec.Containers)
+	}
+	if len(services) == 0 {
+		return nil, fmt.Errorf("tls secret requires the sans or service param when the app has no containers")
+	}
+
+	cfg, err := config.Get(req.Ctx, req.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []string
+	for _, service := range services {
+		result = append(result,
+			service,
+			fmt.Sprintf("%s.%s", service, appInstance.Status.Namespace),
+			fmt.Sprintf("%s.%s.svc", service, appInstance.Status.Namespace),
+			fmt.Sprintf("%s.%s.%s", service, appInstance.Status.Namespace, cfg.InternalClusterDomain))
+	}
+	return result, nil
+}
+
+func parseCert(data []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("failed to parse certificate PEM")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+func parseKey(data []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("failed to parse private key PEM")
+	}
+	if key, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		if signer, ok := key.(crypto.Signer); ok {
+			return signer, nil
+		}
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+	if key, err := x509.ParseECPrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	return x509.ParsePKCS1PrivateKey(block.Bytes)
+}
+
+func encodeKey(key crypto.Signer) ([]byte, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil
+}
+
+func encodeCert(der []byte) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func serialNumber() (*big.Int, error) {
+	return rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+}
+
+// newCA returns a PEM encoded self-signed CA certificate and key
+func newCA(commonName string) ([]byte, []byte, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	serial, err := serialNumber()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: commonName + "-ca"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(defaultCACertDuration),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		return nil, nil, err
+	}
+	keyPEM, err := encodeKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return encodeCert(der), keyPEM, nil
+}
+
+// newLeafCert returns a PEM encoded certificate and key for sans signed by the CA
+func newLeafCert(caCertPEM, caKeyPEM []byte, sans []string, duration time.Duration) ([]byte, []byte, error) {
+	caCert, err := parseCert(caCertPEM)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parsing CA certificate: %w", err)
+	}
+	caKey, err := parseKey(caKeyPEM)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parsing CA key: %w", err)
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	serial, err := serialNumber()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: sans[0]},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.Add(duration),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	for _, san := range sans {
+		if ip := net.ParseIP(san); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else {
+			template.DNSNames = append(template.DNSNames, san)
+		}
+	}
+	if template.NotAfter.After(caCert.NotAfter) {
+		template.NotAfter = caCert.NotAfter
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, caCert, key.Public(), caKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	keyPEM, err := encodeKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return encodeCert(der), keyPEM, nil
+}
+
+func certSANs(cert *x509.Certificate) []string {
+	result := append([]string{}, cert.DNSNames...)
+	for _, ip := range cert.IPAddresses {
+		result = append(result, ip.String())
+	}
+	sort.Strings(result)
+	return result
+}
+
+// mustRenewCert returns true if the leaf certificate must be issued again, either because it is unreadable, it is
+// about to expire, the SANs changed or it was not signed by the CA
+func mustRenewCert(data map[string][]byte, caCertPEM []byte, sans []string, renewBefore time.Duration) bool {
+	cert, err := parseCert(data[corev1.TLSCertKey])
+	if err != nil || len(data[corev1.TLSPrivateKeyKey]) == 0 {
+		return true
+	}
+	if time.Until(cert.NotAfter) < renewBefore {
+		return true
+	}
+
+	wanted := append([]string{}, sans...)
+	sort.Strings(wanted)
+	if !slices.Equal(wanted, certSANs(cert)) {
+		return true
+	}
+
+	caCert, err := parseCert(caCertPEM)
+	if err != nil {
+		return true
+	}
+	return cert.CheckSignatureFrom(caCert) != nil
+}
+
+// tlsRenewalDue returns how long until the leaf certificate of a tls secret should be renewed
+func tlsRenewalDue(secretRef v1.Secret, secret *corev1.Secret) (time.Duration, bool) {
+	params, err := getTLSParams(secretRef)
+	if err != nil {
+		return 0, false
+	}
+	cert, err := parseCert(secret.Data[corev1.TLSCertKey])
+	if err != nil {
+		return 0, false
+	}
+	return time.Until(cert.NotAfter.Add(-params.renewBefore)), true
+}
+
+// caKeySecretName is the name of the secret that holds the CA key of a generated tls secret. The key is kept out of
+// the tls secret so that it is neither copied to the containers that use the secret nor revealed with it.
+func caKeySecretName(secretName string) string {
+	return name2.SafeConcatName(secretName, "ca-key")
+}
+
+// getCAKey returns the CA key of the generated tls secret, or nil if it has none
+func getCAKey(req router.Request, secret *corev1.Secret) ([]byte, error) {
+	if secret == nil || secret.Type != v1.SecretTypeTLS {
+		return nil, nil
+	}
+	caKey := &corev1.Secret{}
+	if err := req.Get(caKey, secret.Namespace, caKeySecretName(secret.Name)); apierrors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return caKey.Data[CAKeyKey], nil
+}
+
+// saveCAKey stores the CA key of the generated tls secret, the key is deleted with the secret
+func saveCAKey(req router.Request, secret *corev1.Secret, key []byte) error {
+	caKey := &corev1.Secret{}
+	if err := req.Get(caKey, secret.Namespace, caKeySecretName(secret.Name)); apierrors.IsNotFound(err) {
+		return req.Client.Create(req.Ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      caKeySecretName(secret.Name),
+				Namespace: secret.Namespace,
+				Labels: map[string]string{
+					labels.AcornManaged: "true",
+				},
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion: "v1",
+						Kind:       "Secret",
+						Name:       secret.Name,
+						UID:        secret.UID,
+					},
+				},
+			},
+			Data: map[string][]byte{
+				CAKeyKey: key,
+			},
+			Type: corev1.SecretTypeOpaque,
+		})
+	} else if err != nil {
+		return err
+	}
+
+	if bytes.Equal(caKey.Data[CAKeyKey], key) {
+		return nil
+	}
+	caKey.Data = map[string][]byte{
+		CAKeyKey: key,
+	}
+	return req.Client.Update(req.Ctx, caKey)
+}
+
+// getCA returns the CA certificate and key of the secret named by the ca param. A secret of type tls, or any secret
+// with ca.crt and ca.key keys, can be used. Otherwise the tls.crt and tls.key keys must be a CA certificate and key.
+func getCA(secrets map[string]*corev1.Secret, req router.Request, appInstance *v1.AppInstance, caName string) ([]byte, []byte, error) {
+	caSecret, err := GetOrCreateSecret(secrets, req, appInstance, caName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(caSecret.Data[CACertKey]) > 0 && len(caSecret.Data[CAKeyKey]) > 0 {
+		return caSecret.Data[CACertKey], caSecret.Data[CAKeyKey], nil
+	}
+	if len(caSecret.Data[CACertKey]) > 0 {
+		if caKey, err := getCAKey(req, caSecret); err != nil {
+			return nil, nil, err
+		} else if len(caKey) > 0 {
+			return caSecret.Data[CACertKey], caKey, nil
+		}
+	}
+
+	caCert, err := parseCert(caSecret.Data[corev1.TLSCertKey])
+	if err != nil {
+		return nil, nil, fmt.Errorf("secret [%s] used as the CA of a tls secret must have %s and %s keys or a CA certificate in %s: %w",
+			caName, CACertKey, CAKeyKey, corev1.TLSCertKey, err)
+	}
+	if !caCert.IsCA {
+		return nil, nil, fmt.Errorf("certificate in secret [%s] used as the CA of a tls secret is not a CA", caName)
+	}
+	return caSecret.Data[corev1.TLSCertKey], caSecret.Data[corev1.TLSPrivateKeyKey], nil
+}
+
+func generateTLS(secrets map[string]*corev1.Secret, req router.Request, appInstance *v1.AppInstance, secretName string, secretRef v1.Secret, existing *corev1.Secret) (*corev1.Secret, error) {
+	params, err := getTLSParams(secretRef)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.ca == secretName {
+		return nil, fmt.Errorf("tls secret [%s] can not use itself as the CA", secretName)
+	}
+
+	sans := params.sans
+	if len(sans) == 0 {
+		sans, err = defaultSANs(req, appInstance, secretRef)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: secretName + "-",
+			Namespace:    appInstance.Namespace,
+			Labels:       labelsForSecret(secretName, appInstance, secretRef),
+			Annotations:  annotationsForSecret(secretName, appInstance, secretRef),
+		},
+		Data: seedData(existing, nil, CACertKey, corev1.TLSCertKey, corev1.TLSPrivateKeyKey),
+		Type: v1.SecretTypeTLS,
+	}
+
+	if params.ca != "" {
+		caCert, caKey, err := getCA(secrets, req, appInstance, params.ca)
+		if err != nil {
+			return nil, err
+		}
+		// The key of a referenced CA is not copied so that only the secret that owns the CA can issue certificates
+		secret.Data[CACertKey] = caCert
+		if mustRenewCert(secret.Data, caCert, sans, params.renewBefore) {
+			secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey], err = newLeafCert(caCert, caKey, sans, params.duration)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return updateOrCreate(req, existing, secret)
+	}
+
+	caKey, err := getCAKey(req, existing)
+	if err != nil {
+		return nil, err
+	}
+	if caCert, err := parseCert(secret.Data[CACertKey]); err != nil || len(caKey) == 0 ||
+		time.Until(caCert.NotAfter) < params.renewBefore {
+		secret.Data[CACertKey], caKey, err = newCA("acorn-" + secretName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if mustRenewCert(secret.Data, secret.Data[CACertKey], sans, params.renewBefore) {
+		secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey], err = newLeafCert(secret.Data[CACertKey], caKey, sans, params.duration)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result, err := updateOrCreate(req, existing, secret)
+	if err != nil {
+		return nil, err
+	}
+	return result, saveCAKey(req, result, caKey)
+}
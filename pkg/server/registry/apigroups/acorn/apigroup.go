@@ -15,14 +15,18 @@ import (
 	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/credentials"
 	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/devsessions"
 	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/events"
+	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/eventsubscriptions"
 	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/imageallowrules"
 	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/images"
 	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/info"
+	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/jobs"
 	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/projects"
 	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/regions"
 	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/secrets"
 	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/volumes"
+	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/volumes/backups"
 	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/volumes/class"
+	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/volumes/snapshots"
 	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/admin/computeclass"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -91,9 +95,12 @@ func Stores(c kclient.WithWatch, cfg, localCfg *clientgo.Config) (map[string]res
 		"projects":                      projects.NewStorage(c),
 		"volumes":                       volumesStorage,
 		"volumeclasses":                 class.NewClassStorage(c),
+		"volumesnapshots":               snapshots.NewStorage(c),
+		"volumebackups":                 backups.NewStorage(c),
 		"containerreplicas":             containersStorage,
 		"containerreplicas/exec":        containerExec,
 		"containerreplicas/portforward": portForward,
+		"containerreplicausages":        containers.NewUsageStorage(c),
 		"credentials":                   credentials.NewStore(c),
 		"secrets":                       secrets.NewStorage(c),
 		"secrets/reveal":                secrets.NewReveal(c),
@@ -102,6 +109,8 @@ func Stores(c kclient.WithWatch, cfg, localCfg *clientgo.Config) (map[string]res
 		"regions":                       regions.NewStorage(c),
 		"imageallowrules":               imageallowrules.NewStorage(c),
 		"events":                        events.NewStorage(c),
+		"eventsubscriptions":            eventsubscriptions.NewStorage(c),
+		"jobexecutions":                 jobs.NewStorage(c),
 	}
 
 	return stores, nil
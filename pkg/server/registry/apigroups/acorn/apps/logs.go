@@ -3,7 +3,10 @@ package apps
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"regexp"
+	"time"
 
 	"github.com/acorn-io/mink/pkg/strategy"
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
@@ -13,6 +16,7 @@ import (
 	"github.com/acorn-io/runtime/pkg/log"
 	"github.com/gorilla/websocket"
 	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apiserver/pkg/endpoints/request"
@@ -61,8 +65,25 @@ func (i *Logs) Connect(ctx context.Context, id string, options runtime.Object, r
 
 	var (
 		opts = options.(*apiv1.LogOptions)
+		now  = time.Now()
+		grep *regexp.Regexp
 	)
 
+	since, err := log.ParseTime(opts.Since, now)
+	if err != nil {
+		return nil, apierrors.NewBadRequest(err.Error())
+	}
+	until, err := log.ParseTime(opts.Until, now)
+	if err != nil {
+		return nil, apierrors.NewBadRequest(err.Error())
+	}
+	if opts.Grep != "" {
+		grep, err = regexp.Compile(opts.Grep)
+		if err != nil {
+			return nil, apierrors.NewBadRequest(fmt.Sprintf("invalid grep expression: %v", err))
+		}
+	}
+
 	output := make(chan log.Message)
 	go func() {
 		defer close(output)
@@ -73,6 +94,9 @@ func (i *Logs) Connect(ctx context.Context, id string, options runtime.Object, r
 			Follow:           opts.Follow,
 			ContainerReplica: opts.ContainerReplica,
 			Container:        opts.Container,
+			Since:            since,
+			Until:            until,
+			Grep:             grep,
 		})
 		if err != nil {
 			output <- log.Message{
@@ -101,6 +125,7 @@ func (i *Logs) Connect(ctx context.Context, id string, options runtime.Object, r
 			if message.Pod != nil {
 				lm.AppName = message.Pod.Labels[labels.AcornAppName]
 				lm.ContainerName = message.Pod.Name
+				lm.ReplicaName = log.ReplicaName(message.Pod, message.ContainerName)
 				if message.ContainerName != message.Pod.Labels[labels.AcornContainerName] {
 					lm.ContainerName += "." + message.ContainerName
 				}
@@ -19,7 +19,9 @@ func NewStorage(c kclient.WithWatch, clientFactory *client.Factory, recorder eve
 	remoteResource := remote.NewRemote(&v1.AppInstance{}, c)
 	strategy := translation.NewSimpleTranslationStrategy(&Translator{}, remoteResource)
 	strategy = publicname.NewStrategy(strategy)
+	strategy = newUpdatedByStrategy(strategy)
 	strategy = newEventRecordingStrategy(strategy, recorder)
+	strategy = newTracingStrategy(strategy)
 	strategy = middleware.ForCompleteStrategy(strategy, middlewares...)
 
 	validator := NewValidator(c, clientFactory, strategy)
@@ -0,0 +1,58 @@
+package apps
+
+import (
+	"context"
+
+	"github.com/acorn-io/mink/pkg/strategy"
+	"github.com/acorn-io/mink/pkg/types"
+	"github.com/acorn-io/runtime/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// tracingStrategy records a span for every change to an app and stores the trace context on the app, so the
+// controller can add the work of deploying the change to the same trace.
+type tracingStrategy struct {
+	strategy.CompleteStrategy
+}
+
+func newTracingStrategy(s strategy.CompleteStrategy) *tracingStrategy {
+	return &tracingStrategy{
+		CompleteStrategy: s,
+	}
+}
+
+func (s *tracingStrategy) Create(ctx context.Context, obj types.Object) (_ types.Object, err error) {
+	ctx, span := tracing.Start(ctx, "create app", appAttributes(obj)...)
+	defer func() {
+		tracing.End(span, err)
+	}()
+
+	tracing.SetTraceParent(ctx, obj)
+	return s.CompleteStrategy.Create(ctx, obj)
+}
+
+func (s *tracingStrategy) Update(ctx context.Context, obj types.Object) (_ types.Object, err error) {
+	ctx, span := tracing.Start(ctx, "update app", appAttributes(obj)...)
+	defer func() {
+		tracing.End(span, err)
+	}()
+
+	tracing.SetTraceParent(ctx, obj)
+	return s.CompleteStrategy.Update(ctx, obj)
+}
+
+func (s *tracingStrategy) Delete(ctx context.Context, obj types.Object) (_ types.Object, err error) {
+	ctx, span := tracing.Start(ctx, "delete app", appAttributes(obj)...)
+	defer func() {
+		tracing.End(span, err)
+	}()
+
+	return s.CompleteStrategy.Delete(ctx, obj)
+}
+
+func appAttributes(obj types.Object) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("k8s.namespace.name", obj.GetNamespace()),
+		attribute.String("acorn.name", obj.GetName()),
+	}
+}
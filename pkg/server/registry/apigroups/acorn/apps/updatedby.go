@@ -0,0 +1,55 @@
+package apps
+
+import (
+	"context"
+
+	"github.com/acorn-io/mink/pkg/strategy"
+	"github.com/acorn-io/mink/pkg/types"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apiserver/pkg/endpoints/request"
+)
+
+// updatedByStrategy records the user that last changed the spec of an app so that the controller can attribute
+// the resulting revision to them.
+type updatedByStrategy struct {
+	strategy.CompleteStrategy
+}
+
+func newUpdatedByStrategy(s strategy.CompleteStrategy) *updatedByStrategy {
+	return &updatedByStrategy{
+		CompleteStrategy: s,
+	}
+}
+
+func (s *updatedByStrategy) Create(ctx context.Context, obj types.Object) (types.Object, error) {
+	setUpdatedBy(ctx, obj)
+	return s.CompleteStrategy.Create(ctx, obj)
+}
+
+func (s *updatedByStrategy) Update(ctx context.Context, obj types.Object) (types.Object, error) {
+	old, err := s.Get(ctx, obj.GetNamespace(), obj.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	if !equality.Semantic.DeepEqual(old.(*apiv1.App).Spec, obj.(*apiv1.App).Spec) {
+		setUpdatedBy(ctx, obj)
+	}
+	return s.CompleteStrategy.Update(ctx, obj)
+}
+
+func setUpdatedBy(ctx context.Context, obj types.Object) {
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return
+	}
+
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[labels.AcornUpdatedBy] = user.GetName()
+	obj.SetAnnotations(annotations)
+}
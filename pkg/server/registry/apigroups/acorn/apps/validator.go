@@ -23,6 +23,7 @@ import (
 	"github.com/acorn-io/runtime/pkg/labels"
 	"github.com/acorn-io/runtime/pkg/pullsecret"
 	"github.com/acorn-io/runtime/pkg/tags"
+	"github.com/acorn-io/runtime/pkg/tracing"
 	"github.com/acorn-io/runtime/pkg/volume"
 	"github.com/google/go-containerregistry/pkg/name"
 	"github.com/google/go-containerregistry/pkg/v1/remote"
@@ -30,6 +31,7 @@ import (
 	authv1 "k8s.io/api/authorization/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -73,11 +75,22 @@ func (s *Validator) Get(ctx context.Context, namespace, name string) (types.Obje
 func (s *Validator) Validate(ctx context.Context, obj runtime.Object) (result field.ErrorList) {
 	params := obj.(*apiv1.App)
 
+	// Validation resolves and inspects the image of the app, which can be slow for remote images
+	ctx, span := tracing.Start(ctx, "validate app", appAttributes(params)...)
+	defer func() {
+		tracing.End(span, result.ToAggregate())
+	}()
+
 	if err := s.validateName(params); err != nil {
 		result = append(result, field.Invalid(field.NewPath("metadata", "name"), params.Name, err.Error()))
 		return
 	}
 
+	if err := params.Spec.Rollout.Validate(); err != nil {
+		result = append(result, field.Invalid(field.NewPath("spec", "rollout"), params.Spec.Rollout, err.Error()))
+		return
+	}
+
 	project := new(apiv1.Project)
 	if err := s.client.Get(ctx, kclient.ObjectKey{Name: params.Namespace}, project); err != nil {
 		result = append(result, field.Invalid(field.NewPath("spec", "images"), params.Spec.Image, err.Error()))
@@ -205,9 +218,53 @@ func (s *Validator) ValidateUpdate(ctx context.Context, obj, old runtime.Object)
 		return result
 	}
 
+	if result = validateVolumeResize(newParams, oldParams); len(result) > 0 {
+		return result
+	}
+
 	return s.Validate(ctx, newParams)
 }
 
+// validateVolumeResize rejects volume sizes that are smaller than the size that was requested for the volume before,
+// volumes can only be expanded. The capacity of the volume isn't used, because it can be rounded up by the storage.
+func validateVolumeResize(newParams, oldParams *apiv1.App) (result field.ErrorList) {
+	for i, binding := range newParams.Spec.Volumes {
+		if binding.Size == "" {
+			continue
+		}
+
+		current := oldParams.Status.AppStatus.Volumes[binding.Target].RequestedSize
+		if current == "" {
+			for _, oldBinding := range oldParams.Spec.Volumes {
+				if oldBinding.Target == binding.Target {
+					current = oldBinding.Size
+				}
+			}
+		}
+		if current == "" {
+			current = oldParams.Status.AppSpec.Volumes[binding.Target].Size
+		}
+		if current == "" {
+			continue
+		}
+
+		// invalid sizes are reported by the validation of the volumes
+		requested, err := resource.ParseQuantity(string(binding.Size))
+		if err != nil {
+			continue
+		}
+		currentSize, err := resource.ParseQuantity(string(current))
+		if err != nil {
+			continue
+		}
+		if requested.Cmp(currentSize) < 0 {
+			result = append(result, field.Invalid(field.NewPath("spec", "volumes").Index(i).Child("size"), binding.Size,
+				fmt.Sprintf("volume %s can not be shrunk from %s, volumes can only be expanded", binding.Target, current)))
+		}
+	}
+	return
+}
+
 func (s *Validator) validateName(app *apiv1.App) error {
 	if app.Name == "" {
 		return fmt.Errorf("name is required")
@@ -432,6 +489,7 @@ func (s *Validator) checkScheduling(ctx context.Context, params *apiv1.App, proj
 	if err != nil {
 		validationErrors = append(validationErrors, err...)
 	}
+	validationErrors = append(validationErrors, validateCPURunFlags(params.Spec.CPU, workloads)...)
 
 	for workload, container := range workloads {
 		cc, err := getClassForWorkload(computeClasses, computeClass, container, workload)
@@ -487,6 +545,19 @@ func (s *Validator) checkScheduling(ctx context.Context, params *apiv1.App, proj
 				validationErrors = append(validationErrors, field.Invalid(field.NewPath("unknown"), "", err.Error()))
 			}
 		}
+
+		// Validate the CPU set by the user or the Acornfile. The default CPU of the ComputeClass is validated when the class is created.
+		cpu, cpuErrType := v1.GetCPU(params.Spec.CPU, workload, container)
+		if cpu == nil {
+			continue
+		}
+		if err = computeclasses.ValidateCPU(*cc, cpu.Quantity()); err != nil {
+			path := field.NewPath("spec", "image")
+			if errors.Is(cpuErrType, v1.ErrInvalidSetCPU) {
+				path = field.NewPath("spec", "cpu", workload)
+			}
+			validationErrors = append(validationErrors, field.Invalid(path, cpu.String(), fmt.Errorf("%w: %v", cpuErrType, err).Error()))
+		}
 	}
 	return validationErrors
 }
@@ -522,6 +593,20 @@ func validateMemoryRunFlags(memory v1.MemoryMap, workloads map[string]v1.Contain
 	return validationErrors
 }
 
+func validateCPURunFlags(cpu v1.CPUMap, workloads map[string]v1.Container) []*field.Error {
+	var validationErrors []*field.Error
+	for key := range cpu {
+		if key == "" {
+			continue
+		}
+		if _, ok := workloads[key]; !ok {
+			path := field.NewPath("spec", "cpu")
+			validationErrors = append(validationErrors, field.Invalid(path, key, v1.ErrInvalidWorkload.Error()))
+		}
+	}
+	return validationErrors
+}
+
 func validateVolumeClasses(ctx context.Context, c kclient.Client, namespace string, appInstanceSpec v1.AppInstanceSpec, appSpec *v1.AppSpec, project *apiv1.Project) *field.Error {
 	if len(appInstanceSpec.Volumes) == 0 && len(appSpec.Volumes) == 0 {
 		return nil
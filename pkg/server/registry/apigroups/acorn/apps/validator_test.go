@@ -144,3 +144,122 @@ func TestCannotUpdateNestedAcorn(t *testing.T) {
 		assert.True(t, strings.Contains(err[0].Error(), "update the parent Acorn"))
 	}
 }
+
+func TestInvalidRollout(t *testing.T) {
+	validator := &Validator{}
+
+	tests := []struct {
+		name    string
+		rollout internalv1.RolloutStrategy
+		message string
+	}{
+		{
+			name:    "Unknown type",
+			rollout: internalv1.RolloutStrategy{Type: "surprise"},
+			message: "invalid rollout strategy",
+		},
+		{
+			name: "Weight over 100",
+			rollout: internalv1.RolloutStrategy{
+				Type:  internalv1.RolloutStrategyCanary,
+				Steps: []internalv1.RolloutStep{{Weight: 50}, {Weight: 150}},
+			},
+			message: "invalid rollout step weight",
+		},
+		{
+			name: "Bad pause",
+			rollout: internalv1.RolloutStrategy{
+				Type:  internalv1.RolloutStrategyCanary,
+				Steps: []internalv1.RolloutStep{{Weight: 10, Pause: "a while"}},
+			},
+			message: "invalid rollout step pause",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := &apiv1.App{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "myapp",
+					Namespace: "acorn",
+				},
+				Spec: internalv1.AppInstanceSpec{
+					Rollout: &tt.rollout,
+				},
+			}
+			err := validator.Validate(context.Background(), app)
+			if assert.Len(t, err, 1) {
+				assert.Equal(t, "spec.rollout", err[0].Field)
+				assert.Contains(t, err[0].Error(), tt.message)
+			}
+		})
+	}
+}
+
+func TestCannotShrinkVolume(t *testing.T) {
+	validator := &Validator{}
+
+	oldApp := apiv1.App{
+		Spec: internalv1.AppInstanceSpec{
+			Volumes: []internalv1.VolumeBinding{{Target: "data", Size: "10G"}},
+		},
+		Status: internalv1.AppInstanceStatus{
+			AppStatus: internalv1.AppStatus{
+				Volumes: map[string]internalv1.VolumeStatus{
+					"data": {Size: "21G", RequestedSize: "20G"},
+				},
+			},
+		},
+	}
+	newApp := apiv1.App{
+		Spec: internalv1.AppInstanceSpec{
+			Volumes: []internalv1.VolumeBinding{{Target: "data", Size: "15G"}},
+		},
+	}
+
+	err := validator.ValidateUpdate(context.Background(), &newApp, &oldApp)
+	if assert.Len(t, err, 1) {
+		assert.Equal(t, "spec.volumes[0].size", err[0].Field)
+		assert.Contains(t, err[0].Error(), "volume data can not be shrunk from 20G")
+	}
+
+	// the capacity of the volume can be rounded up, so sizes between the requested size and the capacity are allowed
+	assert.Empty(t, validateVolumeResize(&apiv1.App{
+		Spec: internalv1.AppInstanceSpec{
+			Volumes: []internalv1.VolumeBinding{{Target: "data", Size: "20G"}},
+		},
+	}, &oldApp))
+	assert.Empty(t, validateVolumeResize(&apiv1.App{
+		Spec: internalv1.AppInstanceSpec{
+			Volumes: []internalv1.VolumeBinding{{Target: "data", Size: "30G"}},
+		},
+	}, &oldApp))
+}
+
+func TestCannotShrinkVolumeBeforeProvisioning(t *testing.T) {
+	oldApp := apiv1.App{
+		Status: internalv1.AppInstanceStatus{
+			AppSpec: internalv1.AppSpec{
+				Volumes: map[string]internalv1.VolumeRequest{
+					"data": {Size: "10G"},
+				},
+			},
+		},
+	}
+
+	err := validateVolumeResize(&apiv1.App{
+		Spec: internalv1.AppInstanceSpec{
+			Volumes: []internalv1.VolumeBinding{{Target: "data", Size: "5G"}},
+		},
+	}, &oldApp)
+	if assert.Len(t, err, 1) {
+		assert.Contains(t, err[0].Error(), "volume data can not be shrunk from 10G")
+	}
+
+	oldApp.Spec.Volumes = []internalv1.VolumeBinding{{Target: "data", Size: "4G"}}
+	assert.Empty(t, validateVolumeResize(&apiv1.App{
+		Spec: internalv1.AppInstanceSpec{
+			Volumes: []internalv1.VolumeBinding{{Target: "data", Size: "5G"}},
+		},
+	}, &oldApp))
+}
@@ -51,12 +51,16 @@ func (s *Strategy) Create(ctx context.Context, object types.Object) (types.Objec
 		return nil, err
 	}
 
-	token, err := buildserver.CreateToken(builder, acornBuild, pushRepo.String())
+	cfg, err := config.Get(ctx, s.client)
 	if err != nil {
 		return nil, err
 	}
 
-	cfg, err := config.Get(ctx, s.client)
+	if *cfg.BuildSBOM {
+		acornBuild.Spec.SBOM = true
+	}
+
+	token, err := buildserver.CreateToken(builder, acornBuild, pushRepo.String())
 	if err != nil {
 		return nil, err
 	}
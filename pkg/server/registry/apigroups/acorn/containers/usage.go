@@ -0,0 +1,256 @@
+package containers
+
+import (
+	"context"
+	"sort"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/mink/pkg/stores"
+	mtypes "github.com/acorn-io/mink/pkg/types"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/computeclasses"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/tables"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/apiserver/pkg/storage"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var podMetricsListGVK = schema.GroupVersionKind{
+	Group:   "metrics.k8s.io",
+	Version: "v1beta1",
+	Kind:    "PodMetricsList",
+}
+
+// podMetrics is the subset of the metrics.k8s.io PodMetrics type that is needed to report usage
+type podMetrics struct {
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Timestamp  metav1.Time      `json:"timestamp"`
+	Window     metav1.Duration  `json:"window"`
+	Containers []containerUsage `json:"containers"`
+}
+
+type containerUsage struct {
+	Name  string              `json:"name"`
+	Usage corev1.ResourceList `json:"usage"`
+}
+
+func NewUsageStorage(c kclient.WithWatch) rest.Storage {
+	return stores.NewBuilder(c.Scheme(), &apiv1.ContainerReplicaUsage{}).
+		WithList(NewUsageStrategy(c)).
+		WithTableConverter(tables.ContainerReplicaUsageConverter).
+		Build()
+}
+
+func NewUsageStrategy(c kclient.Client) *UsageStrategy {
+	return &UsageStrategy{
+		client:     c,
+		translator: &Translator{client: c},
+	}
+}
+
+// UsageStrategy lists the usage of the container replicas of a project by joining their pods with the pod metrics
+// served by the metrics API
+type UsageStrategy struct {
+	client     kclient.Client
+	translator *Translator
+}
+
+func (s *UsageStrategy) New() mtypes.Object {
+	return &apiv1.ContainerReplicaUsage{}
+}
+
+func (s *UsageStrategy) NewList() mtypes.ObjectList {
+	return &apiv1.ContainerReplicaUsageList{}
+}
+
+func (s *UsageStrategy) List(ctx context.Context, namespace string, opts storage.ListOptions) (mtypes.ObjectList, error) {
+	_, opts, err := s.translator.ListOpts(ctx, namespace, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	pods := &corev1.PodList{}
+	if err := s.client.List(ctx, pods, &kclient.ListOptions{
+		LabelSelector: opts.Predicate.Label,
+	}); err != nil {
+		return nil, err
+	}
+
+	metricsList := &unstructured.UnstructuredList{}
+	metricsList.SetGroupVersionKind(podMetricsListGVK)
+	if err := s.client.List(ctx, metricsList, &kclient.ListOptions{
+		LabelSelector: opts.Predicate.Label,
+	}); meta.IsNoMatchError(err) {
+		return nil, apierrors.NewServiceUnavailable("the metrics API is not available, a metrics server such as metrics-server must be installed in the cluster")
+	} else if err != nil {
+		return nil, err
+	}
+
+	metricsByPod := map[string]podMetrics{}
+	for _, item := range metricsList.Items {
+		var metrics podMetrics
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, &metrics); err != nil {
+			return nil, err
+		}
+		metricsByPod[router.Key(metrics.Namespace, metrics.Name).String()] = metrics
+	}
+
+	classes := &computeClassResolver{
+		client:   s.client,
+		apps:     map[string]*v1.AppInstance{},
+		defaults: map[string]string{},
+	}
+
+	result := &apiv1.ContainerReplicaUsageList{}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		metrics, ok := metricsByPod[router.Key(pod.Namespace, pod.Name).String()]
+		if !ok {
+			continue
+		}
+
+		replicas := podToContainers(pod)
+		if len(replicas) == 0 {
+			continue
+		}
+
+		computeClass, err := classes.forReplica(ctx, pod, replicas[0])
+		if err != nil {
+			return nil, err
+		}
+
+		for _, replica := range replicas {
+			usage, ok := toContainerReplicaUsage(pod, metrics, replica)
+			if !ok {
+				continue
+			}
+			usage.ComputeClass = computeClass
+			result.Items = append(result.Items, *usage)
+		}
+	}
+
+	sort.Slice(result.Items, func(i, j int) bool {
+		return result.Items[i].Name < result.Items[j].Name
+	})
+
+	return result, nil
+}
+
+// toContainerReplicaUsage returns the usage of the replica, or false if the metrics API has not reported the usage of
+// its container yet
+func toContainerReplicaUsage(pod *corev1.Pod, metrics podMetrics, replica apiv1.ContainerReplica) (*apiv1.ContainerReplicaUsage, bool) {
+	containerName := replica.Spec.ContainerName
+	if replica.Spec.SidecarName != "" {
+		containerName = replica.Spec.SidecarName
+	} else if containerName == "" {
+		containerName = replica.Spec.JobName
+	}
+
+	var usage *containerUsage
+	for i, container := range metrics.Containers {
+		if container.Name == containerName {
+			usage = &metrics.Containers[i]
+			break
+		}
+	}
+	if usage == nil {
+		return nil, false
+	}
+
+	result := &apiv1.ContainerReplicaUsage{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              replica.Name,
+			Namespace:         replica.Namespace,
+			UID:               replica.UID,
+			CreationTimestamp: replica.CreationTimestamp,
+			Labels:            replica.Labels,
+		},
+		AppName:       replica.Spec.AppName,
+		JobName:       replica.Spec.JobName,
+		ContainerName: replica.Spec.ContainerName,
+		SidecarName:   replica.Spec.SidecarName,
+		Timestamp:     metrics.Timestamp,
+		Window:        metrics.Window,
+		CPU:           usage.Usage[corev1.ResourceCPU],
+		Memory:        usage.Usage[corev1.ResourceMemory],
+	}
+
+	for _, container := range append(pod.Spec.InitContainers, pod.Spec.Containers...) {
+		if container.Name != containerName {
+			continue
+		}
+		if q, ok := container.Resources.Requests[corev1.ResourceCPU]; ok {
+			result.CPURequest = &q
+		}
+		if q, ok := container.Resources.Requests[corev1.ResourceMemory]; ok {
+			result.MemoryRequest = &q
+		}
+		if q, ok := container.Resources.Limits[corev1.ResourceMemory]; ok {
+			result.MemoryLimit = &q
+		}
+		break
+	}
+
+	return result, true
+}
+
+// computeClassResolver determines the compute class of the replicas of a pod, caching the app instances and default
+// compute classes it looks up
+type computeClassResolver struct {
+	client   kclient.Client
+	apps     map[string]*v1.AppInstance
+	defaults map[string]string
+}
+
+// forReplica returns the compute class of the pod's workload. Sidecars use the compute class of the container they
+// belong to, so the replica passed must be the pod's main container.
+func (c *computeClassResolver) forReplica(ctx context.Context, pod *corev1.Pod, replica apiv1.ContainerReplica) (string, error) {
+	appNamespace, appName := pod.Labels[labels.AcornAppNamespace], pod.Labels[labels.AcornAppName]
+	key := router.Key(appNamespace, appName).String()
+
+	app, ok := c.apps[key]
+	if !ok {
+		app = &v1.AppInstance{}
+		if err := c.client.Get(ctx, router.Key(appNamespace, appName), app); apierrors.IsNotFound(err) {
+			app = nil
+		} else if err != nil {
+			return "", err
+		}
+		c.apps[key] = app
+	}
+
+	workload := replica.Spec.ContainerName
+	if workload == "" {
+		workload = replica.Spec.JobName
+	}
+
+	var computeClasses v1.ComputeClassMap
+	if app != nil {
+		computeClasses = app.Spec.ComputeClasses
+	}
+
+	if name := computeclasses.GetComputeClassNameForWorkload(workload, v1.Container(replica.Spec.EmbeddedContainer), computeClasses); name != "" {
+		return name, nil
+	}
+
+	name, ok := c.defaults[appNamespace]
+	if !ok {
+		var err error
+		name, err = computeclasses.GetDefaultComputeClass(ctx, c.client, appNamespace)
+		if err != nil {
+			return "", err
+		}
+		c.defaults[appNamespace] = name
+	}
+	return name, nil
+}
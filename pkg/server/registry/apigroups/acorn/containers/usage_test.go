@@ -0,0 +1,93 @@
+package containers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestToContainerReplicaUsage(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "web-abc",
+			Namespace: "app-ns",
+			Labels: map[string]string{
+				labels.AcornAppPublicName: "app",
+				labels.AcornAppNamespace:  "acorn",
+				labels.AcornContainerName: "web",
+			},
+			Annotations: map[string]string{
+				labels.AcornContainerSpec: `{"image":"nginx","sidecars":{"proxy":{"image":"envoy"},"idle":{"image":"busybox"}}}`,
+			},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name: "web",
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("250m"),
+							corev1.ResourceMemory: resource.MustParse("128Mi"),
+						},
+						Limits: corev1.ResourceList{
+							corev1.ResourceMemory: resource.MustParse("256Mi"),
+						},
+					},
+				},
+				{Name: "proxy"},
+				{Name: "idle"},
+			},
+		},
+	}
+	metrics := podMetrics{
+		Window: metav1.Duration{Duration: 15 * time.Second},
+		Containers: []containerUsage{
+			{
+				Name: "web",
+				Usage: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("12m"),
+					corev1.ResourceMemory: resource.MustParse("64Mi"),
+				},
+			},
+			{
+				Name: "proxy",
+				Usage: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("1m"),
+					corev1.ResourceMemory: resource.MustParse("8Mi"),
+				},
+			},
+		},
+	}
+
+	replicas := podToContainers(pod)
+	require.Len(t, replicas, 3)
+
+	usage, ok := toContainerReplicaUsage(pod, metrics, replicas[0])
+	require.True(t, ok)
+	assert.Equal(t, "app.web-abc", usage.Name)
+	assert.Equal(t, "acorn", usage.Namespace)
+	assert.Equal(t, "app", usage.AppName)
+	assert.Equal(t, "web", usage.ContainerName)
+	assert.Equal(t, "12m", usage.CPU.String())
+	assert.Equal(t, "64Mi", usage.Memory.String())
+	assert.Equal(t, "250m", usage.CPURequest.String())
+	assert.Equal(t, "128Mi", usage.MemoryRequest.String())
+	assert.Equal(t, "256Mi", usage.MemoryLimit.String())
+
+	// Sidecars are sorted by name, so the replicas after the main container are idle and then proxy
+	_, ok = toContainerReplicaUsage(pod, metrics, replicas[1])
+	assert.False(t, ok, "a container without metrics has no usage")
+
+	usage, ok = toContainerReplicaUsage(pod, metrics, replicas[2])
+	require.True(t, ok)
+	assert.Equal(t, "app.web-abc:proxy", usage.Name)
+	assert.Equal(t, "proxy", usage.SidecarName)
+	assert.Equal(t, "8Mi", usage.Memory.String())
+	assert.Nil(t, usage.MemoryRequest)
+}
@@ -0,0 +1,40 @@
+package eventsubscriptions
+
+import (
+	"context"
+	"net/url"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/egress"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+type Validator struct{}
+
+func (s *Validator) Validate(_ context.Context, obj runtime.Object) (result field.ErrorList) {
+	sub := obj.(*apiv1.EventSubscription)
+
+	u, err := url.Parse(sub.Spec.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		result = append(result, field.Invalid(field.NewPath("spec", "url"), sub.Spec.URL, "must be an absolute http or https url"))
+	} else if err := egress.ValidateHost(u.Hostname()); err != nil {
+		result = append(result, field.Invalid(field.NewPath("spec", "url"), sub.Spec.URL, "must not be a loopback, link-local or private address"))
+	}
+
+	for i, severity := range sub.Spec.Severities {
+		switch severity {
+		case v1.EventSeverityInfo, v1.EventSeverityWarn, v1.EventSeverityCritical:
+		default:
+			result = append(result, field.NotSupported(field.NewPath("spec", "severities").Index(i), severity,
+				[]string{string(v1.EventSeverityInfo), string(v1.EventSeverityWarn), string(v1.EventSeverityCritical)}))
+		}
+	}
+
+	return
+}
+
+func (s *Validator) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	return s.Validate(ctx, obj)
+}
@@ -0,0 +1,33 @@
+package jobs
+
+import (
+	"github.com/acorn-io/mink/pkg/stores"
+	"github.com/acorn-io/mink/pkg/strategy/remote"
+	"github.com/acorn-io/mink/pkg/strategy/translation"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/tables"
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func NewStorage(c client.WithWatch) rest.Storage {
+	translator := &Translator{
+		client: c,
+	}
+	strategy := &Strategy{
+		Strategy:   translation.NewTranslationStrategy(translator, remote.NewRemote(&batchv1.Job{}, c)),
+		client:     c,
+		translator: translator,
+	}
+
+	return stores.NewBuilder(c.Scheme(), &apiv1.JobExecution{}).
+		WithCreate(strategy).
+		WithGet(strategy).
+		WithList(strategy).
+		WithDelete(strategy).
+		WithWatch(strategy).
+		WithValidateCreate(strategy).
+		WithTableConverter(tables.JobExecutionConverter).
+		Build()
+}
@@ -0,0 +1,98 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/mink/pkg/strategy/translation"
+	"github.com/acorn-io/mink/pkg/types"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type Strategy struct {
+	*translation.Strategy
+	client     kclient.Client
+	translator *Translator
+}
+
+func (s *Strategy) Validate(ctx context.Context, obj runtime.Object) (result field.ErrorList) {
+	execution := obj.(*apiv1.JobExecution)
+	if execution.Spec.AppName == "" {
+		return append(result, field.Required(field.NewPath("spec", "appName"), "the app of the job to run is required"))
+	}
+	if execution.Spec.JobName == "" {
+		return append(result, field.Required(field.NewPath("spec", "jobName"), "the name of the job to run is required"))
+	}
+
+	app := &apiv1.App{}
+	if err := s.client.Get(ctx, router.Key(execution.Namespace, execution.Spec.AppName), app); err != nil {
+		return append(result, field.Invalid(field.NewPath("spec", "appName"), execution.Spec.AppName, err.Error()))
+	}
+
+	job, ok := app.Status.AppSpec.Jobs[execution.Spec.JobName]
+	if !ok {
+		return append(result, field.NotFound(field.NewPath("spec", "jobName"), execution.Spec.JobName))
+	}
+	if job.Schedule == "" {
+		result = append(result, field.Invalid(field.NewPath("spec", "jobName"), execution.Spec.JobName, "only jobs with a schedule can be run on demand"))
+	}
+	return
+}
+
+// Create runs a scheduled job on demand by creating a Job from the job template of its CronJob, the same
+// as "kubectl create job --from=cronjob/<name>" would.
+func (s *Strategy) Create(ctx context.Context, obj types.Object) (types.Object, error) {
+	execution := obj.(*apiv1.JobExecution)
+
+	app := &apiv1.App{}
+	if err := s.client.Get(ctx, router.Key(execution.Namespace, execution.Spec.AppName), app); err != nil {
+		return nil, err
+	}
+
+	cronJob := &batchv1.CronJob{}
+	if err := s.client.Get(ctx, router.Key(app.Status.Namespace, execution.Spec.JobName), cronJob); err != nil {
+		return nil, fmt.Errorf("failed to find scheduled job [%s] of app [%s]: %w", execution.Spec.JobName, app.Name, err)
+	}
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: cronJob.Name + "-manual-",
+			Namespace:    cronJob.Namespace,
+			Labels:       cronJob.Spec.JobTemplate.Labels,
+			Annotations: labels.Merge(cronJob.Spec.JobTemplate.Annotations, map[string]string{
+				annotationInstantiate: apiv1.JobExecutionTriggerManual,
+			}),
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(cronJob, batchv1.SchemeGroupVersion.WithKind("CronJob")),
+			},
+		},
+		Spec: *cronJob.Spec.JobTemplate.Spec.DeepCopy(),
+	}
+	if err := s.client.Create(ctx, job); err != nil {
+		return nil, err
+	}
+
+	objs, err := s.translator.ToPublic(ctx, job)
+	if err != nil {
+		return nil, err
+	}
+	return objs[0], nil
+}
+
+// Delete removes the Job of the execution along with its pods
+func (s *Strategy) Delete(ctx context.Context, obj types.Object) (types.Object, error) {
+	execution := obj.(*apiv1.JobExecution)
+	return execution, s.client.Delete(ctx, &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      execution.Status.JobResourceName,
+			Namespace: execution.Status.JobResourceNamespace,
+		},
+	}, kclient.PropagationPolicy(metav1.DeletePropagationBackground))
+}
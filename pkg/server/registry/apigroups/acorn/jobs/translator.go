@@ -0,0 +1,163 @@
+package jobs
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	mtypes "github.com/acorn-io/mink/pkg/types"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/namespace"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	klabels "k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apiserver/pkg/storage"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// annotationInstantiate is set by kubectl and the API on Jobs created on demand from a CronJob
+const annotationInstantiate = "cronjob.kubernetes.io/instantiate"
+
+type Translator struct {
+	client kclient.Client
+}
+
+func (t *Translator) FromPublicName(ctx context.Context, namespace, name string) (string, string, error) {
+	parts := strings.Split(name, ".")
+	if len(parts) == 1 {
+		return namespace, name, nil
+	}
+	appName := strings.Join(parts[:len(parts)-1], ".")
+
+	app := &apiv1.App{}
+	if err := t.client.Get(ctx, router.Key(namespace, appName), app); err != nil {
+		return namespace, name, err
+	}
+
+	return app.Status.Namespace, parts[len(parts)-1], nil
+}
+
+func (t *Translator) ListOpts(ctx context.Context, namespace string, opts storage.ListOptions) (string, storage.ListOptions, error) {
+	sel := opts.Predicate.Label
+	if sel == nil {
+		sel = klabels.Everything()
+	}
+	req, _ := klabels.NewRequirement(labels.AcornManaged, selection.Equals, []string{"true"})
+	sel = sel.Add(*req)
+	req, _ = klabels.NewRequirement(labels.AcornJobName, selection.Exists, nil)
+	sel = sel.Add(*req)
+
+	if namespace != "" {
+		req, _ := klabels.NewRequirement(labels.AcornAppNamespace, selection.Equals, []string{namespace})
+		sel = sel.Add(*req)
+	}
+	opts.Predicate.Label = sel
+	return "", opts, nil
+}
+
+func (t *Translator) ToPublic(ctx context.Context, objs ...runtime.Object) (result []mtypes.Object, _ error) {
+	for _, obj := range objs {
+		job := obj.(*batchv1.Job)
+		pods := &corev1.PodList{}
+		if err := t.client.List(ctx, pods, &kclient.ListOptions{
+			Namespace: job.Namespace,
+			LabelSelector: klabels.SelectorFromSet(map[string]string{
+				"controller-uid": string(job.UID),
+			}),
+		}); err != nil {
+			return nil, err
+		}
+		result = append(result, jobToExecution(job, pods.Items))
+	}
+	return
+}
+
+func (t *Translator) FromPublic(_ context.Context, obj runtime.Object) (mtypes.Object, error) {
+	execution := obj.(*apiv1.JobExecution)
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      execution.Status.JobResourceName,
+			Namespace: execution.Status.JobResourceNamespace,
+		},
+	}, nil
+}
+
+func (t *Translator) NewPublicList() mtypes.ObjectList {
+	return &apiv1.JobExecutionList{}
+}
+
+func (t *Translator) NewPublic() mtypes.Object {
+	return &apiv1.JobExecution{}
+}
+
+func jobToExecution(job *batchv1.Job, pods []corev1.Pod) *apiv1.JobExecution {
+	ns, name := namespace.NormalizedName(job.ObjectMeta)
+	jobName := job.Labels[labels.AcornJobName]
+
+	result := &apiv1.JobExecution{
+		ObjectMeta: job.ObjectMeta,
+		Spec: apiv1.JobExecutionSpec{
+			AppName: job.Labels[labels.AcornAppPublicName],
+			JobName: jobName,
+		},
+		Status: apiv1.JobExecutionStatus{
+			Trigger:              trigger(job),
+			State:                apiv1.JobExecutionStateRunning,
+			StartTime:            job.Status.StartTime,
+			CompletionTime:       job.Status.CompletionTime,
+			Attempts:             job.Status.Active + job.Status.Succeeded + job.Status.Failed,
+			JobResourceName:      job.Name,
+			JobResourceNamespace: job.Namespace,
+		},
+	}
+	result.Name = name
+	result.Namespace = ns
+	result.OwnerReferences = nil
+	result.ManagedFields = nil
+
+	for _, cond := range job.Status.Conditions {
+		if cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch cond.Type {
+		case batchv1.JobComplete:
+			result.Status.State = apiv1.JobExecutionStateSucceeded
+		case batchv1.JobFailed:
+			result.Status.State = apiv1.JobExecutionStateFailed
+			result.Status.Message = cond.Message
+		}
+	}
+
+	sort.Slice(pods, func(i, j int) bool {
+		return pods[i].CreationTimestamp.Before(&pods[j].CreationTimestamp)
+	})
+	for _, pod := range pods {
+		_, replicaName := namespace.NormalizedName(pod.ObjectMeta)
+		result.Status.ContainerReplicaNames = append(result.Status.ContainerReplicaNames, replicaName)
+		result.Status.ExitCode = nil
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.Name == jobName && status.State.Terminated != nil {
+				result.Status.ExitCode = &status.State.Terminated.ExitCode
+			}
+		}
+	}
+
+	return result
+}
+
+func trigger(job *batchv1.Job) string {
+	if job.Annotations[annotationInstantiate] == apiv1.JobExecutionTriggerManual {
+		return apiv1.JobExecutionTriggerManual
+	}
+	for _, owner := range job.OwnerReferences {
+		if owner.Kind == "CronJob" {
+			return apiv1.JobExecutionTriggerSchedule
+		}
+	}
+	return apiv1.JobExecutionTriggerEvent
+}
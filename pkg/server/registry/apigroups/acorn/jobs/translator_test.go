@@ -0,0 +1,128 @@
+package jobs
+
+import (
+	"testing"
+	"time"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func jobLabels() map[string]string {
+	return map[string]string{
+		labels.AcornManaged:       "true",
+		labels.AcornAppNamespace:  "app-namespace",
+		labels.AcornAppPublicName: "app",
+		labels.AcornJobName:       "backup",
+	}
+}
+
+func pod(name string, created time.Time, state corev1.ContainerState) corev1.Pod {
+	return corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "app-created-namespace",
+			Labels:            jobLabels(),
+			CreationTimestamp: metav1.NewTime(created),
+		},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "backup", State: state},
+				{Name: "acorn-job-output-helper", State: corev1.ContainerState{
+					Terminated: &corev1.ContainerStateTerminated{ExitCode: 0},
+				}},
+			},
+		},
+	}
+}
+
+func TestJobToExecution(t *testing.T) {
+	now := time.Now()
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "backup-28123456",
+			Namespace: "app-created-namespace",
+			Labels:    jobLabels(),
+			OwnerReferences: []metav1.OwnerReference{
+				{Kind: "CronJob", Name: "backup"},
+			},
+		},
+		Status: batchv1.JobStatus{
+			Failed: 2,
+			Conditions: []batchv1.JobCondition{
+				{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Message: "Job has reached the specified backoff limit"},
+			},
+		},
+	}
+
+	execution := jobToExecution(job, []corev1.Pod{
+		pod("backup-28123456-second", now, corev1.ContainerState{
+			Terminated: &corev1.ContainerStateTerminated{ExitCode: 2},
+		}),
+		pod("backup-28123456-first", now.Add(-time.Minute), corev1.ContainerState{
+			Terminated: &corev1.ContainerStateTerminated{ExitCode: 1},
+		}),
+	})
+
+	assert.Equal(t, "app.backup-28123456", execution.Name)
+	assert.Equal(t, "app-namespace", execution.Namespace)
+	assert.Equal(t, apiv1.JobExecutionSpec{AppName: "app", JobName: "backup"}, execution.Spec)
+	assert.Equal(t, apiv1.JobExecutionTriggerSchedule, execution.Status.Trigger)
+	assert.Equal(t, apiv1.JobExecutionStateFailed, execution.Status.State)
+	assert.Equal(t, "Job has reached the specified backoff limit", execution.Status.Message)
+	assert.Equal(t, int32(2), execution.Status.Attempts)
+	assert.Equal(t, []string{"app.backup-28123456-first", "app.backup-28123456-second"}, execution.Status.ContainerReplicaNames)
+	if assert.NotNil(t, execution.Status.ExitCode) {
+		assert.Equal(t, int32(2), *execution.Status.ExitCode)
+	}
+	assert.Equal(t, "backup-28123456", execution.Status.JobResourceName)
+	assert.Equal(t, "app-created-namespace", execution.Status.JobResourceNamespace)
+	assert.Nil(t, execution.OwnerReferences)
+}
+
+func TestJobToExecutionRunning(t *testing.T) {
+	now := time.Now()
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "backup-manual-abcde",
+			Namespace:   "app-created-namespace",
+			Labels:      jobLabels(),
+			Annotations: map[string]string{annotationInstantiate: "manual"},
+			OwnerReferences: []metav1.OwnerReference{
+				{Kind: "CronJob", Name: "backup"},
+			},
+		},
+		Status: batchv1.JobStatus{
+			Active: 1,
+			Failed: 1,
+		},
+	}
+
+	execution := jobToExecution(job, []corev1.Pod{
+		pod("backup-manual-abcde-first", now.Add(-time.Minute), corev1.ContainerState{
+			Terminated: &corev1.ContainerStateTerminated{ExitCode: 1},
+		}),
+		pod("backup-manual-abcde-second", now, corev1.ContainerState{
+			Running: &corev1.ContainerStateRunning{},
+		}),
+	})
+
+	assert.Equal(t, apiv1.JobExecutionTriggerManual, execution.Status.Trigger)
+	assert.Equal(t, apiv1.JobExecutionStateRunning, execution.Status.State)
+	assert.Equal(t, int32(2), execution.Status.Attempts)
+	assert.Nil(t, execution.Status.ExitCode)
+}
+
+func TestTrigger(t *testing.T) {
+	assert.Equal(t, apiv1.JobExecutionTriggerEvent, trigger(&batchv1.Job{}))
+	assert.Equal(t, apiv1.JobExecutionTriggerSchedule, trigger(&batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{OwnerReferences: []metav1.OwnerReference{{Kind: "CronJob"}}},
+	}))
+	assert.Equal(t, apiv1.JobExecutionTriggerManual, trigger(&batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{annotationInstantiate: "manual"}},
+	}))
+}
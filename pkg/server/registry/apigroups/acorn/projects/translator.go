@@ -2,11 +2,13 @@ package projects
 
 import (
 	"context"
+	"encoding/json"
 	"strings"
 
 	"github.com/acorn-io/mink/pkg/types"
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
 	klabels "k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -61,17 +63,26 @@ func (t *Translator) ToPublic(_ context.Context, obj ...runtime.Object) (result
 			calculatedSupportedRegions = []string{calculatedDefaultRegion}
 		}
 
+		var logSinks []apiv1.LogSink
+		if data := ns.Annotations[labels.AcornProjectLogSinks]; data != "" {
+			if err := json.Unmarshal([]byte(data), &logSinks); err != nil {
+				logrus.Errorf("failed to unmarshal log sinks of project %s: %v", ns.Name, err)
+			}
+		}
+
 		delete(ns.Labels, labels.AcornProject)
 		delete(ns.Annotations, labels.AcornProjectDefaultRegion)
 		delete(ns.Annotations, labels.AcornProjectSupportedRegions)
 		delete(ns.Annotations, labels.AcornCalculatedProjectDefaultRegion)
 		delete(ns.Annotations, labels.AcornCalculatedProjectSupportedRegions)
+		delete(ns.Annotations, labels.AcornProjectLogSinks)
 
 		result = append(result, &apiv1.Project{
 			ObjectMeta: ns.ObjectMeta,
 			Spec: apiv1.ProjectSpec{
 				DefaultRegion:    defaultRegion,
 				SupportedRegions: supportedRegions,
+				LogSinks:         logSinks,
 			},
 			Status: apiv1.ProjectStatus{
 				Namespace:        ns.Name,
@@ -102,6 +113,15 @@ func (t *Translator) FromPublic(_ context.Context, obj runtime.Object) (types.Ob
 	ns.Annotations[labels.AcornProjectSupportedRegions] = strings.Join(prj.Spec.SupportedRegions, ",")
 	ns.Annotations[labels.AcornCalculatedProjectDefaultRegion] = prj.Status.DefaultRegion
 	ns.Annotations[labels.AcornCalculatedProjectSupportedRegions] = strings.Join(prj.Status.SupportedRegions, ",")
+	if len(prj.Spec.LogSinks) == 0 {
+		delete(ns.Annotations, labels.AcornProjectLogSinks)
+	} else {
+		data, err := json.Marshal(prj.Spec.LogSinks)
+		if err != nil {
+			return nil, err
+		}
+		ns.Annotations[labels.AcornProjectLogSinks] = string(data)
+	}
 
 	return ns, nil
 }
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/logsink"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -32,7 +33,13 @@ func (v *Validator) Validate(_ context.Context, obj runtime.Object) field.ErrorL
 		return append(result, field.Invalid(field.NewPath("spec", "defaultRegion"), project.Spec.DefaultRegion, "default region is not in the supported regions list"))
 	}
 
-	return nil
+	for i, sink := range project.Spec.LogSinks {
+		if err := logsink.Validate(sink); err != nil {
+			result = append(result, field.Invalid(field.NewPath("spec", "logSinks").Index(i), sink, err.Error()))
+		}
+	}
+
+	return result
 }
 
 func (v *Validator) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
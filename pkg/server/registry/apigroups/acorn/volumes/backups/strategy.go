@@ -0,0 +1,413 @@
+package backups
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/mink/pkg/types"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/backup"
+	"github.com/acorn-io/runtime/pkg/config"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/volume"
+	name2 "github.com/rancher/wrangler/pkg/name"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	klabels "k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/storage"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var groupResource = schema.GroupResource{
+	Group:    apiv1.SchemeGroupVersion.Group,
+	Resource: "volumebackups",
+}
+
+// Strategy stores volume backups and restores as Jobs in the namespace of the app that uses the volume, labeled with
+// the project they belong to. The Jobs that the CronJobs of scheduled backups create are listed too.
+type Strategy struct {
+	client kclient.Client
+}
+
+func (s *Strategy) New() types.Object {
+	return &apiv1.VolumeBackup{}
+}
+
+func (s *Strategy) NewList() types.ObjectList {
+	return &apiv1.VolumeBackupList{}
+}
+
+func (s *Strategy) Validate(_ context.Context, obj runtime.Object) (result field.ErrorList) {
+	volumeBackup := obj.(*apiv1.VolumeBackup)
+	if volumeBackup.Spec.Volume == "" {
+		result = append(result, field.Required(field.NewPath("spec", "volume"), "the volume to back up or restore is required"))
+	}
+
+	switch {
+	case volumeBackup.Spec.To == "" && volumeBackup.Spec.From == "":
+		result = append(result, field.Required(field.NewPath("spec", "to"), "either the URL to back up to or to restore from is required"))
+	case volumeBackup.Spec.To != "" && volumeBackup.Spec.From != "":
+		result = append(result, field.Invalid(field.NewPath("spec", "from"), volumeBackup.Spec.From, "a volume can not be backed up and restored at the same time"))
+	case volumeBackup.Spec.To != "":
+		if err := backup.Validate(volumeBackup.Spec.To); err != nil {
+			result = append(result, field.Invalid(field.NewPath("spec", "to"), volumeBackup.Spec.To, err.Error()))
+		}
+	default:
+		if err := backup.Validate(volumeBackup.Spec.From); err != nil {
+			result = append(result, field.Invalid(field.NewPath("spec", "from"), volumeBackup.Spec.From, err.Error()))
+		} else if strings.HasSuffix(volumeBackup.Spec.From, "/") {
+			result = append(result, field.Invalid(field.NewPath("spec", "from"), volumeBackup.Spec.From, "the URL of a single backup is required, not a prefix"))
+		}
+	}
+	return
+}
+
+func (s *Strategy) Create(ctx context.Context, obj types.Object) (types.Object, error) {
+	volumeBackup := obj.(*apiv1.VolumeBackup)
+
+	if _, err := s.getJob(ctx, volumeBackup.Namespace, volumeBackup.Name); err == nil {
+		return nil, apierrors.NewAlreadyExists(groupResource, volumeBackup.Name)
+	} else if !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	pvc, err := s.resolveClaim(ctx, volumeBackup.Namespace, volumeBackup.Spec.Volume)
+	if err != nil {
+		return nil, err
+	}
+
+	var credentials *corev1.Secret
+	if volumeBackup.Spec.Secret != "" {
+		credentials = &corev1.Secret{}
+		if err := s.client.Get(ctx, router.Key(volumeBackup.Namespace, volumeBackup.Spec.Secret), credentials); apierrors.IsNotFound(err) {
+			return nil, apierrors.NewBadRequest(fmt.Sprintf("no secret found with name %q in project %q", volumeBackup.Spec.Secret, volumeBackup.Namespace))
+		} else if err != nil {
+			return nil, err
+		}
+	}
+
+	nodeName, err := volume.NodeOfClaim(ctx, s.client, pvc)
+	if err != nil {
+		return nil, err
+	}
+
+	backupType, target := backup.TypeRestore, volumeBackup.Spec.From
+	if volumeBackup.Spec.To != "" {
+		backupType = backup.TypeBackup
+		target, err = backup.ResolveURL(volumeBackup.Spec.To, volumeName(pvc), time.Now())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	cfg, err := config.Get(ctx, s.client)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := backup.JobOptions{
+		Type:          backupType,
+		URL:           target,
+		ClaimName:     pvc.Name,
+		NodeName:      nodeName,
+		HostDirectory: *cfg.VolumeBackupDirectory,
+		Project:       volumeBackup.Namespace,
+	}
+	if credentials != nil {
+		opts.Secret = name2.SafeConcatName(volumeBackup.Name, "credentials")
+	}
+	podSpec, err := backup.PodSpec(opts)
+	if errors.Is(err, backup.ErrFileBackupsDisabled) {
+		return nil, apierrors.NewBadRequest(err.Error())
+	} else if err != nil {
+		return nil, err
+	}
+
+	jobLabels := map[string]string{
+		labels.AcornManaged:          "true",
+		labels.AcornAppNamespace:     volumeBackup.Namespace,
+		labels.AcornAppName:          pvc.Labels[labels.AcornAppName],
+		labels.AcornVolumeName:       pvc.Labels[labels.AcornVolumeName],
+		labels.AcornVolumeBackupType: backupType,
+	}
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      volumeBackup.Name,
+			Namespace: pvc.Namespace,
+			Labels:    jobLabels,
+			Annotations: map[string]string{
+				labels.AcornVolumeBackupSource: pvc.Spec.VolumeName,
+				labels.AcornVolumeBackupURL:    target,
+				labels.AcornVolumeBackupSecret: volumeBackup.Spec.Secret,
+			},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: &[]int32{2}[0],
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: jobLabels,
+				},
+				Spec: podSpec,
+			},
+		},
+	}
+	if err := s.client.Create(ctx, job); err != nil {
+		return nil, err
+	}
+
+	if credentials != nil {
+		// The job can only read secrets in its own namespace, the copy is deleted with the job
+		err := s.client.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      opts.Secret,
+				Namespace: job.Namespace,
+				Labels: map[string]string{
+					labels.AcornManaged: "true",
+				},
+				OwnerReferences: []metav1.OwnerReference{
+					*metav1.NewControllerRef(job, batchv1.SchemeGroupVersion.WithKind("Job")),
+				},
+			},
+			Data: credentials.Data,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return toVolumeBackup(*job, nil), nil
+}
+
+// resolveClaim returns the PersistentVolumeClaim of the volume. The volume is either the name of a volume of the
+// project or its <app>.<volume> alias. A volume that was not provisioned yet can only be found by its alias, so that
+// it can be created by restoring a backup into it.
+func (s *Strategy) resolveClaim(ctx context.Context, namespace, name string) (*corev1.PersistentVolumeClaim, error) {
+	vol := &apiv1.Volume{}
+	if err := s.client.Get(ctx, router.Key(namespace, name), vol); err == nil {
+		pv := &corev1.PersistentVolume{}
+		if err := s.client.Get(ctx, router.Key("", vol.Name), pv); err != nil {
+			return nil, err
+		}
+		if pv.Spec.ClaimRef == nil || pv.Status.Phase != corev1.VolumeBound {
+			return nil, apierrors.NewBadRequest(fmt.Sprintf("volume %s is not in use by an app, only volumes that are bound to an app can be backed up or restored", vol.Name))
+		}
+		pvc := &corev1.PersistentVolumeClaim{}
+		return pvc, s.client.Get(ctx, router.Key(pv.Spec.ClaimRef.Namespace, pv.Spec.ClaimRef.Name), pvc)
+	} else if !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	notFound := apierrors.NewBadRequest(fmt.Sprintf("no volume found with name %q in project %q", name, namespace))
+	i := strings.LastIndex(name, ".")
+	if i == -1 || i+1 >= len(name) {
+		return nil, notFound
+	}
+
+	app := &v1.AppInstance{}
+	if err := s.client.Get(ctx, router.Key(namespace, name[:i]), app); apierrors.IsNotFound(err) {
+		return nil, notFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	pvcs := &corev1.PersistentVolumeClaimList{}
+	if err := s.client.List(ctx, pvcs, &kclient.ListOptions{
+		Namespace: app.Status.Namespace,
+		LabelSelector: klabels.SelectorFromSet(map[string]string{
+			labels.AcornManaged:    "true",
+			labels.AcornAppName:    app.Name,
+			labels.AcornVolumeName: name[i+1:],
+		}),
+	}); err != nil {
+		return nil, err
+	}
+
+	switch len(pvcs.Items) {
+	case 0:
+		return nil, notFound
+	case 1:
+		return &pvcs.Items[0], nil
+	default:
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("volume %s is bound to more than one claim, use the name of the volume instead", name))
+	}
+}
+
+func (s *Strategy) Get(ctx context.Context, namespace, name string) (types.Object, error) {
+	job, err := s.getJob(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	pods, err := s.listPods(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	return toVolumeBackup(*job, pods), nil
+}
+
+func (s *Strategy) List(ctx context.Context, namespace string, opts storage.ListOptions) (types.ObjectList, error) {
+	result := &apiv1.VolumeBackupList{}
+
+	jobs, err := s.listJobs(ctx, namespace, opts.Predicate.Label)
+	if err != nil {
+		return nil, err
+	}
+
+	pods, err := s.listPods(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	name, filterByName := "", false
+	if opts.Predicate.Field != nil {
+		name, filterByName = opts.Predicate.Field.RequiresExactMatch("metadata.name")
+	}
+
+	for _, job := range jobs {
+		if filterByName && job.Name != name {
+			continue
+		}
+		result.Items = append(result.Items, *toVolumeBackup(job, pods))
+	}
+
+	sort.Slice(result.Items, func(i, j int) bool {
+		return result.Items[i].Name < result.Items[j].Name
+	})
+
+	return result, nil
+}
+
+func (s *Strategy) Delete(ctx context.Context, obj types.Object) (types.Object, error) {
+	job, err := s.getJob(ctx, obj.GetNamespace(), obj.GetName())
+	if err != nil {
+		return nil, err
+	}
+	return obj, s.client.Delete(ctx, job, kclient.PropagationPolicy(metav1.DeletePropagationBackground))
+}
+
+func (s *Strategy) getJob(ctx context.Context, namespace, name string) (*batchv1.Job, error) {
+	jobs, err := s.listJobs(ctx, namespace, nil)
+	if err != nil {
+		return nil, err
+	}
+	for i := range jobs {
+		if jobs[i].Name == name {
+			return &jobs[i], nil
+		}
+	}
+	return nil, apierrors.NewNotFound(groupResource, name)
+}
+
+func (s *Strategy) listJobs(ctx context.Context, namespace string, sel klabels.Selector) ([]batchv1.Job, error) {
+	jobs := &batchv1.JobList{}
+	if err := s.client.List(ctx, jobs, &kclient.ListOptions{
+		LabelSelector: backupSelector(namespace, sel),
+	}); err != nil {
+		return nil, err
+	}
+	return jobs.Items, nil
+}
+
+func (s *Strategy) listPods(ctx context.Context, namespace string) ([]corev1.Pod, error) {
+	pods := &corev1.PodList{}
+	if err := s.client.List(ctx, pods, &kclient.ListOptions{
+		LabelSelector: backupSelector(namespace, nil),
+	}); err != nil {
+		return nil, err
+	}
+	return pods.Items, nil
+}
+
+func backupSelector(namespace string, sel klabels.Selector) klabels.Selector {
+	if sel == nil {
+		sel = klabels.Everything()
+	}
+	req, _ := klabels.NewRequirement(labels.AcornManaged, selection.Equals, []string{"true"})
+	sel = sel.Add(*req)
+	req, _ = klabels.NewRequirement(labels.AcornVolumeBackupType, selection.Exists, nil)
+	sel = sel.Add(*req)
+	if namespace != "" {
+		req, _ := klabels.NewRequirement(labels.AcornAppNamespace, selection.Equals, []string{namespace})
+		sel = sel.Add(*req)
+	}
+	return sel
+}
+
+func volumeName(pvc *corev1.PersistentVolumeClaim) string {
+	if name := pvc.Labels[labels.AcornVolumeName]; name != "" {
+		return name
+	}
+	return pvc.Name
+}
+
+// toVolumeBackup returns the volume backup of the job. The pods of the job report the URL the backup was stored at,
+// or why it failed, in their termination message.
+func toVolumeBackup(job batchv1.Job, pods []corev1.Pod) *apiv1.VolumeBackup {
+	result := &apiv1.VolumeBackup{
+		ObjectMeta: job.ObjectMeta,
+		Spec: apiv1.VolumeBackupSpec{
+			Volume: job.Annotations[labels.AcornVolumeBackupSource],
+			Secret: job.Annotations[labels.AcornVolumeBackupSecret],
+		},
+		Status: apiv1.VolumeBackupStatus{
+			AppName:    job.Labels[labels.AcornAppName],
+			VolumeName: job.Labels[labels.AcornVolumeName],
+			URL:        job.Annotations[labels.AcornVolumeBackupURL],
+			State:      apiv1.VolumeBackupStatePending,
+		},
+	}
+	result.Namespace = job.Labels[labels.AcornAppNamespace]
+
+	if job.Labels[labels.AcornVolumeBackupType] == backup.TypeRestore {
+		result.Spec.From = result.Status.URL
+	} else {
+		result.Spec.To = result.Status.URL
+	}
+
+	if job.Status.Active > 0 {
+		result.Status.State = apiv1.VolumeBackupStateRunning
+	}
+	for _, cond := range job.Status.Conditions {
+		if cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch cond.Type {
+		case batchv1.JobComplete:
+			result.Status.State = apiv1.VolumeBackupStateSucceeded
+		case batchv1.JobFailed:
+			result.Status.State = apiv1.VolumeBackupStateFailed
+			result.Status.Message = cond.Message
+		}
+	}
+
+	for _, pod := range pods {
+		if pod.Namespace != job.Namespace || !metav1.IsControlledBy(&pod, &job) {
+			continue
+		}
+		for _, status := range pod.Status.ContainerStatuses {
+			terminated := status.State.Terminated
+			if terminated == nil || terminated.Message == "" {
+				continue
+			}
+			if terminated.ExitCode == 0 {
+				result.Status.URL = strings.TrimSpace(terminated.Message)
+			} else if result.Status.State == apiv1.VolumeBackupStateFailed {
+				result.Status.Message = strings.TrimSpace(terminated.Message)
+			}
+		}
+	}
+
+	return result
+}
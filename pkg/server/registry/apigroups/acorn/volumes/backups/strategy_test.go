@@ -0,0 +1,107 @@
+package backups
+
+import (
+	"context"
+	"testing"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/backup"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestToVolumeBackup(t *testing.T) {
+	job := batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "backup-abcde",
+			Namespace: "my-app-ns",
+			UID:       "1234",
+			Labels: map[string]string{
+				labels.AcornAppNamespace:     "acorn",
+				labels.AcornAppName:          "my-app",
+				labels.AcornVolumeName:       "data",
+				labels.AcornVolumeBackupType: backup.TypeBackup,
+			},
+			Annotations: map[string]string{
+				labels.AcornVolumeBackupSource: "pvc-1234",
+				labels.AcornVolumeBackupURL:    "s3://bucket/backups/",
+				labels.AcornVolumeBackupSecret: "s3-creds",
+			},
+		},
+		Status: batchv1.JobStatus{
+			Active: 1,
+		},
+	}
+
+	result := toVolumeBackup(job, nil)
+	assert.Equal(t, "backup-abcde", result.Name)
+	assert.Equal(t, "acorn", result.Namespace)
+	assert.Equal(t, "pvc-1234", result.Spec.Volume)
+	assert.Equal(t, "s3://bucket/backups/", result.Spec.To)
+	assert.Equal(t, "s3-creds", result.Spec.Secret)
+	assert.Equal(t, "my-app", result.Status.AppName)
+	assert.Equal(t, "data", result.Status.VolumeName)
+	assert.Equal(t, apiv1.VolumeBackupStateRunning, result.Status.State)
+
+	pod := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "backup-abcde-xyz",
+			Namespace: "my-app-ns",
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(&job, batchv1.SchemeGroupVersion.WithKind("Job")),
+			},
+		},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{{
+				State: corev1.ContainerState{
+					Terminated: &corev1.ContainerStateTerminated{
+						Message: "s3://bucket/backups/data-20230601T120000Z.tar.gz",
+					},
+				},
+			}},
+		},
+	}
+	job.Status = batchv1.JobStatus{
+		Conditions: []batchv1.JobCondition{{
+			Type:   batchv1.JobComplete,
+			Status: corev1.ConditionTrue,
+		}},
+	}
+
+	result = toVolumeBackup(job, []corev1.Pod{pod})
+	assert.Equal(t, apiv1.VolumeBackupStateSucceeded, result.Status.State)
+	assert.Equal(t, "s3://bucket/backups/data-20230601T120000Z.tar.gz", result.Status.URL)
+
+	pod.Status.ContainerStatuses[0].State.Terminated = &corev1.ContainerStateTerminated{
+		ExitCode: 1,
+		Message:  "GET s3://bucket/backups/data.tar.gz: NoSuchKey: The specified key does not exist.\n",
+	}
+	job.Labels[labels.AcornVolumeBackupType] = backup.TypeRestore
+	job.Annotations[labels.AcornVolumeBackupURL] = "s3://bucket/backups/data.tar.gz"
+	job.Status = batchv1.JobStatus{
+		Conditions: []batchv1.JobCondition{{
+			Type:    batchv1.JobFailed,
+			Status:  corev1.ConditionTrue,
+			Message: "Job has reached the specified backoff limit",
+		}},
+	}
+
+	result = toVolumeBackup(job, []corev1.Pod{pod})
+	assert.Equal(t, "s3://bucket/backups/data.tar.gz", result.Spec.From)
+	assert.Equal(t, "", result.Spec.To)
+	assert.Equal(t, apiv1.VolumeBackupStateFailed, result.Status.State)
+	assert.Equal(t, "GET s3://bucket/backups/data.tar.gz: NoSuchKey: The specified key does not exist.", result.Status.Message)
+}
+
+func TestValidate(t *testing.T) {
+	s := &Strategy{}
+	assert.Len(t, s.Validate(context.Background(), &apiv1.VolumeBackup{Spec: apiv1.VolumeBackupSpec{Volume: "data", To: "s3://bucket/"}}), 0)
+	assert.Len(t, s.Validate(context.Background(), &apiv1.VolumeBackup{Spec: apiv1.VolumeBackupSpec{Volume: "data", From: "file:///var/backups/data.tar.gz"}}), 0)
+	assert.Len(t, s.Validate(context.Background(), &apiv1.VolumeBackup{Spec: apiv1.VolumeBackupSpec{Volume: "data"}}), 1)
+	assert.Len(t, s.Validate(context.Background(), &apiv1.VolumeBackup{Spec: apiv1.VolumeBackupSpec{To: "s3://bucket/", From: "s3://bucket/data.tar.gz"}}), 2)
+	assert.Len(t, s.Validate(context.Background(), &apiv1.VolumeBackup{Spec: apiv1.VolumeBackupSpec{Volume: "data", To: "http://bucket/"}}), 1)
+	assert.Len(t, s.Validate(context.Background(), &apiv1.VolumeBackup{Spec: apiv1.VolumeBackupSpec{Volume: "data", From: "s3://bucket/"}}), 1)
+}
@@ -0,0 +1,195 @@
+package snapshots
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/mink/pkg/types"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/volume"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/storage"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var groupResource = schema.GroupResource{
+	Group:    apiv1.SchemeGroupVersion.Group,
+	Resource: "volumesnapshots",
+}
+
+// Strategy stores volume snapshots as CSI VolumeSnapshots in the namespace of the app that uses the volume, labeled
+// with the project they belong to
+type Strategy struct {
+	client kclient.Client
+}
+
+func (s *Strategy) New() types.Object {
+	return &apiv1.VolumeSnapshot{}
+}
+
+func (s *Strategy) NewList() types.ObjectList {
+	return &apiv1.VolumeSnapshotList{}
+}
+
+func (s *Strategy) Validate(_ context.Context, obj runtime.Object) (result field.ErrorList) {
+	snapshot := obj.(*apiv1.VolumeSnapshot)
+	if snapshot.Spec.Volume == "" {
+		result = append(result, field.Required(field.NewPath("spec", "volume"), "the volume to snapshot is required"))
+	}
+	return
+}
+
+func (s *Strategy) Create(ctx context.Context, obj types.Object) (types.Object, error) {
+	snapshot := obj.(*apiv1.VolumeSnapshot)
+
+	if _, err := volume.GetSnapshot(ctx, s.client, snapshot.Namespace, snapshot.Name); err == nil {
+		return nil, apierrors.NewAlreadyExists(groupResource, snapshot.Name)
+	} else if errors.Is(err, volume.ErrSnapshotsNotSupported) {
+		return nil, apierrors.NewBadRequest(err.Error())
+	} else if !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	vol := &apiv1.Volume{}
+	if err := s.client.Get(ctx, router.Key(snapshot.Namespace, snapshot.Spec.Volume), vol); apierrors.IsNotFound(err) {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("no volume found with name %q in project %q", snapshot.Spec.Volume, snapshot.Namespace))
+	} else if err != nil {
+		return nil, err
+	}
+
+	pv := &corev1.PersistentVolume{}
+	if err := s.client.Get(ctx, router.Key("", vol.Name), pv); err != nil {
+		return nil, err
+	}
+	if pv.Spec.ClaimRef == nil || pv.Status.Phase != corev1.VolumeBound {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("volume %s is not in use by an app, only volumes that are bound to an app can be snapshotted", vol.Name))
+	}
+
+	underlying := &volume.Snapshot{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: volume.SnapshotGVK.GroupVersion().String(),
+			Kind:       volume.SnapshotGVK.Kind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      snapshot.Name,
+			Namespace: pv.Spec.ClaimRef.Namespace,
+			Labels: map[string]string{
+				labels.AcornManaged:              "true",
+				labels.AcornAppNamespace:         snapshot.Namespace,
+				labels.AcornAppName:              pv.Labels[labels.AcornAppName],
+				labels.AcornVolumeName:           pv.Labels[labels.AcornVolumeName],
+				labels.AcornVolumeSnapshotSource: pv.Name,
+			},
+		},
+		Spec: volume.SnapshotSpec{
+			Source: volume.SnapshotSource{
+				PersistentVolumeClaimName: &pv.Spec.ClaimRef.Name,
+			},
+		},
+	}
+	if snapshot.Spec.Class != "" {
+		underlying.Spec.VolumeSnapshotClassName = &snapshot.Spec.Class
+	}
+
+	u, err := volume.ToUnstructured(underlying)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.Create(ctx, u); err != nil {
+		return nil, err
+	}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, underlying); err != nil {
+		return nil, err
+	}
+
+	return toVolumeSnapshot(snapshot.Namespace, *underlying), nil
+}
+
+func (s *Strategy) Get(ctx context.Context, namespace, name string) (types.Object, error) {
+	snapshot, err := volume.GetSnapshot(ctx, s.client, namespace, name)
+	if errors.Is(err, volume.ErrSnapshotsNotSupported) {
+		return nil, apierrors.NewNotFound(groupResource, name)
+	} else if err != nil {
+		return nil, err
+	}
+	return toVolumeSnapshot(namespace, *snapshot), nil
+}
+
+func (s *Strategy) List(ctx context.Context, namespace string, opts storage.ListOptions) (types.ObjectList, error) {
+	result := &apiv1.VolumeSnapshotList{}
+
+	snapshots, err := volume.ListSnapshots(ctx, s.client, namespace, opts.Predicate.Label)
+	if errors.Is(err, volume.ErrSnapshotsNotSupported) {
+		return result, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	name, filterByName := "", false
+	if opts.Predicate.Field != nil {
+		name, filterByName = opts.Predicate.Field.RequiresExactMatch("metadata.name")
+	}
+
+	for _, snapshot := range snapshots {
+		if filterByName && snapshot.Name != name {
+			continue
+		}
+		result.Items = append(result.Items, *toVolumeSnapshot(snapshot.Labels[labels.AcornAppNamespace], snapshot))
+	}
+
+	sort.Slice(result.Items, func(i, j int) bool {
+		return result.Items[i].Name < result.Items[j].Name
+	})
+
+	return result, nil
+}
+
+func (s *Strategy) Delete(ctx context.Context, obj types.Object) (types.Object, error) {
+	snapshot, err := volume.GetSnapshot(ctx, s.client, obj.GetNamespace(), obj.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(volume.SnapshotGVK)
+	u.SetNamespace(snapshot.Namespace)
+	u.SetName(snapshot.Name)
+	return obj, s.client.Delete(ctx, u)
+}
+
+func toVolumeSnapshot(namespace string, snapshot volume.Snapshot) *apiv1.VolumeSnapshot {
+	result := &apiv1.VolumeSnapshot{
+		ObjectMeta: snapshot.ObjectMeta,
+		Spec: apiv1.VolumeSnapshotSpec{
+			Volume: snapshot.Labels[labels.AcornVolumeSnapshotSource],
+		},
+		Status: apiv1.VolumeSnapshotStatus{
+			AppName:    snapshot.Labels[labels.AcornAppName],
+			VolumeName: snapshot.Labels[labels.AcornVolumeName],
+			ReadyToUse: snapshot.Ready(),
+		},
+	}
+	result.Namespace = namespace
+
+	if snapshot.Spec.VolumeSnapshotClassName != nil {
+		result.Spec.Class = *snapshot.Spec.VolumeSnapshotClassName
+	}
+	if snapshot.Status != nil {
+		result.Status.Size = snapshot.Status.RestoreSize
+		if snapshot.Status.Error != nil && snapshot.Status.Error.Message != nil {
+			result.Status.Error = *snapshot.Status.Error.Message
+		}
+	}
+
+	return result
+}
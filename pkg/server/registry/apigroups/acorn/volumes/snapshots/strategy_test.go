@@ -0,0 +1,54 @@
+package snapshots
+
+import (
+	"testing"
+
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/volume"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+func TestToVolumeSnapshot(t *testing.T) {
+	size := resource.MustParse("5Gi")
+	snapshot := volume.Snapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "before-upgrade",
+			Namespace: "my-app-ns",
+			Labels: map[string]string{
+				labels.AcornAppNamespace:         "acorn",
+				labels.AcornAppName:              "my-app",
+				labels.AcornVolumeName:           "data",
+				labels.AcornVolumeSnapshotSource: "pvc-1234",
+			},
+		},
+		Spec: volume.SnapshotSpec{
+			VolumeSnapshotClassName: pointer.String("csi-snapclass"),
+		},
+		Status: &volume.SnapshotStatus{
+			ReadyToUse:  pointer.Bool(false),
+			RestoreSize: &size,
+			Error: &volume.SnapshotError{
+				Message: pointer.String("failed to take snapshot"),
+			},
+		},
+	}
+
+	result := toVolumeSnapshot("acorn", snapshot)
+	assert.Equal(t, "before-upgrade", result.Name)
+	assert.Equal(t, "acorn", result.Namespace)
+	assert.Equal(t, "pvc-1234", result.Spec.Volume)
+	assert.Equal(t, "csi-snapclass", result.Spec.Class)
+	assert.Equal(t, "my-app", result.Status.AppName)
+	assert.Equal(t, "data", result.Status.VolumeName)
+	assert.Equal(t, "5Gi", result.Status.Size.String())
+	assert.False(t, result.Status.ReadyToUse)
+	assert.Equal(t, "failed to take snapshot", result.Status.Error)
+
+	snapshot.Status = nil
+	result = toVolumeSnapshot("acorn", snapshot)
+	assert.False(t, result.Status.ReadyToUse)
+	assert.Nil(t, result.Status.Size)
+}
@@ -8,6 +8,7 @@ import (
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	admininternalv1 "github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/computeclasses"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -42,8 +43,12 @@ func (s *ProjectValidator) Validate(ctx context.Context, obj runtime.Object) (re
 	if _, err := computeclasses.ParseComputeClassMemory(cc.Memory); err != nil {
 		return append(result, field.Invalid(field.NewPath("spec", "memory"), cc.Memory, err.Error()))
 	}
+	if _, err := computeclasses.ParseComputeClassCPU(cc.CPU); err != nil {
+		return append(result, field.Invalid(field.NewPath("spec", "cpu"), cc.CPU, err.Error()))
+	}
 
-	return append(result, validateMemorySpec(cc.Memory)...)
+	result = append(result, validateMemorySpec(cc.Memory)...)
+	return append(result, validateCPUSpec(cc.CPU)...)
 }
 
 func (s *ProjectValidator) ValidateUpdate(ctx context.Context, newObj, oldObj runtime.Object) field.ErrorList {
@@ -79,8 +84,12 @@ func (s *ClusterValidator) Validate(ctx context.Context, obj runtime.Object) (re
 	if _, err := computeclasses.ParseComputeClassMemory(cc.Memory); err != nil {
 		return append(result, field.Invalid(field.NewPath("spec.memory"), cc.Memory, err.Error()))
 	}
+	if _, err := computeclasses.ParseComputeClassCPU(cc.CPU); err != nil {
+		return append(result, field.Invalid(field.NewPath("spec.cpu"), cc.CPU, err.Error()))
+	}
 
-	return append(result, validateMemorySpec(cc.Memory)...)
+	result = append(result, validateMemorySpec(cc.Memory)...)
+	return append(result, validateCPUSpec(cc.CPU)...)
 }
 
 func validateMemorySpec(memory admininternalv1.ComputeClassMemory) field.ErrorList {
@@ -129,6 +138,58 @@ func validateMemorySpec(memory admininternalv1.ComputeClassMemory) field.ErrorLi
 	return errors
 }
 
+// validateCPUSpec must only be called with CPU that was successfully parsed by computeclasses.ParseComputeClassCPU
+func validateCPUSpec(cpu admininternalv1.ComputeClassCPU) field.ErrorList {
+	errors := field.ErrorList{}
+	if len(cpu.Values) != 0 {
+		if cpu.Max != "" {
+			errors = append(errors, field.Invalid(field.NewPath("spec", "cpu", "max"), cpu.Max, "cannot set maximum cpu with values specified"))
+		}
+		if cpu.Min != "" {
+			errors = append(errors, field.Invalid(field.NewPath("spec", "cpu", "min"), cpu.Min, "cannot set minimum cpu with values specified"))
+		}
+	}
+
+	// Ensure the min, max, and default make sense. A maximum of 0 is unrestricted.
+	min, max, def := toMilliCPU(cpu.Min), toMilliCPU(cpu.Max), toMilliCPU(cpu.Default)
+	if cpu.Min != "" && max != 0 && min > max {
+		errors = append(errors, field.Invalid(field.NewPath("spec", "cpu", "min"), cpu.Min, "minimum cpu should be at most the maximum cpu"))
+	}
+	if cpu.Default == "" {
+		return errors
+	}
+	if min > def {
+		errors = append(errors, field.Invalid(field.NewPath("spec", "cpu", "default"), cpu.Default, "default cpu should be at least the minimum cpu"))
+	}
+	if max != 0 && def > max {
+		errors = append(errors, field.Invalid(field.NewPath("spec", "cpu", "default"), cpu.Default, "default cpu should be at most the maximum cpu"))
+	}
+
+	if len(cpu.Values) == 0 {
+		return errors
+	}
+
+	for _, value := range cpu.Values {
+		if toMilliCPU(value) == def {
+			return errors
+		}
+	}
+	return append(errors,
+		field.Invalid(
+			field.NewPath("spec", "cpu", "default"), cpu.Default,
+			fmt.Sprintf("default cpu is not included in values. current values: %v", cpu.Values)),
+	)
+}
+
+// toMilliCPU returns the CPU in millicores so that values written in cores and millicores can be compared
+func toMilliCPU(cpu string) int64 {
+	if cpu == "" {
+		return 0
+	}
+	q := resource.MustParse(cpu)
+	return q.MilliValue()
+}
+
 func (s *ClusterValidator) ValidateUpdate(ctx context.Context, newObj, _ runtime.Object) field.ErrorList {
 	return s.Validate(ctx, newObj)
 }
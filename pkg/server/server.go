@@ -1,6 +1,8 @@
 package server
 
 import (
+	"net/http"
+
 	"github.com/acorn-io/baaah/pkg/clientaggregator"
 	"github.com/acorn-io/baaah/pkg/restconfig"
 	"github.com/acorn-io/mink/pkg/server"
@@ -10,6 +12,7 @@ import (
 	"github.com/acorn-io/runtime/pkg/openapi"
 	"github.com/acorn-io/runtime/pkg/scheme"
 	"github.com/acorn-io/runtime/pkg/server/registry"
+	"github.com/acorn-io/runtime/pkg/tracing"
 	apiserver "k8s.io/apiserver/pkg/server"
 	"k8s.io/apiserver/pkg/server/options"
 	"k8s.io/client-go/rest"
@@ -69,5 +72,6 @@ func New(cfg Config) (*server.Server, error) {
 		DefaultOptions:        cfg.DefaultOpts,
 		SupportAPIAggregation: cfg.LocalRestConfig == nil,
 		IgnoreStartFailure:    cfg.IgnoreStartFailure,
+		Middleware:            []func(http.Handler) http.Handler{tracing.Middleware},
 	})
 }
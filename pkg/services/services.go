@@ -57,6 +57,15 @@ func toContainerService(service *v1.ServiceInstance) (result []kclient.Object) {
 		},
 	}
 	result = append(result, newService)
+
+	if service.Spec.Canary != nil {
+		// The canary gets a service of its own that published traffic can be split to
+		canaryService := newService.DeepCopy()
+		canaryService.Name = service.Spec.Canary.Container
+		canaryService.Spec.Selector = labels.ManagedByApp(service.Spec.AppNamespace,
+			service.Spec.AppName, labels.AcornContainerName, service.Spec.Canary.Container)
+		result = append(result, canaryService)
+	}
 	return
 }
 
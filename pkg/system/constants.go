@@ -8,6 +8,7 @@ const (
 	LEAccountSecretName  = "acorn-le-account"
 	DefaultUserNamespace = "acorn"
 	DNSSecretName        = "acorn-dns"
+	VaultTokenSecretName = "acorn-vault-token"
 
 	CustomCABundleSecretName = "cabundle"
 	CustomCABundleSecretVolumeName
@@ -24,6 +25,7 @@ var (
 	ControllerName                 = "acorn-controller"
 	APIServerName                  = "acorn-api"
 	BuildkitPort             int32 = 8080
+	BuildServerMetricsPort   int32 = 9090
 	ContainerdConfigPathName       = "containerd-config-path"
 	DefaultHubAddress              = "acorn.io"
 )
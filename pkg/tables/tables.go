@@ -18,6 +18,21 @@ var (
 	}
 	AppConverter = MustConverter(App)
 
+	AppRevision = [][]string{
+		{"Revision", "Revision"},
+		{"Image", "Image"},
+		{"Image-ID", "{{ trunc .ImageID }}"},
+		{"Updated-By", "UpdatedBy"},
+		{"Created", "{{ago .Created}}"},
+	}
+
+	MetricSample = [][]string{
+		{"Name", "Name"},
+		{"Type", "Type"},
+		{"Labels", "Labels"},
+		{"Value", "Value"},
+	}
+
 	Volume = [][]string{
 		{"Name", "{{ . | name }}"},
 		{"App-Name", "Status.AppPublicName"},
@@ -30,6 +45,28 @@ var (
 	}
 	VolumeConverter = MustConverter(Volume)
 
+	VolumeSnapshot = [][]string{
+		{"Name", "{{ . | name }}"},
+		{"App-Name", "Status.AppName"},
+		{"Volume-Name", "Status.VolumeName"},
+		{"Volume", "Spec.Volume"},
+		{"Size", "Status.Size"},
+		{"Ready", "{{ boolToStar .Status.ReadyToUse }}"},
+		{"Created", "{{ago .CreationTimestamp}}"},
+	}
+	VolumeSnapshotConverter = MustConverter(VolumeSnapshot)
+
+	VolumeBackup = [][]string{
+		{"Name", "{{ . | name }}"},
+		{"App-Name", "Status.AppName"},
+		{"Volume-Name", "Status.VolumeName"},
+		{"State", "Status.State"},
+		{"URL", "Status.URL"},
+		{"Created", "{{ago .CreationTimestamp}}"},
+		{"Message", "Status.Message"},
+	}
+	VolumeBackupConverter = MustConverter(VolumeBackup)
+
 	VolumeClass = [][]string{
 		{"Name", "{{ . | name }}"},
 		{"Default", "{{ boolToStar .Default }}"},
@@ -83,6 +120,29 @@ var (
 	}
 	ContainerConverter = MustConverter(Container)
 
+	ContainerReplicaUsage = [][]string{
+		{"Name", "{{ . | name }}"},
+		{"App", "AppName"},
+		{"CPU", "{{ cpu .CPU }}"},
+		{"Memory", "{{ memory .Memory }}"},
+		{"Memory-Request", "{{ with .MemoryRequest }}{{ memory . }}{{ end }}"},
+		{"Memory-%", "{{ percent .Memory .MemoryRequest }}"},
+		{"Compute-Class", "ComputeClass"},
+	}
+	ContainerReplicaUsageConverter = MustConverter(ContainerReplicaUsage)
+
+	JobExecution = [][]string{
+		{"Name", "{{ . | name }}"},
+		{"Job", "Spec.JobName"},
+		{"Trigger", "Status.Trigger"},
+		{"State", "Status.State"},
+		{"Exit-Code", "{{ with .Status.ExitCode }}{{ . }}{{ end }}"},
+		{"Attempts", "Status.Attempts"},
+		{"Started", "{{ with .Status.StartTime }}{{ ago . }}{{ end }}"},
+		{"Message", "Status.Message"},
+	}
+	JobExecutionConverter = MustConverter(JobExecution)
+
 	CredentialClient = [][]string{
 		{"Server", "ServerAddress"},
 		{"Username", "Username"},
@@ -139,6 +199,13 @@ var (
 	}
 	ImageAllowRuleConverter = MustConverter(ImageAllowRule)
 
+	ImageVerifyResult = [][]string{
+		{"Rule", "Rule.Name"},
+		{"Covered", "Covered"},
+		{"Allowed", "Allowed"},
+		{"Reason", "Reason"},
+	}
+
 	Project = [][]string{
 		{"Name", "Name"},
 		{"Created", "{{ago .CreationTimestamp}}"},
@@ -177,4 +244,14 @@ var (
 	}
 
 	EventConverter = MustConverter(Event)
+
+	EventSubscription = [][]string{
+		{"Name", "{{ . | name }}"},
+		{"URL", "Spec.URL"},
+		{"Delivered", "Status.Delivered"},
+		{"Last-Attempt", "{{ with .Status.LastAttempt }}{{ ago . }}{{ end }}"},
+		{"Last-Error", "Status.LastError"},
+	}
+
+	EventSubscriptionConverter = MustConverter(EventSubscription)
 )
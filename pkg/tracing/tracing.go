@@ -0,0 +1,146 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/acorn-io/runtime/pkg/config"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/version"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+	"k8s.io/client-go/rest"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const traceParentHeader = "traceparent"
+
+var (
+	tracer     = otel.Tracer("github.com/acorn-io/runtime")
+	propagator = propagation.TraceContext{}
+	enabled    bool
+)
+
+// Init configures the spans of the named service to be exported to the OTLP gRPC endpoint and returns a function
+// that flushes and stops the exporter. Tracing stays disabled if the endpoint is empty.
+func Init(ctx context.Context, service, endpoint string) (func(context.Context) error, error) {
+	if endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(strings.TrimPrefix(endpoint, "https://"))}
+	if !strings.HasPrefix(endpoint, "https://") {
+		opts = []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(strings.TrimPrefix(endpoint, "http://")), otlptracegrpc.WithInsecure()}
+	}
+
+	exporter, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(
+			semconv.ServiceName(service),
+			semconv.ServiceVersion(version.Get().String()),
+		)),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagator)
+	enabled = true
+
+	logrus.Debugf("Sending traces to %s", endpoint)
+	return provider.Shutdown, nil
+}
+
+// InitFromConfig initializes tracing with the endpoint set in the acorn config
+func InitFromConfig(ctx context.Context, c kclient.Reader, service string) (func(context.Context) error, error) {
+	cfg, err := config.Get(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	return Init(ctx, service, *cfg.TracingEndpoint)
+}
+
+// Enabled returns true if Init configured an endpoint to send traces to
+func Enabled() bool {
+	return enabled
+}
+
+// Start starts a span. The span is a no-op if tracing is not enabled.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return tracer.Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// End records the error, if any, on the span and ends it
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// WrapConfig returns a copy of the rest config whose requests carry the trace context of their context
+func WrapConfig(cfg *rest.Config) *rest.Config {
+	if !enabled {
+		return cfg
+	}
+	cfg = rest.CopyConfig(cfg)
+	cfg.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return otelhttp.NewTransport(rt)
+	})
+	return cfg
+}
+
+// Middleware starts a span for every request, continuing the trace of the client that sent it
+func Middleware(next http.Handler) http.Handler {
+	if !enabled {
+		return next
+	}
+	return otelhttp.NewHandler(next, "acorn-api-server", otelhttp.WithSpanNameFormatter(func(_ string, req *http.Request) string {
+		return req.Method + " " + req.URL.Path
+	}))
+}
+
+// SetTraceParent records the trace context of ctx on the object so the controller can continue the trace when it
+// acts on the object. A trace context from an earlier change is removed if ctx is not part of a trace.
+func SetTraceParent(ctx context.Context, obj kclient.Object) {
+	carrier := propagation.MapCarrier{}
+	propagator.Inject(ctx, carrier)
+
+	annotations := obj.GetAnnotations()
+	if traceParent := carrier.Get(traceParentHeader); traceParent != "" {
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[labels.AcornTraceParent] = traceParent
+	} else {
+		delete(annotations, labels.AcornTraceParent)
+	}
+	obj.SetAnnotations(annotations)
+}
+
+// StartFromObject starts a span in the trace recorded on the object by SetTraceParent. The span is a no-op if the
+// object has no trace context.
+func StartFromObject(ctx context.Context, obj kclient.Object, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	traceParent := obj.GetAnnotations()[labels.AcornTraceParent]
+	if traceParent == "" {
+		return ctx, trace.SpanFromContext(context.Background())
+	}
+
+	ctx = propagator.Extract(ctx, propagation.MapCarrier{traceParentHeader: traceParent})
+	return Start(ctx, name, append(attrs,
+		attribute.String("k8s.namespace.name", obj.GetNamespace()),
+		attribute.String("acorn.name", obj.GetName()))...)
+}
@@ -0,0 +1,35 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestTraceParent(t *testing.T) {
+	otel.SetTracerProvider(sdktrace.NewTracerProvider())
+
+	ctx, span := Start(context.Background(), "update app")
+	defer span.End()
+
+	app := &v1.AppInstance{}
+	SetTraceParent(ctx, app)
+	assert.Contains(t, app.Annotations[labels.AcornTraceParent], span.SpanContext().TraceID().String())
+
+	_, child := StartFromObject(context.Background(), app, "deploy app")
+	assert.True(t, child.IsRecording())
+	assert.Equal(t, span.SpanContext().TraceID(), child.SpanContext().TraceID())
+	child.End()
+
+	// A change that isn't traced removes the trace context of the previous change
+	SetTraceParent(context.Background(), app)
+	assert.NotContains(t, app.Annotations, labels.AcornTraceParent)
+
+	_, child = StartFromObject(context.Background(), app, "deploy app")
+	assert.False(t, child.IsRecording())
+}
@@ -0,0 +1,167 @@
+package volume
+
+import (
+	"context"
+	"errors"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	klabels "k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/selection"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// SnapshotGroup is the API group of the CSI snapshot CRDs
+const SnapshotGroup = "snapshot.storage.k8s.io"
+
+var (
+	SnapshotGVK = schema.GroupVersionKind{
+		Group:   SnapshotGroup,
+		Version: "v1",
+		Kind:    "VolumeSnapshot",
+	}
+	SnapshotListGVK = schema.GroupVersionKind{
+		Group:   SnapshotGroup,
+		Version: "v1",
+		Kind:    "VolumeSnapshotList",
+	}
+	SnapshotContentGVK = schema.GroupVersionKind{
+		Group:   SnapshotGroup,
+		Version: "v1",
+		Kind:    "VolumeSnapshotContent",
+	}
+
+	ErrSnapshotsNotSupported = errors.New("volume snapshots are not supported, the CSI snapshot CRDs (snapshot.storage.k8s.io) are not installed in the cluster")
+)
+
+// Snapshot is the subset of the snapshot.storage.k8s.io VolumeSnapshot type that is used by acorn
+type Snapshot struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SnapshotSpec    `json:"spec"`
+	Status *SnapshotStatus `json:"status,omitempty"`
+}
+
+type SnapshotSpec struct {
+	Source                  SnapshotSource `json:"source"`
+	VolumeSnapshotClassName *string        `json:"volumeSnapshotClassName,omitempty"`
+}
+
+type SnapshotSource struct {
+	PersistentVolumeClaimName *string `json:"persistentVolumeClaimName,omitempty"`
+	VolumeSnapshotContentName *string `json:"volumeSnapshotContentName,omitempty"`
+}
+
+type SnapshotStatus struct {
+	BoundVolumeSnapshotContentName *string            `json:"boundVolumeSnapshotContentName,omitempty"`
+	ReadyToUse                     *bool              `json:"readyToUse,omitempty"`
+	RestoreSize                    *resource.Quantity `json:"restoreSize,omitempty"`
+	Error                          *SnapshotError     `json:"error,omitempty"`
+}
+
+type SnapshotError struct {
+	Message *string `json:"message,omitempty"`
+}
+
+// Ready returns true if the snapshot has been taken and can be used to provision volumes
+func (s *Snapshot) Ready() bool {
+	return s.Status != nil && s.Status.ReadyToUse != nil && *s.Status.ReadyToUse
+}
+
+// SnapshotContent is the subset of the snapshot.storage.k8s.io VolumeSnapshotContent type that is used by acorn
+type SnapshotContent struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SnapshotContentSpec    `json:"spec"`
+	Status *SnapshotContentStatus `json:"status,omitempty"`
+}
+
+type SnapshotContentSpec struct {
+	VolumeSnapshotRef       corev1.ObjectReference `json:"volumeSnapshotRef"`
+	DeletionPolicy          string                 `json:"deletionPolicy"`
+	Driver                  string                 `json:"driver"`
+	VolumeSnapshotClassName *string                `json:"volumeSnapshotClassName,omitempty"`
+	Source                  SnapshotContentSource  `json:"source"`
+}
+
+type SnapshotContentSource struct {
+	VolumeHandle   *string `json:"volumeHandle,omitempty"`
+	SnapshotHandle *string `json:"snapshotHandle,omitempty"`
+}
+
+type SnapshotContentStatus struct {
+	SnapshotHandle *string `json:"snapshotHandle,omitempty"`
+}
+
+// ToUnstructured converts a Snapshot or SnapshotContent to an object that can be sent with a controller-runtime client
+func ToUnstructured(obj any) (*unstructured.Unstructured, error) {
+	data, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	return &unstructured.Unstructured{Object: data}, nil
+}
+
+// ListSnapshots returns the snapshots that were taken of the volumes of the project that match the selector. All
+// projects are searched if namespace is empty.
+func ListSnapshots(ctx context.Context, c client.Reader, namespace string, sel klabels.Selector) ([]Snapshot, error) {
+	if sel == nil {
+		sel = klabels.Everything()
+	}
+	req, _ := klabels.NewRequirement(labels.AcornManaged, selection.Equals, []string{"true"})
+	sel = sel.Add(*req)
+	// Snapshots that acorn copied into an app's namespace to restore a volume don't have a source volume
+	req, _ = klabels.NewRequirement(labels.AcornVolumeSnapshotSource, selection.Exists, nil)
+	sel = sel.Add(*req)
+	if namespace != "" {
+		req, _ := klabels.NewRequirement(labels.AcornAppNamespace, selection.Equals, []string{namespace})
+		sel = sel.Add(*req)
+	}
+
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(SnapshotListGVK)
+	if err := c.List(ctx, list, &client.ListOptions{
+		LabelSelector: sel,
+	}); meta.IsNoMatchError(err) {
+		return nil, ErrSnapshotsNotSupported
+	} else if err != nil {
+		return nil, err
+	}
+
+	result := make([]Snapshot, 0, len(list.Items))
+	for _, item := range list.Items {
+		var snapshot Snapshot
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, &snapshot); err != nil {
+			return nil, err
+		}
+		result = append(result, snapshot)
+	}
+	return result, nil
+}
+
+// GetSnapshot returns the snapshot of a volume of the project with the given name
+func GetSnapshot(ctx context.Context, c client.Reader, namespace, name string) (*Snapshot, error) {
+	snapshots, err := ListSnapshots(ctx, c, namespace, nil)
+	if err != nil {
+		return nil, err
+	}
+	for i := range snapshots {
+		if snapshots[i].Name == name {
+			return &snapshots[i], nil
+		}
+	}
+	return nil, apierrors.NewNotFound(schema.GroupResource{
+		Group:    apiv1.SchemeGroupVersion.Group,
+		Resource: "volumesnapshots",
+	}, name)
+}
@@ -130,6 +130,29 @@ func GetVolumeClassInstances(ctx context.Context, c client.Client, namespace str
 		nil
 }
 
+// NodeOfClaim returns the node of a pod that uses the claim. A volume that can only be attached to one node at a time
+// can only be read by another pod on the node it is attached to.
+func NodeOfClaim(ctx context.Context, c client.Reader, pvc *corev1.PersistentVolumeClaim) (string, error) {
+	pods := &corev1.PodList{}
+	if err := c.List(ctx, pods, &client.ListOptions{
+		Namespace: pvc.Namespace,
+	}); err != nil {
+		return "", err
+	}
+
+	for _, pod := range pods.Items {
+		if pod.Spec.NodeName == "" || pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		for _, vol := range pod.Spec.Volumes {
+			if vol.PersistentVolumeClaim != nil && vol.PersistentVolumeClaim.ClaimName == pvc.Name {
+				return pod.Spec.NodeName, nil
+			}
+		}
+	}
+	return "", nil
+}
+
 func SliceToMap[T any, K comparable](s []T, keyFunc func(obj T) K) map[K]T {
 	m := make(map[K]T, len(s))
 	for _, obj := range s {
@@ -165,6 +188,10 @@ func CopyVolumeDefaults(volumeRequest v1.VolumeRequest, volumeBinding v1.VolumeB
 		volumeRequest.Size = volumeDefaults.Size
 	}
 
+	if volumeBinding.CloneOf != "" {
+		volumeRequest.From = volumeBinding.CloneOf
+	}
+
 	if len(volumeBinding.AccessModes) != 0 {
 		volumeRequest.AccessModes = volumeBinding.AccessModes
 	} else if len(volumeRequest.AccessModes) == 0 {